@@ -92,6 +92,19 @@ type AlluxioCompTemplateSpec struct {
 
 	// ImagePullSecrets that will be used to pull images
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// PriorityClassName indicates the priority of Alluxio's pods. If not set, the default priority of the cluster is used.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// TopologySpreadConstraints describes how Alluxio's pods ought to spread across topology domains.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodDisruptionBudget defines the PodDisruptionBudget created for Alluxio's pods to limit the voluntary disruptions
+	// (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 }
 
 // AlluxioFuseSpec is a description of the Alluxio Fuse
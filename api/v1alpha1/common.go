@@ -20,6 +20,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 )
@@ -164,6 +165,19 @@ type PodMetadata struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// PodDisruptionBudgetSpec describes the PodDisruptionBudget of the pods of a runtime component
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of the pods that must still be available after an eviction.
+	// It takes precedence over MaxUnavailable if both are set.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of the pods that can be unavailable after an eviction.
+	// If neither MinAvailable nor MaxUnavailable is set, defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // VolumeSource defines volume source and volume claim template.
 type VolumeSource struct {
 	corev1.VolumeSource `json:",inline"`
@@ -297,17 +311,101 @@ type ObjectRef struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// RunAfterCondition specifies on which result of the preceding operation the operation runs.
+type RunAfterCondition string
+
+const (
+	// OnSuccessCondition runs the operation after the preceding operation completes, it's the default condition
+	OnSuccessCondition RunAfterCondition = "OnSuccess"
+
+	// OnFailureCondition runs the operation after the preceding operation fails
+	OnFailureCondition RunAfterCondition = "OnFailure"
+
+	// AlwaysCondition runs the operation after the preceding operation either completes or fails
+	AlwaysCondition RunAfterCondition = "Always"
+)
+
 type OperationRef struct {
 	ObjectRef `json:",inline"`
 
 	// AffinityStrategy specifies the pod affinity strategy with the referent operation.
 	// +optional
 	AffinityStrategy AffinityStrategy `json:"affinityStrategy,omitempty"`
+
+	// Condition specifies on which result of the referent operation to run, one of: "OnSuccess", "OnFailure", "Always".
+	// Defaults to "OnSuccess".
+	// +kubebuilder:validation:Enum=OnSuccess;OnFailure;Always
+	// +optional
+	Condition RunAfterCondition `json:"condition,omitempty"`
+}
+
+// RunAfterMode specifies how the conditions of multiple preceding operations are combined.
+type RunAfterMode string
+
+const (
+	// AllOfMode runs the operation when the conditions of all the preceding operations are met, it's the default mode
+	AllOfMode RunAfterMode = "AllOf"
+
+	// AnyOfMode runs the operation when the condition of any preceding operation is met
+	AnyOfMode RunAfterMode = "AnyOf"
+)
+
+type OperationRefList struct {
+	// Mode specifies how the conditions of the preceding operations are combined, one of: "AllOf", "AnyOf".
+	// Defaults to "AllOf".
+	// +kubebuilder:validation:Enum=AllOf;AnyOf
+	// +optional
+	Mode RunAfterMode `json:"mode,omitempty"`
+
+	// Operations are the preceding operations
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Operations []OperationRef `json:"operations"`
 }
 
 type WaitingStatus struct {
 	// OperationComplete indicates if the preceding operation is complete
 	OperationComplete *bool `json:"operationComplete,omitempty"`
+
+	// TimeWindow indicates if the operation is waiting for its time window to open
+	TimeWindow *bool `json:"timeWindow,omitempty"`
+}
+
+// Throttle limits the bandwidth and the concurrency of a data operation, and the time of a day to run it.
+// Each runtime translates the limits into its native throttling options, and ignores the limits it doesn't support.
+type Throttle struct {
+	// MaxBandwidth is the maximum bandwidth in bytes per second to transfer data from the storage, e.g. 100Mi
+	// +optional
+	MaxBandwidth *resource.Quantity `json:"maxBandwidth,omitempty"`
+
+	// MaxConcurrentFilesPerWorker is the maximum number of files transferred concurrently by each worker
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentFilesPerWorker int32 `json:"maxConcurrentFilesPerWorker,omitempty"`
+
+	// Window is the time of a day to run the operation. The operation is delayed until the window opens,
+	// and the running operation is not interrupted when the window closes. It's not supported with the Cron
+	// policy, whose runs follow the schedule, so set the schedule inside the window instead.
+	// +optional
+	Window *TimeWindow `json:"window,omitempty"`
+}
+
+// TimeWindow is a period of a day, e.g. from 00:00 to 06:00. A window whose end is not later than its start
+// spans midnight, e.g. from 22:00 to 06:00.
+type TimeWindow struct {
+	// Start is the time the window opens, in the format of HH:MM
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +required
+	Start string `json:"start"`
+
+	// End is the time the window closes, in the format of HH:MM
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +required
+	End string `json:"end"`
+
+	// TimeZone is the name of the time zone of the window, e.g. Asia/Shanghai. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type ClientMetrics struct {
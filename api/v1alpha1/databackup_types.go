@@ -34,6 +34,10 @@ type DataBackupSpec struct {
 	// Specifies that the preceding operation in a workflow
 	// +optional
 	RunAfter *OperationRef `json:"runAfter,omitempty"`
+
+	// Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.
+	// +optional
+	RunAfterList *OperationRefList `json:"runAfterList,omitempty"`
 	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
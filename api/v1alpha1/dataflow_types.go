@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// DataFlowSpec defines the desired state of DataFlow
+type DataFlowSpec struct {
+	// Steps are the data operations in the flow. Each step creates a data operation named "<dataflow name>-<step name>"
+	// in the namespace of the DataFlow.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Steps []DataFlowStep `json:"steps"`
+
+	// Schedule is an optional cron schedule to re-run the whole flow, e.g. "0 2 * * *". A new run starts only after
+	// the previous one finishes. The first run starts once the DataFlow is created.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// DataFlowStep is a data operation in the flow. Exactly one of DataLoad, DataMigrate, DataProcess and DataBackup must be set.
+type DataFlowStep struct {
+	// Name of the step, which is unique in the DataFlow
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// RunAfter specifies the preceding steps. The step runs once created if not set.
+	// +optional
+	RunAfter []DataFlowStepRef `json:"runAfter,omitempty"`
+
+	// RunAfterMode specifies how the conditions of the preceding steps are combined, one of: "AllOf", "AnyOf".
+	// Defaults to "AllOf".
+	// +kubebuilder:validation:Enum=AllOf;AnyOf
+	// +optional
+	RunAfterMode RunAfterMode `json:"runAfterMode,omitempty"`
+
+	// DataLoad is the template of the DataLoad
+	// +optional
+	DataLoad *DataLoadSpec `json:"dataLoad,omitempty"`
+
+	// DataMigrate is the template of the DataMigrate
+	// +optional
+	DataMigrate *DataMigrateSpec `json:"dataMigrate,omitempty"`
+
+	// DataProcess is the template of the DataProcess
+	// +optional
+	DataProcess *DataProcessSpec `json:"dataProcess,omitempty"`
+
+	// DataBackup is the template of the DataBackup
+	// +optional
+	DataBackup *DataBackupSpec `json:"dataBackup,omitempty"`
+}
+
+// DataFlowStepRef refers to a preceding step in the same DataFlow.
+type DataFlowStepRef struct {
+	// Step is the name of the preceding step
+	// +required
+	Step string `json:"step"`
+
+	// Condition specifies on which result of the preceding step to run, one of: "OnSuccess", "OnFailure", "Always".
+	// Defaults to "OnSuccess".
+	// +kubebuilder:validation:Enum=OnSuccess;OnFailure;Always
+	// +optional
+	Condition RunAfterCondition `json:"condition,omitempty"`
+
+	// AffinityStrategy specifies the pod affinity strategy with the preceding step.
+	// +optional
+	AffinityStrategy AffinityStrategy `json:"affinityStrategy,omitempty"`
+}
+
+// DataFlowStatus defines the observed state of DataFlow
+type DataFlowStatus struct {
+	// Phase is aggregated from the steps. It's `Executing` until all the steps finish, then `Complete` if none of
+	// the steps fails, otherwise `Failed`. Steps skipped because of their RunAfter conditions don't fail the flow.
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Run is the sequence number of the current run, starting from 1
+	Run int32 `json:"run,omitempty"`
+
+	// StartTime is the time the current run starts
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the current run finishes
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Duration of the current run
+	Duration string `json:"duration,omitempty"`
+
+	// Steps are the status of the steps in the current run
+	Steps []DataFlowStepStatus `json:"steps,omitempty"`
+
+	// CriticalPath is the longest chain of steps weighted by their durations, which bounds the duration of the run
+	CriticalPath []string `json:"criticalPath,omitempty"`
+
+	// LastScheduleTime is the last time a run was started by the schedule
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Message explains why the DataFlow fails, e.g. the steps are invalid
+	Message string `json:"message,omitempty"`
+}
+
+// DataFlowStepStatus is the status of a step in the current run.
+type DataFlowStepStatus struct {
+	// Name of the step
+	Name string `json:"name"`
+
+	// Operation is the data operation created for the step
+	Operation ObjectRef `json:"operation"`
+
+	// Phase of the data operation, empty if it's not created yet
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Skipped indicates the step is skipped because the conditions of its preceding steps are not met
+	Skipped bool `json:"skipped,omitempty"`
+
+	// Duration of the data operation
+	Duration string `json:"duration,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Run",type="integer",JSONPath=`.status.run`
+// +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={fluid},shortName=dflow
+
+// DataFlow is the Schema for the dataflows API, which owns a pipeline of data operations
+type DataFlow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataFlowSpec   `json:"spec,omitempty"`
+	Status DataFlowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataFlowList contains a list of DataFlow
+type DataFlowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataFlow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataFlow{}, &DataFlowList{})
+}
@@ -91,6 +91,10 @@ type DataLoadSpec struct {
 	// +optional
 	RunAfter *OperationRef `json:"runAfter,omitempty"`
 
+	// Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.
+	// +optional
+	RunAfterList *OperationRefList `json:"runAfterList,omitempty"`
+
 	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
@@ -98,6 +102,10 @@ type DataLoadSpec struct {
 	// Resources that will be requested by the DataLoad job. <br>
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Throttle limits the bandwidth and the concurrency of the DataLoad, and the time of a day to run it
+	// +optional
+	Throttle *Throttle `json:"throttle,omitempty"`
 }
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
@@ -81,6 +81,10 @@ type DataMigrateSpec struct {
 	// +optional
 	RunAfter *OperationRef `json:"runAfter,omitempty"`
 
+	// Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.
+	// +optional
+	RunAfterList *OperationRefList `json:"runAfterList,omitempty"`
+
 	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
@@ -89,6 +93,10 @@ type DataMigrateSpec struct {
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// Throttle limits the bandwidth and the concurrency of the DataMigrate, and the time of a day to run it
+	// +optional
+	Throttle *Throttle `json:"throttle,omitempty"`
+
 	// Parallelism defines the parallelism tasks numbers for DataMigrate. If the value is greater than 1, the job acts
 	// as a launcher, and users should define the WorkerSpec.
 	// +optional
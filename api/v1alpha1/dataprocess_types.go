@@ -35,6 +35,28 @@ type TargetDatasetWithMountPath struct {
 	SubPath string `json:"subPath,omitempty"`
 }
 
+// DataProcessDatasetRole is the role of a dataset in the DataProcess
+type DataProcessDatasetRole string
+
+const (
+	// DataProcessInputDataset is read by the DataProcess, it's mounted read-only.
+	DataProcessInputDataset DataProcessDatasetRole = "Input"
+
+	// DataProcessOutputDataset is written by the DataProcess, its metadata is resynced after the DataProcess completes.
+	DataProcessOutputDataset DataProcessDatasetRole = "Output"
+)
+
+// DataProcessDataset defines a dataset processed by DataProcess besides spec.dataset.
+type DataProcessDataset struct {
+	TargetDatasetWithMountPath `json:",inline"`
+
+	// Role of the dataset, either Input or Output. Defaults to Input.
+	// +kubebuilder:validation:Enum=Input;Output
+	// +kubebuilder:default:=Input
+	// +optional
+	Role DataProcessDatasetRole `json:"role,omitempty"`
+}
+
 // Processor defines the actual processor for DataProcess. Processor can be either of a Job or a Shell script.
 type Processor struct {
 	// ServiceAccountName defiens the serviceAccountName of the container
@@ -94,23 +116,87 @@ type ScriptProcessor struct {
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
+// DataProcessSharding splits the files of the target dataset into shards, which are processed by an Indexed Job
+// with one index per shard. The file list is sorted and split into contiguous slices.
+// Each shard receives its slice through the following env vars:
+// FLUID_SHARD_INDEX (the index of the shard), FLUID_SHARD_COUNT (the number of shards) and
+// FLUID_SHARD_FILE_LIST (a file listing the paths of the files in the shard, relative to the dataset mount path).
+type DataProcessSharding struct {
+	// Shards is the number of shards
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	Shards int32 `json:"shards"`
+
+	// Glob matches the files of the dataset relative to the mount path, e.g. "images/*.jpg". Note that "*" also
+	// matches "/", so "*.jpg" matches the jpg files in all directories. Exactly one of Glob and ManifestFile must be set.
+	// +optional
+	Glob string `json:"glob,omitempty"`
+
+	// ManifestFile is a file in the dataset relative to the mount path, which lists the files to process
+	// one per line, e.g. "manifest.txt". Exactly one of Glob and ManifestFile must be set.
+	// +optional
+	ManifestFile string `json:"manifestFile,omitempty"`
+
+	// FileListPath is the absolute path of the file in the processor pods which the files matched by Glob are written
+	// into before the shards start, e.g. "/output/files.txt". It must be under the mount path of a dataset mounted
+	// read-write, i.e. spec.dataset or an output dataset in spec.datasets. It's required with Glob.
+	// +optional
+	FileListPath string `json:"fileListPath,omitempty"`
+
+	// BackoffLimitPerShard is the number of retries of each shard before the shard is marked as failed.
+	// A failed shard is retried on its own without affecting the other shards. Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffLimitPerShard *int32 `json:"backoffLimitPerShard,omitempty"`
+}
+
 // DataProcessSpec defines the desired state of DataProcess
 type DataProcessSpec struct {
 	// Dataset specifies the target dataset and its mount path.
 	// +required
 	Dataset TargetDatasetWithMountPath `json:"dataset"`
 
+	// Datasets specifies more datasets read or written by the DataProcess, which must be in the same namespace
+	// as the DataProcess. Like spec.dataset, they are locked by the DataProcess until it finishes.
+	// +optional
+	Datasets []DataProcessDataset `json:"datasets,omitempty"`
+
 	// Processor specify how to process data.
 	// +required
 	Processor Processor `json:"processor"`
 
+	// Sharding splits the files of the dataset into shards processed in parallel. It requires the mount path of the dataset.
+	// +optional
+	Sharding *DataProcessSharding `json:"sharding,omitempty"`
+
+	// Parallelism is the maximum number of shards processed at the same time, only works with Sharding.
+	// Defaults to the number of shards.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
 	// Specifies that the preceding operation in a workflow
 	// +optional
 	RunAfter *OperationRef `json:"runAfter,omitempty"`
 
+	// Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.
+	// +optional
+	RunAfterList *OperationRefList `json:"runAfterList,omitempty"`
+
 	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	//+kubebuilder:default:=Once
+	//+kubebuilder:validation:Enum=Once;Cron;OnEvent
+	// policy for process, including Once, Cron, OnEvent. With OnEvent, the DataProcess runs again when spec.dataset
+	// changes, so spec.dataset is mounted read-only and the results should be written into the output datasets.
+	// +optional
+	Policy Policy `json:"policy,omitempty"`
+
+	// The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
 }
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
@@ -88,6 +88,19 @@ type EFCCompTemplateSpec struct {
 	// PodMetadata defines labels and annotations that will be propagated to EFC's master and worker pods
 	// +optional
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
+
+	// PriorityClassName indicates the priority of EFC's pods. If not set, the default priority of the cluster is used.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// TopologySpreadConstraints describes how EFC's pods ought to spread across topology domains.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodDisruptionBudget defines the PodDisruptionBudget created for EFC's pods to limit the voluntary disruptions
+	// (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 }
 
 // EFCFuseSpec is a description of the EFC Fuse
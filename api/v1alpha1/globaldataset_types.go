@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// GlobalDatasetSpec defines the desired state of GlobalDataset
+type GlobalDatasetSpec struct {
+	// Template describes the Dataset and the runtime created in every member cluster
+	// +required
+	Template GlobalDatasetTemplate `json:"template"`
+
+	// Clusters are the member clusters in which the Dataset is created
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Clusters []MemberCluster `json:"clusters"`
+}
+
+// GlobalDatasetTemplate describes the Dataset and the runtime created in member clusters.
+type GlobalDatasetTemplate struct {
+	// Namespace of the Dataset and the runtime in member clusters, defaults to "default"
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Labels added to the Dataset in member clusters
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to the Dataset in member clusters
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Dataset is the spec of the Dataset in member clusters. The Dataset is named after the GlobalDataset.
+	// +required
+	Dataset DatasetSpec `json:"dataset"`
+
+	// Runtime is the runtime bound to the Dataset in member clusters, e.g. an AlluxioRuntime.
+	// Its name and namespace are overridden by the ones of the Dataset.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:EmbeddedResource
+	// +optional
+	Runtime *runtime.RawExtension `json:"runtime,omitempty"`
+}
+
+// MemberCluster is a cluster managed by the hub cluster.
+type MemberCluster struct {
+	// Name of the member cluster, which is unique in the GlobalDataset
+	// +required
+	Name string `json:"name"`
+
+	// KubeConfigSecret refers to the Secret which contains the kubeconfig to access the member cluster
+	// +required
+	KubeConfigSecret KubeConfigSecretReference `json:"kubeConfigSecret"`
+}
+
+// KubeConfigSecretReference refers to the kubeconfig stored in a Secret of the hub cluster.
+type KubeConfigSecretReference struct {
+	// Name of the Secret
+	// +required
+	Name string `json:"name"`
+
+	// Namespace of the Secret. It must be the namespace configured by `dataset.globalDataset.kubeConfigNamespace`
+	// of the Fluid chart, which defaults to the namespace where Fluid is installed.
+	// +required
+	Namespace string `json:"namespace"`
+
+	// Key of the kubeconfig in the Secret, defaults to "kubeconfig"
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// GlobalDatasetStatus defines the observed state of GlobalDataset
+type GlobalDatasetStatus struct {
+	// Phase is aggregated from the member clusters. It's `Bound` only if the Datasets in all member clusters are bound,
+	// and `Failed` if any of them fails or the member cluster is unreachable.
+	Phase DatasetPhase `json:"phase,omitempty"`
+
+	// UfsTotal is the total size of the underlying data reported by the member clusters
+	UfsTotal string `json:"ufsTotal,omitempty"`
+
+	// CacheStates sums up the cached size and the cache capacity of the member clusters
+	CacheStates common.CacheStateList `json:"cacheStates,omitempty"`
+
+	// ReadyClusters is the number of member clusters in which the Dataset is bound, e.g. "2/3"
+	ReadyClusters string `json:"readyClusters,omitempty"`
+
+	// Clusters are the status of the Datasets in member clusters
+	Clusters []MemberDatasetStatus `json:"clusters,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the controller
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// MemberDatasetStatus is the status of the Dataset in a member cluster.
+type MemberDatasetStatus struct {
+	// Name of the member cluster
+	Name string `json:"name"`
+
+	// KubeConfigSecret of the member cluster, which is recorded to clean up the Dataset
+	// after the member cluster is removed from the spec
+	KubeConfigSecret KubeConfigSecretReference `json:"kubeConfigSecret"`
+
+	// Phase of the Dataset in the member cluster
+	Phase DatasetPhase `json:"phase,omitempty"`
+
+	// UfsTotal of the Dataset in the member cluster
+	UfsTotal string `json:"ufsTotal,omitempty"`
+
+	// CacheStates of the Dataset in the member cluster
+	CacheStates common.CacheStateList `json:"cacheStates,omitempty"`
+
+	// Message explains why the Dataset fails to be synced to the member cluster
+	Message string `json:"message,omitempty"`
+
+	// LastSyncTime is the last time the Dataset is synced with the member cluster
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Ufs Total Size",type="string",JSONPath=`.status.ufsTotal`
+// +kubebuilder:printcolumn:name="Cached",type="string",JSONPath=`.status.cacheStates.cached`
+// +kubebuilder:printcolumn:name="Cache Capacity",type="string",JSONPath=`.status.cacheStates.cacheCapacity`
+// +kubebuilder:printcolumn:name="Ready Clusters",type="string",JSONPath=`.status.readyClusters`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:categories={fluid},shortName=gdataset
+
+// GlobalDataset is the Schema for the globaldatasets API, which creates the same Dataset in multiple member clusters
+type GlobalDataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalDatasetSpec   `json:"spec,omitempty"`
+	Status GlobalDatasetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// GlobalDatasetList contains a list of GlobalDataset
+type GlobalDatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalDataset `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GlobalDataset{}, &GlobalDatasetList{})
+}
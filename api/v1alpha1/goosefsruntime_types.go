@@ -66,6 +66,19 @@ type GooseFSCompTemplateSpec struct {
 	// More info: http://kubernetes.io/docs/user-guide/annotations
 	// +optional
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// PriorityClassName indicates the priority of GooseFS's pods. If not set, the default priority of the cluster is used.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// TopologySpreadConstraints describes how GooseFS's pods ought to spread across topology domains.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodDisruptionBudget defines the PodDisruptionBudget created for GooseFS's pods to limit the voluntary disruptions
+	// (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 }
 
 // GooseFSFuseSpec is a description of the GooseFS Fuse
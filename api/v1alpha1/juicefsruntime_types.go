@@ -125,6 +125,19 @@ type JuiceFSCompTemplateSpec struct {
 	// +kubebuilder:validation:Enum=HostNetwork;"";ContainerNetwork
 	// +optional
 	NetworkMode NetworkMode `json:"networkMode,omitempty"`
+
+	// PriorityClassName indicates the priority of JuiceFS's pods. If not set, the default priority of the cluster is used.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// TopologySpreadConstraints describes how JuiceFS's pods ought to spread across topology domains.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodDisruptionBudget defines the PodDisruptionBudget created for JuiceFS's pods to limit the voluntary disruptions
+	// (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 }
 
 type JuiceFSFuseSpec struct {
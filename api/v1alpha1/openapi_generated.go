@@ -43,6 +43,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackup":                 schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackup(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupList":             schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupSpec":             schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlow(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowList":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus":             schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStep(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepRef":            schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepRef(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus":         schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadList":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref),
@@ -50,7 +57,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateList":            schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec":            schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcess":                schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcess(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessDataset":         schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessDataset(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessList":            schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSharding":        schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSharding(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec":            schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation":        schema_fluid_cloudnative_fluid_api_v1alpha1_DataRestoreLocation(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate":              schema_fluid_cloudnative_fluid_api_v1alpha1_DataToMigrate(ref),
@@ -67,8 +76,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntimeSpec":             schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption":              schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOption(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource":        schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EventStatus":                schema_fluid_cloudnative_fluid_api_v1alpha1_EventStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec":       schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalStorage":            schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalStorage(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDataset":              schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDataset(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetList":          schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetSpec":          schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetStatus":        schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetTemplate":      schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetTemplate(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GooseFSCompTemplateSpec":    schema_fluid_cloudnative_fluid_api_v1alpha1_GooseFSCompTemplateSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GooseFSFuseSpec":            schema_fluid_cloudnative_fluid_api_v1alpha1_GooseFSFuseSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.GooseFSRuntime":             schema_fluid_cloudnative_fluid_api_v1alpha1_GooseFSRuntime(ref),
@@ -88,15 +103,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntime":             schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntime(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntimeList":         schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntimeSpec":         schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.KubeConfigSecretReference":  schema_fluid_cloudnative_fluid_api_v1alpha1_KubeConfigSecretReference(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Level":                      schema_fluid_cloudnative_fluid_api_v1alpha1_Level(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MasterSpec":                 schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MemberCluster":              schema_fluid_cloudnative_fluid_api_v1alpha1_MemberCluster(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MemberDatasetStatus":        schema_fluid_cloudnative_fluid_api_v1alpha1_MemberDatasetStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Metadata":                   schema_fluid_cloudnative_fluid_api_v1alpha1_Metadata(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataSyncPolicy":         schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataSyncPolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount":                      schema_fluid_cloudnative_fluid_api_v1alpha1_Mount(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise":                   schema_fluid_cloudnative_fluid_api_v1alpha1_OSAdvise(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef":                  schema_fluid_cloudnative_fluid_api_v1alpha1_ObjectRef(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObservedDatasetState":       schema_fluid_cloudnative_fluid_api_v1alpha1_ObservedDatasetState(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef":               schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList":           schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRefList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRunRecord":         schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRunRecord(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus":            schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec":    schema_fluid_cloudnative_fluid_api_v1alpha1_PodDisruptionBudgetSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata":                schema_fluid_cloudnative_fluid_api_v1alpha1_PodMetadata(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Prefer":                     schema_fluid_cloudnative_fluid_api_v1alpha1_Prefer(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor":                  schema_fluid_cloudnative_fluid_api_v1alpha1_Processor(ref),
@@ -107,10 +129,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus":              schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ScriptProcessor":            schema_fluid_cloudnative_fluid_api_v1alpha1_ScriptProcessor(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector":          schema_fluid_cloudnative_fluid_api_v1alpha1_SecretKeySelector(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ShardStatus":                schema_fluid_cloudnative_fluid_api_v1alpha1_ShardStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset":              schema_fluid_cloudnative_fluid_api_v1alpha1_TargetDataset(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath": schema_fluid_cloudnative_fluid_api_v1alpha1_TargetDatasetWithMountPath(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath":                 schema_fluid_cloudnative_fluid_api_v1alpha1_TargetPath(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec":       schema_fluid_cloudnative_fluid_api_v1alpha1_ThinCompTemplateSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinDataLoaderSpec":         schema_fluid_cloudnative_fluid_api_v1alpha1_ThinDataLoaderSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec":               schema_fluid_cloudnative_fluid_api_v1alpha1_ThinFuseSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntime":                schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntime(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeList":            schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeList(ref),
@@ -119,7 +143,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeProfileSpec":     schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfileSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeProfileStatus":   schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfileStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeSpec":            schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Throttle":                   schema_fluid_cloudnative_fluid_api_v1alpha1_Throttle(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore":                schema_fluid_cloudnative_fluid_api_v1alpha1_TieredStore(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TimeWindow":                 schema_fluid_cloudnative_fluid_api_v1alpha1_TimeWindow(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.User":                       schema_fluid_cloudnative_fluid_api_v1alpha1_User(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec":                schema_fluid_cloudnative_fluid_api_v1alpha1_VersionSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.VineyardClientSocketSpec":   schema_fluid_cloudnative_fluid_api_v1alpha1_VineyardClientSocketSpec(ref),
@@ -354,11 +380,38 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioCompTemplateSpec(ref com
 							},
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of Alluxio's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how Alluxio's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for Alluxio's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.TopologySpreadConstraint", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -1103,6 +1156,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref common.Refer
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
 						},
 					},
+					"runAfterList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList"),
+						},
+					},
 					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
@@ -1114,15 +1173,15 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoad is the Schema for the dataloads API",
+				Description: "DataFlow is the Schema for the dataflows API, which owns a pipeline of data operations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1148,28 +1207,28 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCa
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoadList contains a list of DataLoad",
+				Description: "DataFlowList contains a list of DataFlow",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1199,7 +1258,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.Referen
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow"),
 									},
 								},
 							},
@@ -1210,51 +1269,106 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoadSpec defines the desired state of DataLoad",
+				Description: "DataFlowSpec defines the desired state of DataFlow",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dataset": {
+					"steps": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dataset defines the target dataset of the DataLoad",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset"),
+							Description: "Steps are the data operations in the flow. Each step creates a data operation named \"<dataflow name>-<step name>\" in the namespace of the DataFlow.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep"),
+									},
+								},
+							},
 						},
 					},
-					"loadMetadata": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LoadMetadata specifies if the dataload job should load metadata",
-							Type:        []string{"boolean"},
+							Description: "Schedule is an optional cron schedule to re-run the whole flow, e.g. \"0 2 * * *\". A new run starts only after the previous one finishes. The first run starts once the DataFlow is created.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+				},
+				Required: []string{"steps"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStatus defines the observed state of DataFlow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target defines target paths that needs to be loaded",
+							Description: "Phase is aggregated from the steps. It's `Executing` until all the steps finish, then `Complete` if none of the steps fails, otherwise `Failed`. Steps skipped because of their RunAfter conditions don't fail the flow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"run": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Run is the sequence number of the current run, starting from 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time the current run starts",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time the current run finishes",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration of the current run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are the status of the steps in the current run",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus"),
 									},
 								},
 							},
 						},
 					},
-					"options": {
+					"criticalPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Options specifies the extra dataload properties for runtime",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "CriticalPath is the longest chain of steps weighted by their durations, which bounds the duration of the run",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1265,103 +1379,191 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.Referen
 							},
 						},
 					},
-					"podMetadata": {
+					"lastScheduleTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to DataLoad pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Description: "LastScheduleTime is the last time a run was started by the schedule",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"affinity": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Affinity defines affinity for DataLoad pod",
-							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+							Description: "Message explains why the DataFlow fails, e.g. the steps are invalid",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tolerations": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStep is a data operation in the flow. Exactly one of DataLoad, DataMigrate, DataProcess and DataBackup must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations defines tolerations for DataLoad pod",
+							Description: "Name of the step, which is unique in the DataFlow",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunAfter specifies the preceding steps. The step runs once created if not set.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepRef"),
 									},
 								},
 							},
 						},
 					},
-					"nodeSelector": {
+					"runAfterMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector defiens node selector for DataLoad pod",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "RunAfterMode specifies how the conditions of the preceding steps are combined, one of: \"AllOf\", \"AnyOf\". Defaults to \"AllOf\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"schedulerName": {
+					"dataLoad": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerName sets the scheduler to be used for DataLoad pod",
+							Description: "DataLoad is the template of the DataLoad",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
+						},
+					},
+					"dataMigrate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataMigrate is the template of the DataMigrate",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+						},
+					},
+					"dataProcess": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataProcess is the template of the DataProcess",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"),
+						},
+					},
+					"dataBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataBackup is the template of the DataBackup",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupSpec"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStepRef refers to a preceding step in the same DataFlow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Step is the name of the preceding step",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"policy": {
+					"condition": {
 						SchemaProps: spec.SchemaProps{
-							Description: "including Once, Cron, OnEvent",
+							Description: "Condition specifies on which result of the preceding step to run, one of: \"OnSuccess\", \"OnFailure\", \"Always\". Defaults to \"OnSuccess\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schedule": {
+					"affinityStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
+							Description: "AffinityStrategy specifies the pod affinity strategy with the preceding step.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.AffinityStrategy"),
+						},
+					},
+				},
+				Required: []string{"step"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.AffinityStrategy"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStepStatus is the status of a step in the current run.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the step",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"runAfter": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies that the preceding operation in a workflow",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
+							Description: "Operation is the data operation created for the step",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef"),
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Phase of the data operation, empty if it's not created yet",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+					"skipped": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by the DataLoad job. <br>",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "Skipped indicates the step is skipped because the conditions of its preceding steps are not met",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration of the data operation",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "operation"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataMigrate is the Schema for the datamigrates API",
+				Description: "DataLoad is the Schema for the dataloads API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1387,7 +1589,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.Referenc
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
 						},
 					},
 					"status": {
@@ -1400,15 +1602,15 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataMigrateList contains a list of DataMigrate",
+				Description: "DataLoadList contains a list of DataLoad",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1438,7 +1640,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref common.Refe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad"),
 									},
 								},
 							},
@@ -1449,27 +1651,278 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataMigrateSpec defines the desired state of DataMigrate",
+				Description: "DataLoadSpec defines the desired state of DataLoad",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"dataset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image (e.g. alluxio/alluxio)",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Dataset defines the target dataset of the DataLoad",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset"),
 						},
 					},
-					"imageTag": {
+					"loadMetadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image tag (e.g. 2.3.0-SNAPSHOT)",
+							Description: "LoadMetadata specifies if the dataload job should load metadata",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target defines target paths that needs to be loaded",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath"),
+									},
+								},
+							},
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options specifies the extra dataload properties for runtime",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to DataLoad pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Affinity defines affinity for DataLoad pod",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations defines tolerations for DataLoad pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector defiens node selector for DataLoad pod",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"schedulerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerName sets the scheduler to be used for DataLoad pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "including Once, Cron, OnEvent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies that the preceding operation in a workflow",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
+						},
+					},
+					"runAfterList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList"),
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by the DataLoad job. <br>",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"throttle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Throttle limits the bandwidth and the concurrency of the DataLoad, and the time of a day to run it",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Throttle"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Throttle", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataMigrate is the Schema for the datamigrates API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataMigrateList contains a list of DataMigrate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataMigrateSpec defines the desired state of DataMigrate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image (e.g. alluxio/alluxio)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image tag (e.g. 2.3.0-SNAPSHOT)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1595,6 +2048,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
 						},
 					},
+					"runAfterList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList"),
+						},
+					},
 					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
@@ -1609,6 +2068,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
+					"throttle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Throttle limits the bandwidth and the concurrency of the DataMigrate, and the time of a day to run it",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Throttle"),
+						},
+					},
 					"parallelism": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Parallelism defines the parallelism tasks numbers for DataMigrate. If the value is greater than 1, the job acts as a launcher, and users should define the WorkerSpec.",
@@ -1637,7 +2102,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Throttle", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -1688,6 +2153,57 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcess(ref common.Referenc
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessDataset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataProcessDataset defines a dataset processed by DataProcess besides spec.dataset.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name defines name of the target dataset",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace defines namespace of the target dataset",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountPath defines where the Dataset should be mounted in DataProcess's containers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubPath defines subpath of the target dataset to mount.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role of the dataset, either Input or Output. Defaults to Input.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "mountPath"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1737,6 +2253,56 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref common.Refe
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSharding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataProcessSharding splits the files of the target dataset into shards, which are processed by an Indexed Job with one index per shard. The file list is sorted and split into contiguous slices. Each shard receives its slice through the following env vars: FLUID_SHARD_INDEX (the index of the shard), FLUID_SHARD_COUNT (the number of shards) and FLUID_SHARD_FILE_LIST (a file listing the paths of the files in the shard, relative to the dataset mount path).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shards": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shards is the number of shards",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"glob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Glob matches the files of the dataset relative to the mount path, e.g. \"images/*.jpg\". Note that \"*\" also matches \"/\", so \"*.jpg\" matches the jpg files in all directories. Exactly one of Glob and ManifestFile must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manifestFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestFile is a file in the dataset relative to the mount path, which lists the files to process one per line, e.g. \"manifest.txt\". Exactly one of Glob and ManifestFile must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fileListPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FileListPath is the absolute path of the file in the processor pods which the files matched by Glob are written into before the shards start, e.g. \"/output/files.txt\". It must be under the mount path of a dataset mounted read-write, i.e. spec.dataset or an output dataset in spec.datasets. It's required with Glob.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backoffLimitPerShard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimitPerShard is the number of retries of each shard before the shard is marked as failed. A failed shard is retried on its own without affecting the other shards. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"shards"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1751,6 +2317,20 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.Refe
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"),
 						},
 					},
+					"datasets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Datasets specifies more datasets read or written by the DataProcess, which must be in the same namespace as the DataProcess. Like spec.dataset, they are locked by the DataProcess until it finishes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessDataset"),
+									},
+								},
+							},
+						},
+					},
 					"processor": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Processor specify how to process data.",
@@ -1758,12 +2338,31 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.Refe
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor"),
 						},
 					},
+					"sharding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sharding splits the files of the dataset into shards processed in parallel. It requires the mount path of the dataset.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSharding"),
+						},
+					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallelism is the maximum number of shards processed at the same time, only works with Sharding. Defaults to the number of shards.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"runAfter": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Specifies that the preceding operation in a workflow",
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
 						},
 					},
+					"runAfterList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies multiple preceding operations in a workflow. It can't be set together with RunAfter.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList"),
+						},
+					},
 					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
@@ -1771,12 +2370,26 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "policy for process, including Once, Cron, OnEvent. With OnEvent, the DataProcess runs again when spec.dataset changes, so spec.dataset is mounted read-only and the results should be written into the output datasets.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"dataset", "processor"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessDataset", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSharding", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRefList", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"},
 	}
 }
 
@@ -2393,11 +3006,38 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCCompTemplateSpec(ref common.
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of EFC's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how EFC's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for EFC's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.ResourceRequirements"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.TopologySpreadConstraint"},
 	}
 }
 
@@ -2621,125 +3261,403 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeSpec(ref common.Refer
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"),
 						},
 					},
-					"replicas": {
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The replicas of the worker, need to be specified",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"osAdvise": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operating system optimization for EFC",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise"),
+						},
+					},
+					"cleanCachePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanCachePolicy defines cleanCache Policy",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy"),
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to all EFC's pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of encryptOption",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The valueFrom of encryptOption",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The encryptInfo obtained from secret",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EventStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventStatus stores information about the events which trigger an operation with OnEvent policy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastTriggeredEvent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTriggeredEvent is the event which triggered the latest run of the operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggeredCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredCount is the number of runs triggered by events",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"observedDataset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedDataset is the state of the watched dataset observed when the latest run was triggered",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ObservedDatasetState"),
+						},
+					},
+					"observedWorkerNumberReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedWorkerNumberReady is the number of ready runtime workers observed when the latest run was triggered",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"observedPrecedingCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedPrecedingCompletionTime is the completion time of the preceding operation observed when the latest run was triggered",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"runHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunHistory records the latest runs of the operation, the oldest record is removed when the history is full",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRunRecord"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObservedDatasetState", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRunRecord", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalEndpointSpec defines the configurations for external etcd cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"uri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URI specifies the endpoint of external Etcd cluster E,g. \"etcd-svc.etcd-namespace.svc.cluster.local:2379\" Default is not set and use http protocol to connect to external etcd cluster",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encryptOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "encrypt info for accessing the external etcd cluster",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
+									},
+								},
+							},
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurable options for External Etcd cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"uri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of external storage, including s3, oss, gcs, ceph, nfs, pvc, etc. (related to runtime)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encryptOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "encrypt info for external storage",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"uri"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDataset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GlobalDataset is the Schema for the globaldatasets API, which creates the same Dataset in multiple member clusters",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The replicas of the worker, need to be specified",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"osAdvise": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operating system optimization for EFC",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"cleanCachePolicy": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanCachePolicy defines cleanCache Policy",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetSpec"),
 						},
 					},
-					"podMetadata": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to all EFC's pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GlobalDatasetList contains a list of GlobalDataset",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of encryptOption",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"valueFrom": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The valueFrom of encryptOption",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDataset"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDataset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GlobalDatasetSpec defines the desired state of GlobalDataset",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The encryptInfo obtained from secret",
+							Description: "Template describes the Dataset and the runtime created in every member cluster",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetTemplate"),
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clusters are the member clusters in which the Dataset is created",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.MemberCluster"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"template", "clusters"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.GlobalDatasetTemplate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.MemberCluster"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalEndpointSpec defines the configurations for external etcd cluster",
+				Description: "GlobalDatasetStatus defines the observed state of GlobalDataset",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"uri": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URI specifies the endpoint of external Etcd cluster E,g. \"etcd-svc.etcd-namespace.svc.cluster.local:2379\" Default is not set and use http protocol to connect to external etcd cluster",
-							Default:     "",
+							Description: "Phase is aggregated from the member clusters. It's `Bound` only if the Datasets in all member clusters are bound, and `Failed` if any of them fails or the member cluster is unreachable.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"encryptOptions": {
+					"ufsTotal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "encrypt info for accessing the external etcd cluster",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
-									},
-								},
-							},
+							Description: "UfsTotal is the total size of the underlying data reported by the member clusters",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"options": {
+					"cacheStates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurable options for External Etcd cluster.",
+							Description: "CacheStates sums up the cached size and the cache capacity of the member clusters",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -2753,48 +3671,107 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref common
 							},
 						},
 					},
+					"readyClusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyClusters is the number of member clusters in which the Dataset is bound, e.g. \"2/3\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clusters are the status of the Datasets in member clusters",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.MemberDatasetStatus"),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed by the controller",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.MemberDatasetStatus"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_GlobalDatasetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GlobalDatasetTemplate describes the Dataset and the runtime created in member clusters.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"uri": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type of external storage, including s3, oss, gcs, ceph, nfs, pvc, etc. (related to runtime)",
-							Default:     "",
+							Description: "Namespace of the Dataset and the runtime in member clusters, defaults to \"default\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"encryptOptions": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "encrypt info for external storage",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Labels added to the Dataset in member clusters",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations added to the Dataset in member clusters",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"dataset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dataset is the spec of the Dataset in member clusters. The Dataset is named after the GlobalDataset.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSpec"),
+						},
+					},
+					"runtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runtime is the runtime bound to the Dataset in member clusters, e.g. an AlluxioRuntime. Its name and namespace are overridden by the ones of the Dataset.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
 				},
-				Required: []string{"uri"},
+				Required: []string{"dataset"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -2921,11 +3898,38 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_GooseFSCompTemplateSpec(ref com
 							},
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of GooseFS's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how GooseFS's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for GooseFS's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ResourceRequirements"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.TopologySpreadConstraint"},
 	}
 }
 
@@ -3583,11 +4587,38 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoCompTemplateSpec(ref commo
 							},
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of Jindo's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how Jindo's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for Jindo's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -4194,11 +5225,38 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSCompTemplateSpec(ref com
 							Format:      "",
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of JuiceFS's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how JuiceFS's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for JuiceFS's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.TopologySpreadConstraint", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -4526,25 +5584,62 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeSpec(ref common.R
 							},
 						},
 					},
-					"podMetadata": {
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"management": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeManagement defines policies when managing the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.Volume"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_KubeConfigSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeConfigSecretReference refers to the kubeconfig stored in a Secret of the hub cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Description: "Namespace of the Secret. It must be the namespace configured by `dataset.globalDataset.kubeConfigNamespace` of the Fluid chart, which defaults to the namespace where Fluid is installed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"management": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RuntimeManagement defines policies when managing the runtime",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement"),
+							Description: "Key of the kubeconfig in the Secret, defaults to \"kubeconfig\"",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "namespace"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -4755,6 +5850,33 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.Reference
 							Format:      "",
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of Vineyard's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how Vineyard's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for Vineyard's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
 					"endpoint": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalEndpoint defines the configurations for external etcd cluster Default is not set If set, the Vineyard Master component will not be deployed, which means the Vineyard Worker component will use an external Etcd cluster. E,g.\n  endpoint:\n    uri: \"etcd-svc.etcd-namespace.svc.cluster.local:2379\"\n    encryptOptions:\n      - name: access-key\n\t\t   valueFrom:\n          secretKeyRef:\n            name: etcd-secret\n\t\t\t   key: accesskey",
@@ -4766,7 +5888,112 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.TopologySpreadConstraint", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_MemberCluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemberCluster is a cluster managed by the hub cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the member cluster, which is unique in the GlobalDataset",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubeConfigSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeConfigSecret refers to the Secret which contains the kubeconfig to access the member cluster",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.KubeConfigSecretReference"),
+						},
+					},
+				},
+				Required: []string{"name", "kubeConfigSecret"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.KubeConfigSecretReference"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_MemberDatasetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemberDatasetStatus is the status of the Dataset in a member cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the member cluster",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubeConfigSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeConfigSecret of the member cluster, which is recorded to clean up the Dataset after the member cluster is removed from the spec",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.KubeConfigSecretReference"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of the Dataset in the member cluster",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ufsTotal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UfsTotal of the Dataset in the member cluster",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cacheStates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheStates of the Dataset in the member cluster",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why the Dataset fails to be synced to the member cluster",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the Dataset is synced with the member cluster",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "kubeConfigSecret"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.KubeConfigSecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4995,6 +6222,48 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ObjectRef(ref common.ReferenceC
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ObservedDatasetState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObservedDatasetState is the snapshot of a dataset used to detect the changes on it",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ufsTotal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UfsTotal is the total size of the dataset's ufs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fileNum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FileNum is the file number of the dataset's ufs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountPoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountPoints are the mount points which have been mounted by the dataset",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5038,6 +6307,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref common.Referen
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.AffinityStrategy"),
 						},
 					},
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Condition specifies on which result of the referent operation to run, one of: \"OnSuccess\", \"OnFailure\", \"Always\". Defaults to \"OnSuccess\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"kind", "name"},
 			},
@@ -5047,6 +6323,90 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref common.Referen
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRefList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode specifies how the conditions of the preceding operations are combined, one of: \"AllOf\", \"AnyOf\". Defaults to \"AllOf\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations are the preceding operations",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"operations"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRunRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationRunRecord records a finished run of the operation with OnEvent policy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"triggeredEvent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredEvent is the event which triggered the run, it's empty for the first run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the run started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the run finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the final phase of the run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the time the run took",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5112,25 +6472,64 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.Refe
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"waitingFor": {
+					"waitingFor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitingStatus stores information about waiting operation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus"),
+						},
+					},
+					"nodeAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeAffinity records the node affinity for operation pods",
+							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
+						},
+					},
+					"eventStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventStatus records the events observed by the operation with OnEvent policy",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EventStatus"),
+						},
+					},
+					"shardStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShardStatus records the progress of the shards of the sharded operation",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ShardStatus"),
+						},
+					},
+				},
+				Required: []string{"phase", "duration", "conditions"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EventStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ShardStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_PodDisruptionBudgetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodDisruptionBudgetSpec describes the PodDisruptionBudget of the pods of a runtime component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitingStatus stores information about waiting operation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus"),
+							Description: "MinAvailable is the number or percentage of the pods that must still be available after an eviction. It takes precedence over MaxUnavailable if both are set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"nodeAffinity": {
+					"maxUnavailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeAffinity records the node affinity for operation pods",
-							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
+							Description: "MaxUnavailable is the number or percentage of the pods that can be unavailable after an eviction. If neither MinAvailable nor MaxUnavailable is set, defaults to 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
 				},
-				Required: []string{"phase", "duration", "conditions"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -5798,6 +7197,58 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_SecretKeySelector(ref common.Re
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ShardStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardStatus stores the progress of the shards of an operation, which are the indexes of an Indexed Job.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shards": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shards is the total number of shards",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"completed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Completed is the number of completed shards",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of shards failed after exhausting their retries",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"completedIndexes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletedIndexes are the indexes of the completed shards in the interval format, e.g. \"0,2-5\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failedIndexes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedIndexes are the indexes of the failed shards in the interval format, e.g. \"1,6\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"shards", "completed", "failed"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_TargetDataset(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6040,11 +7491,148 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinCompTemplateSpec(ref common
 							Format:      "",
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of the runtime component's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how the runtime component's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for the runtime component's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.TopologySpreadConstraint", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinDataLoaderSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ThinDataLoaderSpec is the container template of the loader used by DataLoad. The loader reads the target paths through the mounted dataset, and the paths to load are passed to it through the environment variables.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image of the loader container",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image tag of the loader container",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entrypoint of the loader container",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments to the entrypoint of the loader container",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Environment variables that will be used by the loader container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by the loader container, it's overridden by the resources of the DataLoad if set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into the loader container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"dataMountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataMountPath is the path where the dataset is mounted in the loader container, default is /data",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"image"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+			"k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -6503,12 +8091,18 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfileSpec(ref comm
 							Format:      "",
 						},
 					},
+					"dataLoader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataLoader defines the loader container used by DataLoad on the thinRuntime. DataLoad is not supported if it's not set.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinDataLoaderSpec"),
+						},
+					},
 				},
 				Required: []string{"fileSystemType"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinDataLoaderSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -6621,6 +8215,40 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeSpec(ref common.Refe
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_Throttle(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Throttle limits the bandwidth and the concurrency of a data operation, and the time of a day to run it. Each runtime translates the limits into its native throttling options, and ignores the limits it doesn't support.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxBandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBandwidth is the maximum bandwidth in bytes per second to transfer data from the storage, e.g. 100Mi",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxConcurrentFilesPerWorker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentFilesPerWorker is the maximum number of files transferred concurrently by each worker",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the time of a day to run the operation. The operation is delayed until the window opens, and the running operation is not interrupted when the window closes. It's not supported with the Cron policy, whose runs follow the schedule, so set the schedule inside the window instead.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TimeWindow"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.TimeWindow", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_TieredStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6650,6 +8278,43 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_TieredStore(ref common.Referenc
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_TimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TimeWindow is a period of a day, e.g. from 00:00 to 06:00. A window whose end is not later than its start spans midnight, e.g. from 22:00 to 06:00.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the time the window opens, in the format of HH:MM",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the time the window closes, in the format of HH:MM",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the name of the time zone of the window, e.g. Asia/Shanghai. Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_User(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6958,11 +8623,38 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_VineyardCompTemplateSpec(ref co
 							Format:      "",
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName indicates the priority of Vineyard's pods. If not set, the default priority of the cluster is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how Vineyard's pods ought to spread across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodDisruptionBudget defines the PodDisruptionBudget created for Vineyard's pods to limit the voluntary disruptions (e.g. node drains) at the same time. If not set, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodDisruptionBudgetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.TopologySpreadConstraint", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -7342,6 +9034,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_WaitingStatus(ref common.Refere
 							Format:      "",
 						},
 					},
+					"timeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeWindow indicates if the operation is waiting for its time window to open",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -154,6 +154,99 @@ type OperationStatus struct {
 
 	// NodeAffinity records the node affinity for operation pods
 	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+
+	// EventStatus records the events observed by the operation with OnEvent policy
+	// +optional
+	EventStatus *EventStatus `json:"eventStatus,omitempty"`
+
+	// ShardStatus records the progress of the shards of the sharded operation
+	// +optional
+	ShardStatus *ShardStatus `json:"shardStatus,omitempty"`
+}
+
+// ShardStatus stores the progress of the shards of an operation, which are the indexes of an Indexed Job.
+type ShardStatus struct {
+	// Shards is the total number of shards
+	Shards int32 `json:"shards"`
+
+	// Completed is the number of completed shards
+	Completed int32 `json:"completed"`
+
+	// Failed is the number of shards failed after exhausting their retries
+	Failed int32 `json:"failed"`
+
+	// CompletedIndexes are the indexes of the completed shards in the interval format, e.g. "0,2-5"
+	// +optional
+	CompletedIndexes string `json:"completedIndexes,omitempty"`
+
+	// FailedIndexes are the indexes of the failed shards in the interval format, e.g. "1,6"
+	// +optional
+	FailedIndexes string `json:"failedIndexes,omitempty"`
+}
+
+// EventStatus stores information about the events which trigger an operation with OnEvent policy
+type EventStatus struct {
+	// LastTriggeredEvent is the event which triggered the latest run of the operation
+	// +optional
+	LastTriggeredEvent string `json:"lastTriggeredEvent,omitempty"`
+
+	// TriggeredCount is the number of runs triggered by events
+	// +optional
+	TriggeredCount int32 `json:"triggeredCount,omitempty"`
+
+	// ObservedDataset is the state of the watched dataset observed when the latest run was triggered
+	// +optional
+	ObservedDataset ObservedDatasetState `json:"observedDataset,omitempty"`
+
+	// ObservedWorkerNumberReady is the number of ready runtime workers observed when the latest run was triggered
+	// +optional
+	ObservedWorkerNumberReady int32 `json:"observedWorkerNumberReady,omitempty"`
+
+	// ObservedPrecedingCompletionTime is the completion time of the preceding operation observed when the latest run was triggered
+	// +optional
+	ObservedPrecedingCompletionTime *metav1.Time `json:"observedPrecedingCompletionTime,omitempty"`
+
+	// RunHistory records the latest runs of the operation, the oldest record is removed when the history is full
+	// +optional
+	RunHistory []OperationRunRecord `json:"runHistory,omitempty"`
+}
+
+// OperationRunRecord records a finished run of the operation with OnEvent policy
+type OperationRunRecord struct {
+	// TriggeredEvent is the event which triggered the run, it's empty for the first run
+	// +optional
+	TriggeredEvent string `json:"triggeredEvent,omitempty"`
+
+	// StartTime is the time when the run started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the run finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Phase is the final phase of the run
+	// +optional
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Duration is the time the run took
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// ObservedDatasetState is the snapshot of a dataset used to detect the changes on it
+type ObservedDatasetState struct {
+	// UfsTotal is the total size of the dataset's ufs
+	// +optional
+	UfsTotal string `json:"ufsTotal,omitempty"`
+
+	// FileNum is the file number of the dataset's ufs
+	// +optional
+	FileNum string `json:"fileNum,omitempty"`
+
+	// MountPoints are the mount points which have been mounted by the dataset
+	// +optional
+	MountPoints []string `json:"mountPoints,omitempty"`
 }
 
 type RuntimePhase string
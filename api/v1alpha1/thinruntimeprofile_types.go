@@ -53,6 +53,52 @@ type ThinRuntimeProfileSpec struct {
 	// +kubebuilder:default=MountNodePublishSecretIfExists
 	// +kubebuilder:validation:Enum=NotMountNodePublishSecret;MountNodePublishSecretIfExists;CopyNodePublishSecretAndMountIfNotExists
 	NodePublishSecretPolicy NodePublishSecretPolicy `json:"nodePublishSecretPolicy,omitempty"`
+
+	// DataLoader defines the loader container used by DataLoad on the thinRuntime.
+	// DataLoad is not supported if it's not set.
+	// +optional
+	DataLoader *ThinDataLoaderSpec `json:"dataLoader,omitempty"`
+}
+
+// ThinDataLoaderSpec is the container template of the loader used by DataLoad.
+// The loader reads the target paths through the mounted dataset, and the paths to load are
+// passed to it through the environment variables.
+type ThinDataLoaderSpec struct {
+	// Image of the loader container
+	// +required
+	Image string `json:"image"`
+
+	// Image tag of the loader container
+	// +optional
+	ImageTag string `json:"imageTag,omitempty"`
+
+	// One of the three policies: `Always`, `IfNotPresent`, `Never`
+	// +optional
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+
+	// Entrypoint of the loader container
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Arguments to the entrypoint of the loader container
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Environment variables that will be used by the loader container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources that will be requested by the loader container, it's overridden by the resources of the DataLoad if set.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into the loader container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// DataMountPath is the path where the dataset is mounted in the loader container, default is /data
+	// +optional
+	DataMountPath string `json:"dataMountPath,omitempty"`
 }
 
 // ThinRuntimeProfileStatus defines the observed state of ThinRuntimeProfile
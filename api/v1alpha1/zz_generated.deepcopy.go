@@ -23,6 +23,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -120,6 +121,18 @@ func (in *AlluxioCompTemplateSpec) DeepCopyInto(out *AlluxioCompTemplateSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlluxioCompTemplateSpec.
@@ -471,6 +484,11 @@ func (in *DataBackupSpec) DeepCopyInto(out *DataBackupSpec) {
 		*out = new(OperationRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunAfterList != nil {
+		in, out := &in.RunAfterList, &out.RunAfterList
+		*out = new(OperationRefList)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TTLSecondsAfterFinished != nil {
 		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
 		*out = new(int32)
@@ -488,6 +506,198 @@ func (in *DataBackupSpec) DeepCopy() *DataBackupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlow) DeepCopyInto(out *DataFlow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlow.
+func (in *DataFlow) DeepCopy() *DataFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataFlow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowList) DeepCopyInto(out *DataFlowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataFlow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowList.
+func (in *DataFlowList) DeepCopy() *DataFlowList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataFlowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowSpec) DeepCopyInto(out *DataFlowSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DataFlowStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowSpec.
+func (in *DataFlowSpec) DeepCopy() *DataFlowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStatus) DeepCopyInto(out *DataFlowStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DataFlowStepStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.CriticalPath != nil {
+		in, out := &in.CriticalPath, &out.CriticalPath
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStatus.
+func (in *DataFlowStatus) DeepCopy() *DataFlowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStep) DeepCopyInto(out *DataFlowStep) {
+	*out = *in
+	if in.RunAfter != nil {
+		in, out := &in.RunAfter, &out.RunAfter
+		*out = make([]DataFlowStepRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataLoad != nil {
+		in, out := &in.DataLoad, &out.DataLoad
+		*out = new(DataLoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataMigrate != nil {
+		in, out := &in.DataMigrate, &out.DataMigrate
+		*out = new(DataMigrateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataProcess != nil {
+		in, out := &in.DataProcess, &out.DataProcess
+		*out = new(DataProcessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataBackup != nil {
+		in, out := &in.DataBackup, &out.DataBackup
+		*out = new(DataBackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStep.
+func (in *DataFlowStep) DeepCopy() *DataFlowStep {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStepRef) DeepCopyInto(out *DataFlowStepRef) {
+	*out = *in
+	in.AffinityStrategy.DeepCopyInto(&out.AffinityStrategy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStepRef.
+func (in *DataFlowStepRef) DeepCopy() *DataFlowStepRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStepRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStepStatus) DeepCopyInto(out *DataFlowStepStatus) {
+	*out = *in
+	out.Operation = in.Operation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStepStatus.
+func (in *DataFlowStepStatus) DeepCopy() *DataFlowStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataLoad) DeepCopyInto(out *DataLoad) {
 	*out = *in
@@ -588,12 +798,22 @@ func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
 		*out = new(OperationRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunAfterList != nil {
+		in, out := &in.RunAfterList, &out.RunAfterList
+		*out = new(OperationRefList)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TTLSecondsAfterFinished != nil {
 		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
 		*out = new(int32)
 		**out = **in
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Throttle != nil {
+		in, out := &in.Throttle, &out.Throttle
+		*out = new(Throttle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoadSpec.
@@ -703,12 +923,22 @@ func (in *DataMigrateSpec) DeepCopyInto(out *DataMigrateSpec) {
 		*out = new(OperationRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunAfterList != nil {
+		in, out := &in.RunAfterList, &out.RunAfterList
+		*out = new(OperationRefList)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TTLSecondsAfterFinished != nil {
 		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
 		*out = new(int32)
 		**out = **in
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Throttle != nil {
+		in, out := &in.Throttle, &out.Throttle
+		*out = new(Throttle)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ParallelOptions != nil {
 		in, out := &in.ParallelOptions, &out.ParallelOptions
 		*out = make(map[string]string, len(*in))
@@ -755,6 +985,22 @@ func (in *DataProcess) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataProcessDataset) DeepCopyInto(out *DataProcessDataset) {
+	*out = *in
+	out.TargetDatasetWithMountPath = in.TargetDatasetWithMountPath
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataProcessDataset.
+func (in *DataProcessDataset) DeepCopy() *DataProcessDataset {
+	if in == nil {
+		return nil
+	}
+	out := new(DataProcessDataset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataProcessList) DeepCopyInto(out *DataProcessList) {
 	*out = *in
@@ -787,16 +1033,56 @@ func (in *DataProcessList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataProcessSharding) DeepCopyInto(out *DataProcessSharding) {
+	*out = *in
+	if in.BackoffLimitPerShard != nil {
+		in, out := &in.BackoffLimitPerShard, &out.BackoffLimitPerShard
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataProcessSharding.
+func (in *DataProcessSharding) DeepCopy() *DataProcessSharding {
+	if in == nil {
+		return nil
+	}
+	out := new(DataProcessSharding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataProcessSpec) DeepCopyInto(out *DataProcessSpec) {
 	*out = *in
 	out.Dataset = in.Dataset
+	if in.Datasets != nil {
+		in, out := &in.Datasets, &out.Datasets
+		*out = make([]DataProcessDataset, len(*in))
+		copy(*out, *in)
+	}
 	in.Processor.DeepCopyInto(&out.Processor)
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(DataProcessSharding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
 	if in.RunAfter != nil {
 		in, out := &in.RunAfter, &out.RunAfter
 		*out = new(OperationRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunAfterList != nil {
+		in, out := &in.RunAfterList, &out.RunAfterList
+		*out = new(OperationRefList)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TTLSecondsAfterFinished != nil {
 		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
 		*out = new(int32)
@@ -1096,6 +1382,18 @@ func (in *EFCCompTemplateSpec) DeepCopyInto(out *EFCCompTemplateSpec) {
 		}
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFCCompTemplateSpec.
@@ -1249,54 +1547,225 @@ func (in *EncryptOptionSource) DeepCopy() *EncryptOptionSource {
 	if in == nil {
 		return nil
 	}
-	out := new(EncryptOptionSource)
+	out := new(EncryptOptionSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventStatus) DeepCopyInto(out *EventStatus) {
+	*out = *in
+	in.ObservedDataset.DeepCopyInto(&out.ObservedDataset)
+	if in.ObservedPrecedingCompletionTime != nil {
+		in, out := &in.ObservedPrecedingCompletionTime, &out.ObservedPrecedingCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RunHistory != nil {
+		in, out := &in.RunHistory, &out.RunHistory
+		*out = make([]OperationRunRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventStatus.
+func (in *EventStatus) DeepCopy() *EventStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEndpointSpec) DeepCopyInto(out *ExternalEndpointSpec) {
+	*out = *in
+	if in.EncryptOptions != nil {
+		in, out := &in.EncryptOptions, &out.EncryptOptions
+		*out = make([]EncryptOption, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEndpointSpec.
+func (in *ExternalEndpointSpec) DeepCopy() *ExternalEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStorage) DeepCopyInto(out *ExternalStorage) {
+	*out = *in
+	if in.EncryptOptions != nil {
+		in, out := &in.EncryptOptions, &out.EncryptOptions
+		*out = make([]EncryptOption, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStorage.
+func (in *ExternalStorage) DeepCopy() *ExternalStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalDataset) DeepCopyInto(out *GlobalDataset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalDataset.
+func (in *GlobalDataset) DeepCopy() *GlobalDataset {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalDataset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalDataset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalDatasetList) DeepCopyInto(out *GlobalDatasetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalDataset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalDatasetList.
+func (in *GlobalDatasetList) DeepCopy() *GlobalDatasetList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalDatasetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalDatasetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalDatasetSpec) DeepCopyInto(out *GlobalDatasetSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]MemberCluster, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalDatasetSpec.
+func (in *GlobalDatasetSpec) DeepCopy() *GlobalDatasetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalDatasetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalEndpointSpec) DeepCopyInto(out *ExternalEndpointSpec) {
+func (in *GlobalDatasetStatus) DeepCopyInto(out *GlobalDatasetStatus) {
 	*out = *in
-	if in.EncryptOptions != nil {
-		in, out := &in.EncryptOptions, &out.EncryptOptions
-		*out = make([]EncryptOption, len(*in))
-		copy(*out, *in)
-	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make(map[string]string, len(*in))
+	if in.CacheStates != nil {
+		in, out := &in.CacheStates, &out.CacheStates
+		*out = make(common.CacheStateList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]MemberDatasetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEndpointSpec.
-func (in *ExternalEndpointSpec) DeepCopy() *ExternalEndpointSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalDatasetStatus.
+func (in *GlobalDatasetStatus) DeepCopy() *GlobalDatasetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalEndpointSpec)
+	out := new(GlobalDatasetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalStorage) DeepCopyInto(out *ExternalStorage) {
+func (in *GlobalDatasetTemplate) DeepCopyInto(out *GlobalDatasetTemplate) {
 	*out = *in
-	if in.EncryptOptions != nil {
-		in, out := &in.EncryptOptions, &out.EncryptOptions
-		*out = make([]EncryptOption, len(*in))
-		copy(*out, *in)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Dataset.DeepCopyInto(&out.Dataset)
+	if in.Runtime != nil {
+		in, out := &in.Runtime, &out.Runtime
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStorage.
-func (in *ExternalStorage) DeepCopy() *ExternalStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalDatasetTemplate.
+func (in *GlobalDatasetTemplate) DeepCopy() *GlobalDatasetTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalStorage)
+	out := new(GlobalDatasetTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1345,6 +1814,18 @@ func (in *GooseFSCompTemplateSpec) DeepCopyInto(out *GooseFSCompTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GooseFSCompTemplateSpec.
@@ -1626,6 +2107,18 @@ func (in *JindoCompTemplateSpec) DeepCopyInto(out *JindoCompTemplateSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JindoCompTemplateSpec.
@@ -1885,6 +2378,18 @@ func (in *JuiceFSCompTemplateSpec) DeepCopyInto(out *JuiceFSCompTemplateSpec) {
 		}
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JuiceFSCompTemplateSpec.
@@ -2046,6 +2551,21 @@ func (in *JuiceFSRuntimeSpec) DeepCopy() *JuiceFSRuntimeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeConfigSecretReference) DeepCopyInto(out *KubeConfigSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeConfigSecretReference.
+func (in *KubeConfigSecretReference) DeepCopy() *KubeConfigSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeConfigSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Level) DeepCopyInto(out *Level) {
 	*out = *in
@@ -2084,6 +2604,46 @@ func (in *MasterSpec) DeepCopy() *MasterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberCluster) DeepCopyInto(out *MemberCluster) {
+	*out = *in
+	out.KubeConfigSecret = in.KubeConfigSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberCluster.
+func (in *MemberCluster) DeepCopy() *MemberCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberDatasetStatus) DeepCopyInto(out *MemberDatasetStatus) {
+	*out = *in
+	out.KubeConfigSecret = in.KubeConfigSecret
+	if in.CacheStates != nil {
+		in, out := &in.CacheStates, &out.CacheStates
+		*out = make(common.CacheStateList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberDatasetStatus.
+func (in *MemberDatasetStatus) DeepCopy() *MemberDatasetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberDatasetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -2178,6 +2738,26 @@ func (in *ObjectRef) DeepCopy() *ObjectRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedDatasetState) DeepCopyInto(out *ObservedDatasetState) {
+	*out = *in
+	if in.MountPoints != nil {
+		in, out := &in.MountPoints, &out.MountPoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedDatasetState.
+func (in *ObservedDatasetState) DeepCopy() *ObservedDatasetState {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedDatasetState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationRef) DeepCopyInto(out *OperationRef) {
 	*out = *in
@@ -2195,6 +2775,51 @@ func (in *OperationRef) DeepCopy() *OperationRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationRefList) DeepCopyInto(out *OperationRefList) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]OperationRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationRefList.
+func (in *OperationRefList) DeepCopy() *OperationRefList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationRefList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationRunRecord) DeepCopyInto(out *OperationRunRecord) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationRunRecord.
+func (in *OperationRunRecord) DeepCopy() *OperationRunRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationRunRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
 	*out = *in
@@ -2226,6 +2851,16 @@ func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
 		*out = new(v1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EventStatus != nil {
+		in, out := &in.EventStatus, &out.EventStatus
+		*out = new(EventStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShardStatus != nil {
+		in, out := &in.ShardStatus, &out.ShardStatus
+		*out = new(ShardStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationStatus.
@@ -2238,6 +2873,31 @@ func (in *OperationStatus) DeepCopy() *OperationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodMetadata) DeepCopyInto(out *PodMetadata) {
 	*out = *in
@@ -2480,6 +3140,21 @@ func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardStatus.
+func (in *ShardStatus) DeepCopy() *ShardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetDataset) DeepCopyInto(out *TargetDataset) {
 	*out = *in
@@ -2571,6 +3246,18 @@ func (in *ThinCompTemplateSpec) DeepCopyInto(out *ThinCompTemplateSpec) {
 		*out = new(v1.Probe)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinCompTemplateSpec.
@@ -2583,6 +3270,46 @@ func (in *ThinCompTemplateSpec) DeepCopy() *ThinCompTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThinDataLoaderSpec) DeepCopyInto(out *ThinDataLoaderSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinDataLoaderSpec.
+func (in *ThinDataLoaderSpec) DeepCopy() *ThinDataLoaderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThinDataLoaderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThinFuseSpec) DeepCopyInto(out *ThinFuseSpec) {
 	*out = *in
@@ -2798,6 +3525,11 @@ func (in *ThinRuntimeProfileSpec) DeepCopyInto(out *ThinRuntimeProfileSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DataLoader != nil {
+		in, out := &in.DataLoader, &out.DataLoader
+		*out = new(ThinDataLoaderSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinRuntimeProfileSpec.
@@ -2861,6 +3593,31 @@ func (in *ThinRuntimeSpec) DeepCopy() *ThinRuntimeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Throttle) DeepCopyInto(out *Throttle) {
+	*out = *in
+	if in.MaxBandwidth != nil {
+		in, out := &in.MaxBandwidth, &out.MaxBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(TimeWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Throttle.
+func (in *Throttle) DeepCopy() *Throttle {
+	if in == nil {
+		return nil
+	}
+	out := new(Throttle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TieredStore) DeepCopyInto(out *TieredStore) {
 	*out = *in
@@ -2883,6 +3640,21 @@ func (in *TieredStore) DeepCopy() *TieredStore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -2994,6 +3766,18 @@ func (in *VineyardCompTemplateSpec) DeepCopyInto(out *VineyardCompTemplateSpec)
 		}
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VineyardCompTemplateSpec.
@@ -3116,6 +3900,11 @@ func (in *WaitingStatus) DeepCopyInto(out *WaitingStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitingStatus.
@@ -120,6 +120,17 @@ affinity:
 {{- end -}}
 {{- end -}}
 
+{{/*
+The namespace of the kubeconfig Secrets of the member clusters of GlobalDatasets
+*/}}
+{{- define "fluid.globalDataset.kubeConfigNamespace" -}}
+{{- if .Values.dataset.globalDataset.kubeConfigNamespace -}}
+    {{ .Values.dataset.globalDataset.kubeConfigNamespace }}
+{{- else -}}
+    {{ include "fluid.namespace" . }}
+{{- end -}}
+{{- end -}}
+
 {{- define "fluid.helmDriver" -}}
 {{- if or (eq .Values.helmDriver "configmap") (eq .Values.helmDriver "secret") -}}
 {{ .Values.helmDriver | quote }}
@@ -162,6 +173,23 @@ Check if feature gate DataflowAffinity is enabled in the featureGates.
 {{- $found -}}
 {{- end -}}
 
+{{/*
+Check if feature gate CacheAwareAdmission is enabled in the featureGates.
+*/}}
+{{- define "fluid.cacheAwareAdmission.enabled" -}}
+{{- $featureGates := splitList "," .Values.fluidapp.featureGates }}
+{{- $found := false -}}
+{{- range $idx, $featureGate := $featureGates }}
+    {{- $featureGateKV := splitList "=" $featureGate }}
+    {{- $key :=  trim (index $featureGateKV 0) }}
+    {{- $value := trim (index $featureGateKV 1) }}
+    {{- if and (eq $key "CacheAwareAdmission") (eq $value "true") -}}
+        {{- $found = true -}}
+    {{- end -}}
+{{- end -}}
+{{- $found -}}
+{{- end -}}
+
 {{/* Common syncScheduleInfoNodeExcludeSelector env for all runtime controllers*/}}
 {{- define "fluid.controllers.envs.syncScheduleInfoNodeExcludeSelector" -}}
 {{- if .Values.runtime.syncScheduleInfoNodeExcludeSelector }}
@@ -40,12 +40,14 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	"github.com/fluid-cloudnative/fluid/pkg/controllers/deploy"
 	databackupctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/databackup"
 	dataflowctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataflow"
 	dataloadctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataload"
 	datamigratectl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datamigrate"
 	dataprocessctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataprocess"
 	datasetctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataset"
+	globaldatasetctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/globaldataset"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
@@ -68,6 +70,11 @@ var (
 
 	kubeClientQPS   float32
 	kubeClientBurst int
+
+	runtimeControllerIdlePeriodStr string
+
+	enableGlobalDataset              bool
+	globalDatasetKubeConfigNamespace string
 )
 
 // configuration for controllers' rate limiter
@@ -102,6 +109,9 @@ func init() {
 	datasetCmd.Flags().StringVar(&controllerWorkqueueMaxSyncBackoffStr, "workqueue-max-sync-backoff", "1000s", "max backoff period for failed reconciliation in controller's workqueue")
 	datasetCmd.Flags().IntVar(&controllerWorkqueueQPS, "workqueue-qps", 10, "qps limit value for controller's workqueue")
 	datasetCmd.Flags().IntVar(&controllerWorkqueueBurst, "workqueue-burst", 100, "burst limit value for controller's workqueue")
+	datasetCmd.Flags().StringVar(&runtimeControllerIdlePeriodStr, "runtime-controller-idle-period", "0s", "scale in runtime controllers to 0 when none of their runtimes exists for the period, 0 means never scale in")
+	datasetCmd.Flags().BoolVar(&enableGlobalDataset, "enable-global-dataset", false, "Enable the GlobalDataset controller to sync Datasets to member clusters")
+	datasetCmd.Flags().StringVar(&globalDatasetKubeConfigNamespace, "global-dataset-kubeconfig-namespace", "fluid-system", "The only namespace allowed to store the kubeconfig Secrets of the member clusters of GlobalDatasets")
 }
 
 func handle() {
@@ -152,6 +162,12 @@ func handle() {
 		os.Exit(1)
 	}
 
+	runtimeControllerIdlePeriod, err := time.ParseDuration(runtimeControllerIdlePeriodStr)
+	if err != nil {
+		setupLog.Error(err, "runtime-controller-idle-period is not a valid duration, please use string like \"30m\", \"1h\", ...")
+		os.Exit(1)
+	}
+
 	controllerOptions := controller.Options{
 		MaxConcurrentReconciles: maxConcurrentReconciles,
 		RateLimiter:             controllers.NewFluidControllerRateLimiter(defaultSyncBackoff, maxSyncBackoff, controllerWorkqueueQPS, controllerWorkqueueBurst),
@@ -169,6 +185,18 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if runtimeControllerIdlePeriod > 0 {
+		setupLog.Info("Registering runtime controller scaler to Fluid controller manager.", "idlePeriod", runtimeControllerIdlePeriod)
+		if err = mgr.Add(deploy.NewRuntimeControllerScaler(mgr.GetClient(),
+			ctrl.Log.WithName("datasetctl").WithName("RuntimeControllerScaler"),
+			mgr.GetEventRecorderFor("Dataset"),
+			runtimeControllerIdlePeriod,
+		)); err != nil {
+			setupLog.Error(err, "unable to add runtime controller scaler")
+			os.Exit(1)
+		}
+	}
+
 	if fluidDiscovery.ResourceEnabled("dataload") {
 		setupLog.Info("Registering DataLoad reconciler to Fluid controller manager.")
 		if err = (dataloadctl.NewDataLoadReconciler(mgr.GetClient(),
@@ -229,6 +257,32 @@ func handle() {
 		}
 	}
 
+	if fluidDiscovery.ResourceEnabled("dataflow") {
+		setupLog.Info("Registering DataFlow controller to Fluid controller manager.")
+		if err = (dataflowctl.NewFlowReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("dataflowctl").WithName("DataFlow"),
+			mgr.GetEventRecorderFor("DataFlow"),
+			time.Duration(5*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataFlow")
+			os.Exit(1)
+		}
+	}
+
+	if enableGlobalDataset && fluidDiscovery.ResourceEnabled("globaldataset") {
+		setupLog.Info("Registering GlobalDataset reconciler to Fluid controller manager.")
+		if err = (globaldatasetctl.NewGlobalDatasetReconciler(mgr.GetClient(),
+			mgr.GetAPIReader(),
+			ctrl.Log.WithName("globaldatasetctl").WithName("GlobalDataset"),
+			mgr.GetEventRecorderFor("GlobalDataset"),
+			time.Duration(30*time.Second),
+			globalDatasetKubeConfigNamespace,
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "GlobalDataset")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting dataset-controller")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running dataset-controller")
@@ -19,6 +19,8 @@ package app
 import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/fluidapp/cacheadmission"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/fluidapp/dataflowaffinity"
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	utilfeature "github.com/fluid-cloudnative/fluid/pkg/utils/feature"
@@ -67,6 +69,7 @@ var fluidAppCmd = &cobra.Command{
 func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
+	_ = datav1alpha1.AddToScheme(scheme)
 	fluidAppCmd.Flags().StringVarP(&metricsAddr, "metrics-addr", "", ":8080", "The address the metric endpoint binds to.")
 	fluidAppCmd.Flags().BoolVarP(&enableLeaderElection, "enable-leader-election", "", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	fluidAppCmd.Flags().StringVarP(&leaderElectionNamespace, "leader-election-namespace", "", "fluid-system", "The namespace in which the leader election resource will be created.")
@@ -134,6 +137,13 @@ func handle() {
 		}
 	}
 
+	if dataflow.Enabled(dataflow.CacheAwareAdmission) {
+		if err = cacheadmission.SetupWithManager(mgr, controllerOptions, ctrl.Log.WithName("cacheadmissionctrl")); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CacheAdmission")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting fluidapp-controller")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running fluidapp-controller")
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+	schedulerapp "k8s.io/kubernetes/cmd/kube-scheduler/app"
+
+	"github.com/fluid-cloudnative/fluid"
+	"github.com/fluid-cloudnative/fluid/pkg/scheduler/plugins/cachelocality"
+)
+
+// newStartCommand builds the kube-scheduler command with the plugins of fluid registered, which are enabled
+// by the profiles in the KubeSchedulerConfiguration passed by --config.
+func newStartCommand() *cobra.Command {
+	cmd := schedulerapp.NewSchedulerCommand(
+		schedulerapp.WithPlugin(cachelocality.Name, cachelocality.New),
+	)
+	cmd.Use = "start"
+	cmd.Short = "start fluid scheduler with the cache locality plugin"
+	cmd.Long = `The fluid scheduler is kube-scheduler with the FluidCacheLocality plugin registered, which scores
+the nodes by the bytes of the pod's datasets cached on them. Enable the plugin in the profiles of
+the KubeSchedulerConfiguration passed by --config.`
+
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		// print fluid version
+		fluid.LogVersion()
+		return run(cmd, args)
+	}
+	return cmd
+}
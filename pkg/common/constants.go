@@ -43,6 +43,19 @@ const (
 	RuntimeDeprecated = "RuntimeDeprecated"
 
 	RuntimeWithSecretNotSupported = "RuntimeWithSecretNotSupported"
+
+	RuntimeControllerScaledIn = "RuntimeControllerScaledIn"
+
+	ErrorSyncMemberCluster = "ErrorSyncMemberCluster"
+)
+
+// Events related to cache-aware admission of workloads
+const (
+	WorkloadHeld = "WorkloadHeld"
+
+	WorkloadAdmitted = "WorkloadAdmitted"
+
+	WorkloadAdmissionFailed = "WorkloadAdmissionFailed"
 )
 
 // Events related to all type of Data Operations
@@ -65,7 +78,13 @@ const (
 
 	DataOperationCollision = "DataOperationCollision"
 
+	DataOperationTriggered = "DataOperationTriggered"
+
 	TargetSSHSecretNameNotSet = "TargetSSHSecretNameNotSet"
+
+	InvalidTimeWindow = "InvalidTimeWindow"
+
+	WaitingForTimeWindow = "WaitingForTimeWindow"
 )
 
 // Events related to dataflow
@@ -73,6 +92,14 @@ const (
 	DataOperationNotFound = "DataOperationNotFound"
 
 	DataOperationWaiting = "DataOperationWaiting"
+
+	DataOperationSkipped = "DataOperationSkipped"
+
+	DataFlowInvalid = "DataFlowInvalid"
+
+	DataFlowStepCreated = "DataFlowStepCreated"
+
+	DataFlowRunStarted = "DataFlowRunStarted"
 )
 
 // Events related to DataLoad
@@ -106,6 +133,10 @@ const (
 	DataProcessMultipleProcessorSpecified = "MultipleProcessorSpecified"
 
 	DataProcessConflictMountPath = "ConflictMountPath"
+
+	DataProcessInvalidSharding = "InvalidSharding"
+
+	DataProcessInvalidDatasets = "InvalidDatasets"
 )
 
 type CacheStoreType string
@@ -64,6 +64,20 @@ const (
 	// i.e. fluid.io/dataset.referring-namespace
 	LabelAnnotationDatasetReferringNameSpace = LabelAnnotationDataset + ".referring-namespace"
 
+	// LabelGlobalDataset is a label of the Dataset and the runtime created in member clusters by a GlobalDataset,
+	// the value is the name of the GlobalDataset.
+	// i.e. fluid.io/global-dataset
+	LabelGlobalDataset = LabelAnnotationPrefix + "global-dataset"
+
+	// LabelDataFlowName is a label of the data operations created by a DataFlow, the value is the name of the DataFlow.
+	// i.e. fluid.io/dataflow-name
+	LabelDataFlowName = LabelAnnotationPrefix + "dataflow-name"
+
+	// LabelDataFlowRun is a label of the data operations created by a DataFlow, the value is the run of the DataFlow
+	// in which the data operation is created.
+	// i.e. fluid.io/dataflow-run
+	LabelDataFlowRun = LabelAnnotationPrefix + "dataflow-run"
+
 	// LabelNodePublishMethod is a pv label that indicates the method nodePuhlishVolume use
 	// i.e. fluid.io/node-publish-method
 	LabelNodePublishMethod = LabelAnnotationPrefix + "node-publish-method"
@@ -116,6 +130,54 @@ const (
 	AnnotationDataFlowCustomizedAffinityPrefix = "affinity.dataflow.fluid.io."
 )
 
+const (
+	// AnnotationCacheAdmissionPrefix is the annotation prefix of the cache-aware admission, which holds a workload
+	// (a batch Job, a JobSet or a Kueue Workload) until the cache of the dataset is prewarmed. A Job or a JobSet is
+	// held only if it's created suspended.
+	// i.e. cache-admission.fluid.io/
+	AnnotationCacheAdmissionPrefix = "cache-admission." + LabelAnnotationPrefix
+	// AnnotationCacheAdmissionDataLoad is the DataLoad to wait for, in the format of "<namespace>/<name>" or "<name>".
+	// It must be in the namespace of the workload.
+	// i.e. cache-admission.fluid.io/dataload
+	AnnotationCacheAdmissionDataLoad = AnnotationCacheAdmissionPrefix + "dataload"
+	// AnnotationCacheAdmissionDataset is the Dataset whose cached percentage is checked, in the format of "<namespace>/<name>" or "<name>".
+	// It must be in the namespace of the workload.
+	// i.e. cache-admission.fluid.io/dataset
+	AnnotationCacheAdmissionDataset = AnnotationCacheAdmissionPrefix + "dataset"
+	// AnnotationCacheAdmissionCachedPercentage is the threshold of the cached percentage of the dataset, e.g. "80".
+	// i.e. cache-admission.fluid.io/cached-percentage
+	AnnotationCacheAdmissionCachedPercentage = AnnotationCacheAdmissionPrefix + "cached-percentage"
+	// AnnotationCacheAdmissionOnFinishDataMigrate is the json of a DataMigrateSpec to create when the workload finishes.
+	// It's created only if the service accounts of the workload are allowed to create DataMigrates.
+	// i.e. cache-admission.fluid.io/on-finish-datamigrate
+	AnnotationCacheAdmissionOnFinishDataMigrate = AnnotationCacheAdmissionPrefix + "on-finish-datamigrate"
+	// AnnotationCacheAdmissionOnFinishDataProcess is the json of a DataProcessSpec to create when the workload finishes,
+	// e.g. a script evicting the cache of the dataset. It's created only if the service accounts of the workload are
+	// allowed to create DataProcesses.
+	// i.e. cache-admission.fluid.io/on-finish-dataprocess
+	AnnotationCacheAdmissionOnFinishDataProcess = AnnotationCacheAdmissionPrefix + "on-finish-dataprocess"
+	// AnnotationCacheAdmissionState is the admission state of the workload, for internal use.
+	// i.e. cache-admission.fluid.io/state
+	AnnotationCacheAdmissionState = AnnotationCacheAdmissionPrefix + "state"
+)
+
+const (
+	// AnnotationReferenceAllowedNamespaces is a dataset annotation listing the namespaces (separated by commas) whose
+	// datasets are allowed to mount the dataset with the "dataset://" schema, "*" allows all the namespaces.
+	// Reference datasets in the same namespace are always allowed, and all the namespaces are allowed without the annotation.
+	// i.e. reference.dataset.fluid.io/allowed-namespaces
+	AnnotationReferenceAllowedNamespaces = "reference.dataset." + LabelAnnotationPrefix + "allowed-namespaces"
+	// AnnotationReferenceSecretsAllowedNamespaces is a dataset annotation listing the namespaces (separated by commas)
+	// to which the Secrets of the runtime are allowed to be copied for the reference datasets, "*" allows all the namespaces.
+	// The reference datasets in other namespaces are rejected if the fuse of the runtime depends on any Secret.
+	// i.e. reference.dataset.fluid.io/secrets-allowed-namespaces
+	AnnotationReferenceSecretsAllowedNamespaces = "reference.dataset." + LabelAnnotationPrefix + "secrets-allowed-namespaces"
+	// AnnotationReferenceSharedFrom is the source "<namespace>/<name>" of a ConfigMap or a Secret copied to the namespace
+	// of the reference dataset, for internal use.
+	// i.e. reference.dataset.fluid.io/shared-from
+	AnnotationReferenceSharedFrom = "reference.dataset." + LabelAnnotationPrefix + "shared-from"
+)
+
 const (
 	// AnnotationServerlessPlatform is an annotation key name for the platform type of serverless.
 	// i.e. serverless.fluid.io/platform
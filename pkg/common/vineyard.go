@@ -35,6 +35,10 @@ const (
 
 	DefultVineyardFuseImage = "registry.aliyuncs.com/vineyard/vineyard-fluid-fuse:v0.22.2"
 
+	VineyardDataLoaderImageEnv = "VINEYARD_DATALOADER_IMAGE_ENV"
+
+	DefaultVineyardDataLoaderImage = "registry.aliyuncs.com/vineyard/vineyard-fluid-loader:v0.22.2"
+
 	VineyardEngineImpl = VineyardRuntime
 )
 
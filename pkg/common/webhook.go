@@ -26,6 +26,9 @@ const (
 	WebhookServiceName     = "fluid-pod-admission-webhook"
 	WebhookSchedulePodPath = "mutate-fluid-io-v1alpha1-schedulepod"
 
+	ValidatingWebhookName            = "fluid-resource-validating-webhook"
+	WebhookValidateFluidResourcePath = "validate-fluid-io-v1alpha1-resources"
+
 	CertSecretName = "fluid-webhook-certs"
 
 	WebhookPluginFilePath = "/etc/fluid/plugins.profile"
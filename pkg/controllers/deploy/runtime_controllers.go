@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"strings"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/efc"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/goosefs"
@@ -53,6 +54,17 @@ func setPrecheckFunc(checks map[string]CheckFunc) {
 	precheckFuncs = checks
 }
 
+// runtimeListFuncs creates the lists of the runtimes managed by the runtime controllers
+var runtimeListFuncs = map[string]func() client.ObjectList{
+	"alluxioruntime-controller":  func() client.ObjectList { return &datav1alpha1.AlluxioRuntimeList{} },
+	"jindoruntime-controller":    func() client.ObjectList { return &datav1alpha1.JindoRuntimeList{} },
+	"juicefsruntime-controller":  func() client.ObjectList { return &datav1alpha1.JuiceFSRuntimeList{} },
+	"goosefsruntime-controller":  func() client.ObjectList { return &datav1alpha1.GooseFSRuntimeList{} },
+	"thinruntime-controller":     func() client.ObjectList { return &datav1alpha1.ThinRuntimeList{} },
+	"efcruntime-controller":      func() client.ObjectList { return &datav1alpha1.EFCRuntimeList{} },
+	"vineyardruntime-controller": func() client.ObjectList { return &datav1alpha1.VineyardRuntimeList{} },
+}
+
 func init() {
 	allPrecheckFuncs := map[string]CheckFunc{
 		"alluxioruntime-controller":  alluxio.Precheck,
@@ -90,12 +102,9 @@ func ScaleoutRuntimeControllerOnDemand(c client.Client, datasetKey types.Namespa
 		}
 
 		if match {
-			namespace, err := utils.GetEnvByKey(common.MyPodNamespace)
+			namespace, err := getRuntimeControllerNamespace()
 			if err != nil {
-				return controllerName, scaleout, errors.Wrapf(err, "get namespace from env failed, env key:%s", common.MyPodNamespace)
-			}
-			if namespace == "" {
-				namespace = common.NamespaceFluidSystem
+				return controllerName, scaleout, err
 			}
 			scaleout, err = scaleoutDeploymentIfNeeded(c, types.NamespacedName{
 				Namespace: namespace,
@@ -114,6 +123,18 @@ func ScaleoutRuntimeControllerOnDemand(c client.Client, datasetKey types.Namespa
 	return controllerName, scaleout, fmt.Errorf("no matched controller for dataset %s", datasetKey)
 }
 
+// getRuntimeControllerNamespace gets the namespace where the runtime controllers are deployed.
+func getRuntimeControllerNamespace() (namespace string, err error) {
+	namespace, err = utils.GetEnvByKey(common.MyPodNamespace)
+	if err != nil {
+		return "", errors.Wrapf(err, "get namespace from env failed, env key:%s", common.MyPodNamespace)
+	}
+	if namespace == "" {
+		namespace = common.NamespaceFluidSystem
+	}
+	return namespace, nil
+}
+
 // scaleoutDeploymentIfNeeded scales out runtime controller deployments if the current replica of it is 0.
 func scaleoutDeploymentIfNeeded(c client.Client, key types.NamespacedName, log logr.Logger) (scale bool, err error) {
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() (err error) {
@@ -157,3 +178,33 @@ func scaleoutDeploymentIfNeeded(c client.Client, key types.NamespacedName, log l
 
 	return
 }
+
+// scaleinDeploymentIfNeeded scales in runtime controller deployments to 0 if the current replica of it is not 0.
+func scaleinDeploymentIfNeeded(c client.Client, key types.NamespacedName, log logr.Logger) (scale bool, err error) {
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() (err error) {
+		deploy := &appsv1.Deployment{}
+		err = c.Get(context.TODO(), key, deploy)
+		if err != nil {
+			return err
+		}
+		if deploy.Spec.Replicas != nil && *deploy.Spec.Replicas == 0 {
+			log.V(1).Info("No need to scale in runtime controller, skip", "key", key)
+			return nil
+		}
+
+		deployToUpdate := deploy.DeepCopy()
+		deployToUpdate.Spec.Replicas = ptr.To[int32](0)
+		err = c.Update(context.TODO(), deployToUpdate)
+		if err != nil {
+			return err
+		}
+		scale = true
+		return nil
+	})
+
+	if err != nil {
+		log.Error(err, "Failed to scale deployment", "key", key)
+	}
+
+	return
+}
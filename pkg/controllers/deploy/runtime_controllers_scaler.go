@@ -0,0 +1,164 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// defaultIdleCheckPeriod is the max period to check if the runtime controllers are idle
+const defaultIdleCheckPeriod = time.Minute
+
+// RuntimeControllerScaler scales in the runtime controllers which have been idle for the given period.
+// A runtime controller is idle when no runtime it manages exists.
+type RuntimeControllerScaler struct {
+	client      client.Client
+	log         logr.Logger
+	recorder    record.EventRecorder
+	idlePeriod  time.Duration
+	checkPeriod time.Duration
+
+	// idleSince records when each runtime controller is found idle
+	idleSince map[string]time.Time
+}
+
+var _ manager.LeaderElectionRunnable = &RuntimeControllerScaler{}
+
+// NewRuntimeControllerScaler creates a scaler which scales in the runtime controllers idle for idlePeriod.
+func NewRuntimeControllerScaler(c client.Client, log logr.Logger, recorder record.EventRecorder, idlePeriod time.Duration) *RuntimeControllerScaler {
+	checkPeriod := defaultIdleCheckPeriod
+	if idlePeriod < checkPeriod {
+		checkPeriod = idlePeriod
+	}
+
+	return &RuntimeControllerScaler{
+		client:      c,
+		log:         log,
+		recorder:    recorder,
+		idlePeriod:  idlePeriod,
+		checkPeriod: checkPeriod,
+		idleSince:   map[string]time.Time{},
+	}
+}
+
+// Start checks the idle runtime controllers periodically until the context is done.
+func (s *RuntimeControllerScaler) Start(ctx context.Context) error {
+	s.log.Info("start scaling in idle runtime controllers", "idlePeriod", s.idlePeriod)
+	wait.UntilWithContext(ctx, s.scaleinIdleRuntimeControllers, s.checkPeriod)
+	return nil
+}
+
+// NeedLeaderElection makes sure only the leader scales in the runtime controllers.
+func (s *RuntimeControllerScaler) NeedLeaderElection() bool {
+	return true
+}
+
+func (s *RuntimeControllerScaler) scaleinIdleRuntimeControllers(ctx context.Context) {
+	inUse, err := getRuntimeControllersInUse(s.client)
+	if err != nil {
+		s.log.Error(err, "Failed to get runtime controllers in use")
+		return
+	}
+
+	now := time.Now()
+	for controllerName := range precheckFuncs {
+		if inUse[controllerName] {
+			delete(s.idleSince, controllerName)
+			continue
+		}
+
+		since, found := s.idleSince[controllerName]
+		if !found {
+			s.idleSince[controllerName] = now
+			continue
+		}
+		if now.Sub(since) < s.idlePeriod {
+			continue
+		}
+
+		// check again in case a runtime is created while checking others
+		inUse, err = getRuntimeControllersInUse(s.client)
+		if err != nil {
+			s.log.Error(err, "Failed to get runtime controllers in use")
+			return
+		}
+		if inUse[controllerName] {
+			delete(s.idleSince, controllerName)
+			continue
+		}
+
+		namespace, err := getRuntimeControllerNamespace()
+		if err != nil {
+			s.log.Error(err, "Failed to get namespace of runtime controllers")
+			return
+		}
+		key := types.NamespacedName{Namespace: namespace, Name: controllerName}
+		scalein, err := scaleinDeploymentIfNeeded(s.client, key, s.log)
+		if err != nil {
+			continue
+		}
+		if scalein {
+			s.log.Info("scale in the idle runtime controller successfully", "controller", controllerName, "idleSince", since)
+			s.recordScaleinEvent(ctx, key, now.Sub(since))
+		}
+	}
+}
+
+func (s *RuntimeControllerScaler) recordScaleinEvent(ctx context.Context, key types.NamespacedName, idleDuration time.Duration) {
+	if s.recorder == nil {
+		return
+	}
+
+	deploy := &appsv1.Deployment{}
+	if err := s.client.Get(ctx, key, deploy); err != nil {
+		s.log.Error(err, "Failed to get deployment for recording event", "key", key)
+		return
+	}
+	s.recorder.Eventf(deploy, corev1.EventTypeNormal, common.RuntimeControllerScaledIn,
+		"Runtime controller %s is scaled in to 0 because no runtime it manages exists for %s", key.Name, idleDuration.Round(time.Second))
+}
+
+// getRuntimeControllersInUse returns the runtime controllers which manage any runtime. The runtimes are listed
+// instead of the datasets, because a runtime still needs its controller to clean it up after its dataset is deleted.
+func getRuntimeControllersInUse(c client.Client) (inUse map[string]bool, err error) {
+	inUse = map[string]bool{}
+	for controllerName := range precheckFuncs {
+		newRuntimeList, found := runtimeListFuncs[controllerName]
+		if !found {
+			continue
+		}
+		runtimeList := newRuntimeList()
+		if err = c.List(context.TODO(), runtimeList, client.Limit(1)); err != nil {
+			return nil, err
+		}
+		inUse[controllerName] = meta.LenList(runtimeList) > 0
+	}
+
+	return inUse, nil
+}
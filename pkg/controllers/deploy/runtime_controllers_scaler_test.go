@@ -0,0 +1,147 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+func TestScaleinIdleRuntimeControllers(t *testing.T) {
+	t.Setenv(common.MyPodNamespace, common.NamespaceFluidSystem)
+
+	s := runtime.NewScheme()
+	_ = appsv1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+
+	objs := []runtime.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "alluxioruntime-controller", Namespace: common.NamespaceFluidSystem},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "juicefsruntime-controller", Namespace: common.NamespaceFluidSystem},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		},
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: corev1.NamespaceDefault},
+		},
+		&datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: corev1.NamespaceDefault},
+		},
+	}
+	fakeClient := fake.NewFakeClientWithScheme(s, objs...)
+
+	setPrecheckFunc(map[string]CheckFunc{
+		"alluxioruntime-controller": alluxio.Precheck,
+		"juicefsruntime-controller": juicefs.Precheck,
+	})
+
+	recorder := record.NewFakeRecorder(10)
+	scaler := NewRuntimeControllerScaler(fakeClient, fake.NullLogger(), recorder, 30*time.Minute)
+
+	getReplicas := func(name string) int32 {
+		deploy := &appsv1.Deployment{}
+		if err := fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: common.NamespaceFluidSystem, Name: name}, deploy); err != nil {
+			t.Fatalf("failed to get deployment %s: %v", name, err)
+		}
+		return *deploy.Spec.Replicas
+	}
+
+	// the first check only finds the idle controller
+	scaler.scaleinIdleRuntimeControllers(context.TODO())
+	if _, found := scaler.idleSince["juicefsruntime-controller"]; !found {
+		t.Errorf("expected juicefsruntime-controller recorded as idle")
+	}
+	if _, found := scaler.idleSince["alluxioruntime-controller"]; found {
+		t.Errorf("expected alluxioruntime-controller not recorded as idle")
+	}
+	if replicas := getReplicas("juicefsruntime-controller"); replicas != 1 {
+		t.Errorf("expected juicefsruntime-controller not scaled in before idle period, get replicas %d", replicas)
+	}
+
+	// the controller is scaled in after it's idle for the idle period
+	scaler.idleSince["juicefsruntime-controller"] = time.Now().Add(-time.Hour)
+	scaler.scaleinIdleRuntimeControllers(context.TODO())
+	if replicas := getReplicas("juicefsruntime-controller"); replicas != 0 {
+		t.Errorf("expected juicefsruntime-controller scaled in to 0, get replicas %d", replicas)
+	}
+	if replicas := getReplicas("alluxioruntime-controller"); replicas != 1 {
+		t.Errorf("expected alluxioruntime-controller not scaled in, get replicas %d", replicas)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("expected 1 event recorded, get %d", len(recorder.Events))
+	}
+}
+
+func TestGetRuntimeControllersInUse(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+
+	objs := []runtime.Object{
+		// the runtime is still in use after its dataset is deleted
+		&datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: corev1.NamespaceDefault},
+		},
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: corev1.NamespaceDefault},
+		},
+	}
+	fakeClient := fake.NewFakeClientWithScheme(s, objs...)
+
+	setPrecheckFunc(map[string]CheckFunc{
+		"alluxioruntime-controller": alluxio.Precheck,
+		"juicefsruntime-controller": juicefs.Precheck,
+	})
+
+	inUse, err := getRuntimeControllersInUse(fakeClient)
+	if err != nil {
+		t.Fatalf("failed to get runtime controllers in use: %v", err)
+	}
+	if !inUse["juicefsruntime-controller"] {
+		t.Errorf("expected juicefsruntime-controller in use")
+	}
+	if inUse["alluxioruntime-controller"] {
+		t.Errorf("expected alluxioruntime-controller not in use")
+	}
+}
+
+func TestNewRuntimeControllerScaler(t *testing.T) {
+	scaler := NewRuntimeControllerScaler(nil, fake.NullLogger(), nil, 10*time.Second)
+	if scaler.checkPeriod != 10*time.Second {
+		t.Errorf("expected check period not longer than idle period, get %v", scaler.checkPeriod)
+	}
+
+	scaler = NewRuntimeControllerScaler(nil, fake.NullLogger(), nil, time.Hour)
+	if scaler.checkPeriod != defaultIdleCheckPeriod {
+		t.Errorf("expected default check period, get %v", scaler.checkPeriod)
+	}
+}
@@ -17,7 +17,6 @@
 package controllers
 
 import (
-	"fmt"
 	"sync"
 	"time"
 
@@ -36,9 +35,9 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
-	jindoutils "github.com/fluid-cloudnative/fluid/pkg/utils/jindo"
 )
 
 // OperationReconciler is the default implementation
@@ -99,6 +98,7 @@ func (o *OperationReconciler) ReconcileDeletion(ctx dataoperation.ReconcileReque
 	for _, namespacedName := range namespacedNames {
 		o.RemoveEngine(namespacedName)
 	}
+	o.ForgetMetrics(implement)
 
 	object := implement.GetOperationObject()
 	// 4. remove finalizer
@@ -199,8 +199,10 @@ func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileReque
 		return o.addOwnerAndRequeue(ctx, object, targetDataset)
 	}
 
-	// 8. do the data operation
-	return engine.Operate(ctx.ReconcileRequestContext, ctx.OpStatus, implement)
+	// 8. do the data operation, the metrics are recorded when the status of the data operation is updated
+	metrics.GetOrCreateDataOperationMetrics(string(implement.GetOperationType()), object.GetNamespace(), object.GetName()).
+		SetWaitingRunAfter(isWaitingRunAfter(ctx.OpStatus))
+	return engine.Operate(ctx.ReconcileRequestContext, ctx.OpStatus, newOperationWithMetrics(implement, ctx.OpStatus))
 }
 
 // GetOrCreateEngine gets the Engine
@@ -275,53 +277,6 @@ func (o *OperationReconciler) addOwnerAndRequeue(ctx dataoperation.ReconcileRequ
 
 // getRuntimeObjectAndEngineImpl firstly gets a runtime object given its name, namespace and runtimeType, and then infer its engine implementation
 // from the retrieved runtime object.
-// TODO: Maybe the function can be separated into two sub-function and make them globally accessible.
 func (o *OperationReconciler) getRuntimeObjectAndEngineImpl(runtimeType, name, namespace string) (obj client.Object, engineImpl string, err error) {
-	// support all runtime
-	var runtime base.RuntimeInterface
-	switch runtimeType {
-	case common.AlluxioRuntime:
-		runtime, err = utils.GetAlluxioRuntime(o.Client, name, namespace)
-	case common.JindoRuntime:
-		runtime, err = utils.GetJindoRuntime(o.Client, name, namespace)
-	case common.GooseFSRuntime:
-		runtime, err = utils.GetGooseFSRuntime(o.Client, name, namespace)
-	case common.JuiceFSRuntime:
-		runtime, err = utils.GetJuiceFSRuntime(o.Client, name, namespace)
-	case common.EFCRuntime:
-		runtime, err = utils.GetEFCRuntime(o.Client, name, namespace)
-	case common.ThinRuntime:
-		runtime, err = utils.GetThinRuntime(o.Client, name, namespace)
-	case common.VineyardRuntime:
-		runtime, err = utils.GetVineyardRuntime(o.Client, name, namespace)
-	}
-
-	if err != nil {
-		return
-	}
-
-	if runtimeType == common.ThinRuntime {
-		// ThinRuntime cannot use ddc.InferEngineImpl because ReferenceDatasetEngine inherits valueFile property
-		// from its physical runtime.
-		// TODO: We should determine whether ReferenceDatasetEngine is an engineImpl of ThinRuntime.
-		return runtime, common.ThinEngineImpl, nil
-	}
-
-	switch runtimeType {
-	case common.AlluxioRuntime:
-		return runtime, ddc.InferEngineImpl(*runtime.GetStatus(), common.AlluxioEngineImpl), nil
-	case common.JindoRuntime:
-		return runtime, ddc.InferEngineImpl(*runtime.GetStatus(), jindoutils.GetDefaultEngineImpl()), nil
-	case common.GooseFSRuntime:
-		return runtime, ddc.InferEngineImpl(*runtime.GetStatus(), common.GooseFSEngineImpl), nil
-	case common.JuiceFSRuntime:
-		return runtime, ddc.InferEngineImpl(*runtime.GetStatus(), common.JuiceFSEngineImpl), nil
-	case common.EFCRuntime:
-		return runtime, ddc.InferEngineImpl(*runtime.GetStatus(), common.EFCEngineImpl), nil
-	case common.VineyardRuntime:
-		return runtime, ddc.InferEngineImpl(*runtime.GetStatus(), common.VineyardEngineImpl), nil
-	}
-
-	err = fmt.Errorf("runtimeType %s is not supported", runtimeType)
-	return
+	return ddc.GetRuntimeObjectAndEngineImpl(o.Client, runtimeType, name, namespace)
 }
@@ -0,0 +1,95 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
+)
+
+// operationWithMetrics wraps the data operation and records the metrics of it every time its status is updated,
+// so that the phase transitions made in any step of the template engine are observed in one place.
+type operationWithMetrics struct {
+	dataoperation.OperationInterface
+
+	// phase is the last phase observed of the data operation
+	phase common.Phase
+}
+
+func newOperationWithMetrics(implement dataoperation.OperationInterface, opStatus *datav1alpha1.OperationStatus) *operationWithMetrics {
+	return &operationWithMetrics{
+		OperationInterface: implement,
+		phase:              opStatus.Phase,
+	}
+}
+
+// UpdateOperationApiStatus updates the status of the data operation, and records the metrics once the update succeeds.
+func (o *operationWithMetrics) UpdateOperationApiStatus(opStatus *datav1alpha1.OperationStatus) error {
+	if err := o.OperationInterface.UpdateOperationApiStatus(opStatus); err != nil {
+		return err
+	}
+
+	object := o.GetOperationObject()
+	m := metrics.GetOrCreateDataOperationMetrics(string(o.GetOperationType()), object.GetNamespace(), object.GetName())
+	m.SetWaitingRunAfter(isWaitingRunAfter(opStatus))
+
+	if opStatus.Phase == o.phase {
+		return nil
+	}
+	o.phase = opStatus.Phase
+	m.PhaseTransitionInc(string(opStatus.Phase))
+
+	switch opStatus.Phase {
+	case common.PhaseComplete:
+		m.ObserveDuration(string(opStatus.Phase), opStatus.Duration)
+		if isCronOperation(object) {
+			m.CronSucceededInc()
+		}
+	case common.PhaseFailed:
+		m.ObserveDuration(string(opStatus.Phase), opStatus.Duration)
+		if isCronOperation(object) {
+			m.CronFailedInc()
+		}
+	}
+	return nil
+}
+
+// ForgetMetrics deletes related metrics in prometheus metrics to avoid memory inflation
+func (o *OperationReconciler) ForgetMetrics(implement dataoperation.OperationInterface) {
+	object := implement.GetOperationObject()
+	metrics.GetOrCreateDataOperationMetrics(string(implement.GetOperationType()), object.GetNamespace(), object.GetName()).Forget()
+}
+
+func isWaitingRunAfter(opStatus *datav1alpha1.OperationStatus) bool {
+	return opStatus.WaitingFor.OperationComplete != nil && *opStatus.WaitingFor.OperationComplete
+}
+
+func isCronOperation(object client.Object) bool {
+	switch obj := object.(type) {
+	case *datav1alpha1.DataLoad:
+		return obj.Spec.Policy == datav1alpha1.Cron
+	case *datav1alpha1.DataMigrate:
+		return obj.Spec.Policy == datav1alpha1.Cron
+	case *datav1alpha1.DataProcess:
+		return obj.Spec.Policy == datav1alpha1.Cron
+	}
+	return false
+}
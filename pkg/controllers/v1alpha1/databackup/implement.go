@@ -59,7 +59,7 @@ func (r *dataBackupOperation) GetChartsDirectory() string {
 }
 
 func (r *dataBackupOperation) HasPrecedingOperation() bool {
-	return r.dataBackup.Spec.RunAfter != nil
+	return r.dataBackup.Spec.RunAfter != nil || r.dataBackup.Spec.RunAfterList != nil
 }
 
 func (r *dataBackupOperation) UpdateStatusInfoForCompleted(infos map[string]string) error {
@@ -122,6 +122,11 @@ func (r *dataBackupOperation) GetTargetDataset() (*datav1alpha1.Dataset, error)
 	return utils.GetDataset(r.Client, r.dataBackup.Spec.Dataset, r.dataBackup.Namespace)
 }
 
+// GetRelatedDatasetNamespacedNames returns nil, only the target dataset is locked by the DataBackup.
+func (r *dataBackupOperation) GetRelatedDatasetNamespacedNames() []types.NamespacedName {
+	return nil
+}
+
 func (r *dataBackupOperation) GetReleaseNameSpacedName() types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: r.dataBackup.GetNamespace(),
@@ -149,3 +154,7 @@ func (r *dataBackupOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataBackupOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
+
+func (r *dataBackupOperation) GetThrottle() *datav1alpha1.Throttle {
+	return nil
+}
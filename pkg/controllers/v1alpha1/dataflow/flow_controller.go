@@ -0,0 +1,346 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/validation"
+)
+
+const flowControllerName string = "DataFlowController"
+
+// FlowReconciler reconciles a DataFlow object. It creates a data operation for every step with the DataFlow as
+// the controller owner, chains them by RunAfterList and aggregates their status. The data operations themselves
+// are run by their own controllers.
+type FlowReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+type flowRequestContext struct {
+	context.Context
+	Log  logr.Logger
+	Flow datav1alpha1.DataFlow
+}
+
+func NewFlowReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *FlowReconciler {
+	return &FlowReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataflows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataflows/status,verbs=get;update;patch
+
+func (r *FlowReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := flowRequestContext{
+		Context: context,
+		Log:     r.Log.WithValues("dataflow", req.NamespacedName),
+	}
+	ctx.Log.V(1).Info("process the request", "request", req)
+
+	if err := r.Get(ctx, req.NamespacedName, &ctx.Flow); err != nil {
+		if utils.IgnoreNotFound(err) != nil {
+			ctx.Log.Error(err, "failed to get dataflow")
+			return utils.RequeueIfError(err)
+		}
+		ctx.Log.V(1).Info("Not found.")
+		return utils.NoRequeue()
+	}
+
+	// The data operations are garbage collected with the DataFlow by the owner references
+	if utils.HasDeletionTimestamp(ctx.Flow.ObjectMeta) {
+		return utils.NoRequeue()
+	}
+
+	return r.reconcileDataFlow(ctx)
+}
+
+// reconcileDataFlow creates the data operations of the current run and updates the aggregated status
+func (r *FlowReconciler) reconcileDataFlow(ctx flowRequestContext) (ctrl.Result, error) {
+	flow := &ctx.Flow
+
+	// 1. Validate the steps, the invalid DataFlow is reconciled again once its spec changes
+	sorted, err := validateDataFlow(flow)
+	if err != nil {
+		ctx.Log.Info("The dataflow is invalid", "error", err.Error())
+		r.Recorder.Eventf(flow, v1.EventTypeWarning, common.DataFlowInvalid, "The dataflow is invalid: %v", err)
+		// The running data operations are not affected, so only the DataFlow which hasn't started fails
+		if flow.Status.Message != err.Error() || (flow.Status.Run == 0 && flow.Status.Phase != common.PhaseFailed) {
+			flowToUpdate := flow.DeepCopy()
+			flowToUpdate.Status.Message = err.Error()
+			if flow.Status.Run == 0 {
+				flowToUpdate.Status.Phase = common.PhaseFailed
+			}
+			if err = r.Status().Update(ctx, flowToUpdate); err != nil {
+				return utils.RequeueIfError(err)
+			}
+		}
+		return utils.NoRequeue()
+	}
+
+	// 2. Start the first run
+	if flow.Status.Run == 0 {
+		return r.startRun(ctx, nil)
+	}
+
+	// 3. Clean up the data operations of the previous runs before creating the new ones with the same names
+	cleaning, err := r.deleteStaleOperations(ctx)
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+	if cleaning {
+		ctx.Log.V(1).Info("Wait for the data operations of the previous runs to be deleted")
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+
+	// 4. Create the data operations and collect their status
+	previous := map[string]datav1alpha1.DataFlowStepStatus{}
+	for _, step := range flow.Status.Steps {
+		previous[step.Name] = step
+	}
+	var steps []datav1alpha1.DataFlowStepStatus
+	for i := range flow.Spec.Steps {
+		step, err := r.reconcileStep(ctx, &flow.Spec.Steps[i], previous[flow.Spec.Steps[i].Name])
+		if err != nil {
+			return utils.RequeueIfError(err)
+		}
+		steps = append(steps, step)
+	}
+
+	// 5. Update the aggregated status
+	status := *flow.Status.DeepCopy()
+	status.Steps = steps
+	status.CriticalPath = criticalPath(flow, sorted, steps)
+	status.Message = ""
+	status.Phase = aggregatePhase(steps)
+	if status.Phase == common.PhaseExecuting {
+		status.CompletionTime = nil
+		status.Duration = "Unfinished"
+	} else if status.CompletionTime == nil {
+		status.CompletionTime = &metav1.Time{Time: time.Now()}
+		status.Duration = utils.CalculateDuration(status.StartTime.Time, status.CompletionTime.Time)
+	}
+	if !reflect.DeepEqual(status, flow.Status) {
+		flowToUpdate := flow.DeepCopy()
+		flowToUpdate.Status = status
+		if err = r.Status().Update(ctx, flowToUpdate); err != nil {
+			ctx.Log.Error(err, "Failed to update the dataflow status")
+			return utils.RequeueIfError(err)
+		}
+		ctx.Log.V(1).Info("Update the status of the dataflow successfully", "phase", status.Phase, "run", status.Run)
+		*flow = *flowToUpdate
+	}
+
+	// 6. Schedule the next run after the current run finishes
+	if status.Phase == common.PhaseExecuting || flow.Spec.Schedule == "" {
+		return utils.NoRequeue()
+	}
+	return r.scheduleNextRun(ctx)
+}
+
+// reconcileStep creates the data operation of the step if it's not created in the current run, and returns the
+// status of the step.
+func (r *FlowReconciler) reconcileStep(ctx flowRequestContext, step *datav1alpha1.DataFlowStep, previous datav1alpha1.DataFlowStepStatus) (status datav1alpha1.DataFlowStepStatus, err error) {
+	flow := &ctx.Flow
+	status = datav1alpha1.DataFlowStepStatus{Name: step.Name}
+	ref := stepOperationRef(flow, step)
+
+	object := newOperationObject(ref.Kind)
+	err = r.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, object)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return status, err
+	}
+
+	if apierrs.IsNotFound(err) {
+		// The operation is recorded in the status only after it's observed
+		if previous.Operation.Name == "" {
+			return status, r.createStepOperation(ctx, step)
+		}
+		// The data operation is deleted after it's observed, e.g. by its ttlSecondsAfterFinished,
+		// keep the last observed status instead of running it again
+		status = previous
+		if !isStepFinished(status) {
+			status.Phase = common.PhaseFailed
+		}
+		return status, nil
+	}
+
+	if !metav1.IsControlledBy(object, flow) {
+		return status, fmt.Errorf("%s %s already exists and is not controlled by the dataflow", ref.Kind, ref.Name)
+	}
+	status.Operation = ref
+	opStatus, err := utils.GetOperationStatus(object)
+	if err != nil {
+		return status, err
+	}
+	status.Phase = opStatus.Phase
+	status.Skipped = utils.IsDataOperationSkipped(opStatus)
+	status.Duration = opStatus.Duration
+	return status, nil
+}
+
+func (r *FlowReconciler) createStepOperation(ctx flowRequestContext, step *datav1alpha1.DataFlowStep) error {
+	object, err := buildStepOperation(&ctx.Flow, step)
+	if err != nil {
+		return err
+	}
+	if err = r.Create(ctx, object); err != nil {
+		// The data operation may not be synced to the cache yet
+		if apierrs.IsAlreadyExists(err) {
+			return nil
+		}
+		ctx.Log.Error(err, "Failed to create the data operation of the step", "step", step.Name)
+		return err
+	}
+	ctx.Log.Info("Create the data operation of the step", "step", step.Name, "name", object.GetName())
+	r.Recorder.Eventf(&ctx.Flow, v1.EventTypeNormal, common.DataFlowStepCreated, "Created %s %s for step %s",
+		stepOperationKind(step), object.GetName(), step.Name)
+	return nil
+}
+
+// deleteStaleOperations deletes the data operations created in the previous runs, and returns true if any of
+// them still exists.
+func (r *FlowReconciler) deleteStaleOperations(ctx flowRequestContext) (cleaning bool, err error) {
+	flow := &ctx.Flow
+	lists := []client.ObjectList{
+		&datav1alpha1.DataLoadList{},
+		&datav1alpha1.DataMigrateList{},
+		&datav1alpha1.DataProcessList{},
+		&datav1alpha1.DataBackupList{},
+	}
+	for _, list := range lists {
+		err = r.List(ctx, list, client.InNamespace(flow.Namespace), client.MatchingLabels{common.LabelDataFlowName: flow.Name})
+		if err != nil {
+			// The CRD of the data operation may not be installed
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return false, err
+		}
+		objects, err := meta.ExtractList(list)
+		if err != nil {
+			return false, err
+		}
+		for _, item := range objects {
+			object := item.(client.Object)
+			if !metav1.IsControlledBy(object, flow) || object.GetLabels()[common.LabelDataFlowRun] == strconv.Itoa(int(flow.Status.Run)) {
+				continue
+			}
+			cleaning = true
+			if utils.HasDeletionTimestamp(metav1.ObjectMeta{DeletionTimestamp: object.GetDeletionTimestamp()}) {
+				continue
+			}
+			ctx.Log.Info("Delete the data operation of the previous run", "name", object.GetName())
+			if err = r.Delete(ctx, object); utils.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+		}
+	}
+	return cleaning, nil
+}
+
+// scheduleNextRun starts a new run if the schedule is due, otherwise requeues until then
+func (r *FlowReconciler) scheduleNextRun(ctx flowRequestContext) (ctrl.Result, error) {
+	flow := &ctx.Flow
+	schedule, err := validation.ParseCronSchedule(flow.Spec.Schedule)
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+
+	last := flow.CreationTimestamp.Time
+	if flow.Status.LastScheduleTime != nil {
+		last = flow.Status.LastScheduleTime.Time
+	}
+	next := schedule.Next(last)
+	if next.IsZero() {
+		ctx.Log.Info("No more runs are scheduled", "schedule", flow.Spec.Schedule)
+		return utils.NoRequeue()
+	}
+	if now := time.Now(); now.Before(next) {
+		return utils.RequeueAfterInterval(next.Sub(now))
+	}
+
+	// The missed runs are not made up, a single run is started for them
+	return r.startRun(ctx, &metav1.Time{Time: time.Now()})
+}
+
+// startRun resets the status for a new run. scheduleTime is nil for the first run, which starts once the DataFlow
+// is created.
+func (r *FlowReconciler) startRun(ctx flowRequestContext, scheduleTime *metav1.Time) (ctrl.Result, error) {
+	flowToUpdate := ctx.Flow.DeepCopy()
+	flowToUpdate.Status = datav1alpha1.DataFlowStatus{
+		Phase:            common.PhaseExecuting,
+		Run:              ctx.Flow.Status.Run + 1,
+		StartTime:        &metav1.Time{Time: time.Now()},
+		Duration:         "Unfinished",
+		LastScheduleTime: ctx.Flow.Status.LastScheduleTime,
+	}
+	if scheduleTime != nil {
+		flowToUpdate.Status.LastScheduleTime = scheduleTime
+	}
+	if err := r.Status().Update(ctx, flowToUpdate); err != nil {
+		ctx.Log.Error(err, "Failed to start a new run of the dataflow")
+		return utils.RequeueIfError(err)
+	}
+	ctx.Log.Info("Start a new run of the dataflow", "run", flowToUpdate.Status.Run)
+	r.Recorder.Eventf(flowToUpdate, v1.EventTypeNormal, common.DataFlowRunStarted, "Run %d of the dataflow is started", flowToUpdate.Status.Run)
+	return utils.RequeueImmediately()
+}
+
+func (r *FlowReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	bld := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DataFlow{})
+	for kind, obj := range reconcileKinds {
+		if discovery.GetFluidDiscovery().ResourceEnabled(kind) {
+			bld = bld.Owns(obj)
+		}
+	}
+	return bld.Complete(r)
+}
+
+func (r *FlowReconciler) ControllerName() string {
+	return flowControllerName
+}
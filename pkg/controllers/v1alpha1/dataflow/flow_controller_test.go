@@ -0,0 +1,278 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func newTestDataFlow(steps ...datav1alpha1.DataFlowStep) *datav1alpha1.DataFlow {
+	return &datav1alpha1.DataFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: "flow", Namespace: "default", UID: "flow-uid"},
+		Spec:       datav1alpha1.DataFlowSpec{Steps: steps},
+	}
+}
+
+func TestValidateDataFlow(t *testing.T) {
+	load := &datav1alpha1.DataLoadSpec{}
+	tests := []struct {
+		name       string
+		flow       *datav1alpha1.DataFlow
+		wantSorted []string
+		wantErr    string
+	}{
+		{
+			name: "valid",
+			flow: newTestDataFlow(
+				datav1alpha1.DataFlowStep{Name: "process", DataProcess: &datav1alpha1.DataProcessSpec{}, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "load"}}},
+				datav1alpha1.DataFlowStep{Name: "load", DataLoad: load},
+			),
+			wantSorted: []string{"load", "process"},
+		},
+		{
+			name: "duplicated",
+			flow: newTestDataFlow(
+				datav1alpha1.DataFlowStep{Name: "load", DataLoad: load},
+				datav1alpha1.DataFlowStep{Name: "load", DataLoad: load},
+			),
+			wantErr: "duplicated",
+		},
+		{
+			name:    "no operation",
+			flow:    newTestDataFlow(datav1alpha1.DataFlowStep{Name: "load"}),
+			wantErr: "exactly one",
+		},
+		{
+			name:    "two operations",
+			flow:    newTestDataFlow(datav1alpha1.DataFlowStep{Name: "load", DataLoad: load, DataBackup: &datav1alpha1.DataBackupSpec{}}),
+			wantErr: "exactly one",
+		},
+		{
+			name:    "runAfter in template",
+			flow:    newTestDataFlow(datav1alpha1.DataFlowStep{Name: "load", DataLoad: &datav1alpha1.DataLoadSpec{RunAfter: &datav1alpha1.OperationRef{}}}),
+			wantErr: "must not set runAfter",
+		},
+		{
+			name:    "cron policy",
+			flow:    newTestDataFlow(datav1alpha1.DataFlowStep{Name: "load", DataLoad: &datav1alpha1.DataLoadSpec{Policy: datav1alpha1.Cron}}),
+			wantErr: "Once policy",
+		},
+		{
+			name:    "step not found",
+			flow:    newTestDataFlow(datav1alpha1.DataFlowStep{Name: "load", DataLoad: load, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "backup"}}}),
+			wantErr: "not found",
+		},
+		{
+			name: "cycle",
+			flow: newTestDataFlow(
+				datav1alpha1.DataFlowStep{Name: "a", DataLoad: load, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "b"}}},
+				datav1alpha1.DataFlowStep{Name: "b", DataLoad: load, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "a"}}},
+			),
+			wantErr: "cycle",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := validateDataFlow(tt.flow)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("validateDataFlow() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(sorted, tt.wantSorted) {
+				t.Errorf("validateDataFlow() = %v, %v, want %v", sorted, err, tt.wantSorted)
+			}
+		})
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	load := &datav1alpha1.DataLoadSpec{}
+	flow := newTestDataFlow(
+		datav1alpha1.DataFlowStep{Name: "load", DataLoad: load},
+		datav1alpha1.DataFlowStep{Name: "fast", DataLoad: load, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "load"}}},
+		datav1alpha1.DataFlowStep{Name: "slow", DataLoad: load, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "load"}}},
+		datav1alpha1.DataFlowStep{Name: "merge", DataLoad: load, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "fast"}, {Step: "slow"}}},
+		datav1alpha1.DataFlowStep{Name: "cleanup", DataLoad: load, RunAfter: []datav1alpha1.DataFlowStepRef{{Step: "merge", Condition: datav1alpha1.OnFailureCondition}}},
+	)
+	sorted, err := validateDataFlow(flow)
+	if err != nil {
+		t.Fatalf("validateDataFlow() got error %v", err)
+	}
+	steps := []datav1alpha1.DataFlowStepStatus{
+		{Name: "load", Phase: common.PhaseComplete, Duration: "1m"},
+		{Name: "fast", Phase: common.PhaseComplete, Duration: "10s"},
+		{Name: "slow", Phase: common.PhaseComplete, Duration: "5m"},
+		{Name: "merge", Phase: common.PhaseExecuting, Duration: "Unfinished"},
+		{Name: "cleanup", Phase: common.PhasePending},
+	}
+	want := []string{"load", "slow", "merge"}
+	if got := criticalPath(flow, sorted, steps); !reflect.DeepEqual(got, want) {
+		t.Errorf("criticalPath() = %v, want %v", got, want)
+	}
+}
+
+func TestAggregatePhase(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []datav1alpha1.DataFlowStepStatus
+		want  common.Phase
+	}{
+		{name: "executing", steps: []datav1alpha1.DataFlowStepStatus{{Phase: common.PhaseFailed}, {Phase: common.PhaseExecuting}}, want: common.PhaseExecuting},
+		{name: "not created", steps: []datav1alpha1.DataFlowStepStatus{{Phase: common.PhaseComplete}, {}}, want: common.PhaseExecuting},
+		{name: "complete", steps: []datav1alpha1.DataFlowStepStatus{{Phase: common.PhaseComplete}, {Phase: common.PhaseFailed, Skipped: true}}, want: common.PhaseComplete},
+		{name: "failed", steps: []datav1alpha1.DataFlowStepStatus{{Phase: common.PhaseFailed}, {Phase: common.PhaseComplete}}, want: common.PhaseFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aggregatePhase(tt.steps); got != tt.want {
+				t.Errorf("aggregatePhase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlowReconcile(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+
+	flow := newTestDataFlow(
+		datav1alpha1.DataFlowStep{Name: "load", DataLoad: &datav1alpha1.DataLoadSpec{Dataset: datav1alpha1.TargetDataset{Name: "hbase"}}},
+		datav1alpha1.DataFlowStep{
+			Name:        "process",
+			DataProcess: &datav1alpha1.DataProcessSpec{Dataset: datav1alpha1.TargetDatasetWithMountPath{TargetDataset: datav1alpha1.TargetDataset{Name: "hbase"}}},
+			RunAfter:    []datav1alpha1.DataFlowStepRef{{Step: "load", Condition: datav1alpha1.AlwaysCondition}},
+		},
+	)
+	flow.Spec.Schedule = "@every 1h"
+	flow.CreationTimestamp = metav1.Now()
+	c := fake.NewFakeClientWithScheme(testScheme, flow)
+	r := NewFlowReconciler(c, fake.NullLogger(), record.NewFakeRecorder(10), 5*time.Second)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "flow"}}
+	reconcile := func() *datav1alpha1.DataFlow {
+		if _, err := r.Reconcile(context.TODO(), req); err != nil {
+			t.Fatalf("Reconcile() got error %v", err)
+		}
+		got := &datav1alpha1.DataFlow{}
+		if err := c.Get(context.TODO(), req.NamespacedName, got); err != nil {
+			t.Fatalf("failed to get dataflow: %v", err)
+		}
+		return got
+	}
+
+	// the first run starts
+	got := reconcile()
+	if got.Status.Run != 1 || got.Status.Phase != common.PhaseExecuting || got.Status.StartTime == nil {
+		t.Fatalf("expect the first run to start, got status %+v", got.Status)
+	}
+
+	// the data operations are created with the owner references and chained by runAfterList
+	got = reconcile()
+	dataLoad := &datav1alpha1.DataLoad{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "flow-load"}, dataLoad); err != nil {
+		t.Fatalf("expect the dataload to be created, got error %v", err)
+	}
+	if !metav1.IsControlledBy(dataLoad, got) || dataLoad.Labels[common.LabelDataFlowRun] != "1" || dataLoad.Spec.Dataset.Name != "hbase" {
+		t.Errorf("unexpected dataload %+v", dataLoad.ObjectMeta)
+	}
+	dataProcess := &datav1alpha1.DataProcess{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "flow-process"}, dataProcess); err != nil {
+		t.Fatalf("expect the dataprocess to be created, got error %v", err)
+	}
+	wantRunAfterList := &datav1alpha1.OperationRefList{Operations: []datav1alpha1.OperationRef{{
+		ObjectRef: datav1alpha1.ObjectRef{APIVersion: "data.fluid.io/v1alpha1", Kind: "DataLoad", Name: "flow-load", Namespace: "default"},
+		Condition: datav1alpha1.AlwaysCondition,
+	}}}
+	if !reflect.DeepEqual(dataProcess.Spec.RunAfterList, wantRunAfterList) {
+		t.Errorf("expect runAfterList %+v, got %+v", wantRunAfterList, dataProcess.Spec.RunAfterList)
+	}
+
+	// the status is aggregated from the data operations
+	dataLoad.Status = datav1alpha1.OperationStatus{Phase: common.PhaseFailed, Duration: "2m0s"}
+	dataProcess.Status = datav1alpha1.OperationStatus{Phase: common.PhaseComplete, Duration: "1m0s"}
+	for _, obj := range []client.Object{dataLoad, dataProcess} {
+		if err := c.Update(context.TODO(), obj); err != nil {
+			t.Fatalf("failed to update operation: %v", err)
+		}
+	}
+	got = reconcile()
+	if got.Status.Phase != common.PhaseFailed || got.Status.CompletionTime == nil || len(got.Status.Steps) != 2 {
+		t.Fatalf("expect the run to fail, got status %+v", got.Status)
+	}
+	if got.Status.Steps[1].Operation.Name != "flow-process" || got.Status.Steps[1].Duration != "1m0s" {
+		t.Errorf("unexpected step status %+v", got.Status.Steps[1])
+	}
+	if !reflect.DeepEqual(got.Status.CriticalPath, []string{"load", "process"}) {
+		t.Errorf("unexpected critical path %v", got.Status.CriticalPath)
+	}
+
+	// the next run is started by the schedule, and the data operations of the previous run are replaced
+	got.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+	if err := c.Status().Update(context.TODO(), got); err != nil {
+		t.Fatalf("failed to update dataflow: %v", err)
+	}
+	got = reconcile()
+	if got.Status.Run != 2 || got.Status.Phase != common.PhaseExecuting || len(got.Status.Steps) != 0 {
+		t.Fatalf("expect the second run to start, got status %+v", got.Status)
+	}
+	got = reconcile()
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "flow-load"}, dataLoad); !apierrs.IsNotFound(err) {
+		t.Errorf("expect the dataload of the previous run to be deleted, got %v", err)
+	}
+	got = reconcile()
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "flow-load"}, dataLoad); err != nil || dataLoad.Labels[common.LabelDataFlowRun] != "2" {
+		t.Errorf("expect the dataload of the second run to be created, got %v, %v", dataLoad.Labels, err)
+	}
+}
+
+func TestFlowReconcileInvalid(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+
+	flow := newTestDataFlow(datav1alpha1.DataFlowStep{Name: "load"})
+	c := fake.NewFakeClientWithScheme(testScheme, flow)
+	r := NewFlowReconciler(c, fake.NullLogger(), record.NewFakeRecorder(10), 5*time.Second)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "flow"}}
+	if _, err := r.Reconcile(context.TODO(), req); err != nil {
+		t.Fatalf("Reconcile() got error %v", err)
+	}
+
+	got := &datav1alpha1.DataFlow{}
+	if err := c.Get(context.TODO(), req.NamespacedName, got); err != nil {
+		t.Fatalf("failed to get dataflow: %v", err)
+	}
+	if got.Status.Phase != common.PhaseFailed || got.Status.Run != 0 || !strings.Contains(got.Status.Message, "exactly one") {
+		t.Errorf("expect the dataflow to fail, got status %+v", got.Status)
+	}
+}
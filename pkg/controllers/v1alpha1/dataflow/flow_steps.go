@@ -0,0 +1,287 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/validation"
+)
+
+// stepOperationName is the name of the data operation created for the step
+func stepOperationName(flow *datav1alpha1.DataFlow, stepName string) string {
+	return fmt.Sprintf("%s-%s", flow.Name, stepName)
+}
+
+// stepOperationKind returns the kind of the data operation of the step, or an empty string if the step doesn't set
+// exactly one data operation.
+func stepOperationKind(step *datav1alpha1.DataFlowStep) string {
+	kinds := []string{}
+	if step.DataLoad != nil {
+		kinds = append(kinds, string(dataoperation.DataLoadType))
+	}
+	if step.DataMigrate != nil {
+		kinds = append(kinds, string(dataoperation.DataMigrateType))
+	}
+	if step.DataProcess != nil {
+		kinds = append(kinds, string(dataoperation.DataProcessType))
+	}
+	if step.DataBackup != nil {
+		kinds = append(kinds, string(dataoperation.DataBackupType))
+	}
+	if len(kinds) != 1 {
+		return ""
+	}
+	return kinds[0]
+}
+
+// newOperationObject returns an empty data operation of the kind
+func newOperationObject(kind string) client.Object {
+	switch kind {
+	case string(dataoperation.DataLoadType):
+		return &datav1alpha1.DataLoad{}
+	case string(dataoperation.DataMigrateType):
+		return &datav1alpha1.DataMigrate{}
+	case string(dataoperation.DataProcessType):
+		return &datav1alpha1.DataProcess{}
+	case string(dataoperation.DataBackupType):
+		return &datav1alpha1.DataBackup{}
+	}
+	return nil
+}
+
+// validateDataFlow checks the steps and the schedule of the DataFlow, and returns the steps in topological order.
+func validateDataFlow(flow *datav1alpha1.DataFlow) (sorted []string, err error) {
+	if flow.Spec.Schedule != "" {
+		if _, err = validation.ParseCronSchedule(flow.Spec.Schedule); err != nil {
+			return nil, err
+		}
+	}
+
+	steps := map[string]*datav1alpha1.DataFlowStep{}
+	for i := range flow.Spec.Steps {
+		step := &flow.Spec.Steps[i]
+		if _, found := steps[step.Name]; found {
+			return nil, fmt.Errorf("step %s is duplicated", step.Name)
+		}
+		steps[step.Name] = step
+
+		if stepOperationKind(step) == "" {
+			return nil, fmt.Errorf("step %s must set exactly one of dataLoad, dataMigrate, dataProcess and dataBackup", step.Name)
+		}
+		if err = validateStepTemplate(step); err != nil {
+			return nil, err
+		}
+	}
+
+	// Kahn's algorithm, which also detects the cycles
+	inDegrees := map[string]int{}
+	for _, step := range flow.Spec.Steps {
+		for _, ref := range step.RunAfter {
+			if _, found := steps[ref.Step]; !found {
+				return nil, fmt.Errorf("step %s runs after step %s which is not found", step.Name, ref.Step)
+			}
+			inDegrees[step.Name]++
+		}
+	}
+	for len(sorted) < len(flow.Spec.Steps) {
+		progressed := false
+		for _, step := range flow.Spec.Steps {
+			if inDegrees[step.Name] != 0 || utils.ContainsString(sorted, step.Name) {
+				continue
+			}
+			sorted = append(sorted, step.Name)
+			progressed = true
+			for _, next := range flow.Spec.Steps {
+				for _, ref := range next.RunAfter {
+					if ref.Step == step.Name {
+						inDegrees[next.Name]--
+					}
+				}
+			}
+		}
+		if !progressed {
+			return nil, fmt.Errorf("the steps have a cycle in runAfter")
+		}
+	}
+	return sorted, nil
+}
+
+// validateStepTemplate checks the fields of the template that are managed by the DataFlow
+func validateStepTemplate(step *datav1alpha1.DataFlowStep) error {
+	var policy datav1alpha1.Policy
+	var runAfterSet bool
+	switch {
+	case step.DataLoad != nil:
+		policy, runAfterSet = step.DataLoad.Policy, step.DataLoad.RunAfter != nil || step.DataLoad.RunAfterList != nil
+	case step.DataMigrate != nil:
+		policy, runAfterSet = step.DataMigrate.Policy, step.DataMigrate.RunAfter != nil || step.DataMigrate.RunAfterList != nil
+	case step.DataProcess != nil:
+		policy, runAfterSet = step.DataProcess.Policy, step.DataProcess.RunAfter != nil || step.DataProcess.RunAfterList != nil
+	case step.DataBackup != nil:
+		runAfterSet = step.DataBackup.RunAfter != nil || step.DataBackup.RunAfterList != nil
+	}
+	if runAfterSet {
+		return fmt.Errorf("step %s must not set runAfter in the template, use the runAfter of the step instead", step.Name)
+	}
+	// the flow is re-run as a whole by its own schedule
+	if policy != "" && policy != datav1alpha1.Once {
+		return fmt.Errorf("step %s must use the Once policy, got %s", step.Name, policy)
+	}
+	return nil
+}
+
+// buildStepOperation builds the data operation of the step in the current run of the DataFlow
+func buildStepOperation(flow *datav1alpha1.DataFlow, step *datav1alpha1.DataFlowStep) (client.Object, error) {
+	var runAfterList *datav1alpha1.OperationRefList
+	if len(step.RunAfter) > 0 {
+		runAfterList = &datav1alpha1.OperationRefList{Mode: step.RunAfterMode}
+		for _, ref := range step.RunAfter {
+			precedingStep := findStep(flow, ref.Step)
+			if precedingStep == nil {
+				return nil, fmt.Errorf("step %s runs after step %s which is not found", step.Name, ref.Step)
+			}
+			runAfterList.Operations = append(runAfterList.Operations, datav1alpha1.OperationRef{
+				ObjectRef:        stepOperationRef(flow, precedingStep),
+				AffinityStrategy: ref.AffinityStrategy,
+				Condition:        ref.Condition,
+			})
+		}
+	}
+
+	var object client.Object
+	switch {
+	case step.DataLoad != nil:
+		op := &datav1alpha1.DataLoad{Spec: *step.DataLoad.DeepCopy()}
+		op.Spec.RunAfterList = runAfterList
+		object = op
+	case step.DataMigrate != nil:
+		op := &datav1alpha1.DataMigrate{Spec: *step.DataMigrate.DeepCopy()}
+		op.Spec.RunAfterList = runAfterList
+		object = op
+	case step.DataProcess != nil:
+		op := &datav1alpha1.DataProcess{Spec: *step.DataProcess.DeepCopy()}
+		op.Spec.RunAfterList = runAfterList
+		object = op
+	case step.DataBackup != nil:
+		op := &datav1alpha1.DataBackup{Spec: *step.DataBackup.DeepCopy()}
+		op.Spec.RunAfterList = runAfterList
+		object = op
+	default:
+		return nil, fmt.Errorf("step %s must set exactly one of dataLoad, dataMigrate, dataProcess and dataBackup", step.Name)
+	}
+
+	object.SetName(stepOperationName(flow, step.Name))
+	object.SetNamespace(flow.Namespace)
+	object.SetLabels(map[string]string{
+		common.LabelDataFlowName: flow.Name,
+		common.LabelDataFlowRun:  strconv.Itoa(int(flow.Status.Run)),
+	})
+	object.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(flow, datav1alpha1.GroupVersion.WithKind("DataFlow")),
+	})
+	return object, nil
+}
+
+func findStep(flow *datav1alpha1.DataFlow, name string) *datav1alpha1.DataFlowStep {
+	for i := range flow.Spec.Steps {
+		if flow.Spec.Steps[i].Name == name {
+			return &flow.Spec.Steps[i]
+		}
+	}
+	return nil
+}
+
+func stepOperationRef(flow *datav1alpha1.DataFlow, step *datav1alpha1.DataFlowStep) datav1alpha1.ObjectRef {
+	return datav1alpha1.ObjectRef{
+		APIVersion: datav1alpha1.GroupVersion.String(),
+		Kind:       stepOperationKind(step),
+		Name:       stepOperationName(flow, step.Name),
+		Namespace:  flow.Namespace,
+	}
+}
+
+// isStepFinished checks if the step won't change any more in the current run
+func isStepFinished(step datav1alpha1.DataFlowStepStatus) bool {
+	return step.Phase == common.PhaseComplete || step.Phase == common.PhaseFailed
+}
+
+// aggregatePhase computes the phase of the run from the steps. The run is finished only after all the steps finish,
+// since a step running on the failure of its preceding steps may still be running.
+func aggregatePhase(steps []datav1alpha1.DataFlowStepStatus) common.Phase {
+	failed := false
+	for _, step := range steps {
+		if !isStepFinished(step) {
+			return common.PhaseExecuting
+		}
+		if step.Phase == common.PhaseFailed && !step.Skipped {
+			failed = true
+		}
+	}
+	if failed {
+		return common.PhaseFailed
+	}
+	return common.PhaseComplete
+}
+
+// criticalPath returns the longest chain of the executed steps weighted by their durations. sorted is the step names
+// in topological order.
+func criticalPath(flow *datav1alpha1.DataFlow, sorted []string, steps []datav1alpha1.DataFlowStepStatus) []string {
+	executed := map[string]bool{}
+	weights := map[string]time.Duration{}
+	for _, step := range steps {
+		if step.Phase == common.PhaseNone || step.Phase == common.PhasePending || step.Skipped {
+			continue
+		}
+		executed[step.Name] = true
+		// the duration is "Unfinished" for the executing step
+		weights[step.Name], _ = time.ParseDuration(step.Duration)
+	}
+
+	lengths := map[string]time.Duration{}
+	previous := map[string]string{}
+	last := ""
+	for _, name := range sorted {
+		if !executed[name] {
+			continue
+		}
+		for _, ref := range findStep(flow, name).RunAfter {
+			if executed[ref.Step] && (previous[name] == "" || lengths[ref.Step] > lengths[previous[name]]) {
+				previous[name] = ref.Step
+			}
+		}
+		lengths[name] = weights[name] + lengths[previous[name]]
+		if last == "" || lengths[name] >= lengths[last] {
+			last = name
+		}
+	}
+
+	var path []string
+	for name := last; name != ""; name = previous[name] {
+		path = append([]string{name}, path...)
+	}
+	return path
+}
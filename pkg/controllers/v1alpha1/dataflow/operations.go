@@ -18,6 +18,7 @@ package dataflow
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -25,6 +26,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,7 +42,7 @@ func reconcileDataLoad(ctx reconcileRequestContext) (needRequeue bool, err error
 		return true, errors.Wrap(err, "failed to get dataload")
 	}
 
-	updateStatusFn := func() error {
+	updateStatusFn := func(mutate func(opStatus *datav1alpha1.OperationStatus)) error {
 		tmp, err := utils.GetDataLoad(ctx.Client, ctx.Name, ctx.Namespace)
 		if err != nil {
 			if utils.IgnoreNotFound(err) == nil {
@@ -50,7 +52,7 @@ func reconcileDataLoad(ctx reconcileRequestContext) (needRequeue bool, err error
 		}
 
 		toUpdate := tmp.DeepCopy()
-		toUpdate.Status.WaitingFor.OperationComplete = ptr.To(false)
+		mutate(&toUpdate.Status)
 		if !reflect.DeepEqual(toUpdate.Status, tmp.Status) {
 			return ctx.Client.Status().Update(context.TODO(), toUpdate)
 		}
@@ -58,7 +60,7 @@ func reconcileDataLoad(ctx reconcileRequestContext) (needRequeue bool, err error
 		return nil
 	}
 
-	return reconcileOperationDataFlow(ctx, dataLoad, dataLoad.Spec.RunAfter, dataLoad.Status, updateStatusFn)
+	return reconcileOperationDataFlow(ctx, dataLoad, updateStatusFn)
 }
 
 func reconcileDataBackup(ctx reconcileRequestContext) (needRequeue bool, err error) {
@@ -71,7 +73,7 @@ func reconcileDataBackup(ctx reconcileRequestContext) (needRequeue bool, err err
 		return true, errors.Wrap(err, "failed to get databackup")
 	}
 
-	updateStatusFn := func() error {
+	updateStatusFn := func(mutate func(opStatus *datav1alpha1.OperationStatus)) error {
 		tmp, err := utils.GetDataBackup(ctx.Client, ctx.Name, ctx.Namespace)
 		if err != nil {
 			if utils.IgnoreNotFound(err) == nil {
@@ -81,7 +83,7 @@ func reconcileDataBackup(ctx reconcileRequestContext) (needRequeue bool, err err
 		}
 
 		toUpdate := tmp.DeepCopy()
-		toUpdate.Status.WaitingFor.OperationComplete = ptr.To(false)
+		mutate(&toUpdate.Status)
 		if !reflect.DeepEqual(toUpdate.Status, tmp.Status) {
 			return ctx.Client.Status().Update(context.TODO(), toUpdate)
 		}
@@ -89,7 +91,7 @@ func reconcileDataBackup(ctx reconcileRequestContext) (needRequeue bool, err err
 		return nil
 	}
 
-	return reconcileOperationDataFlow(ctx, dataBackup, dataBackup.Spec.RunAfter, dataBackup.Status, updateStatusFn)
+	return reconcileOperationDataFlow(ctx, dataBackup, updateStatusFn)
 }
 
 func reconcileDataMigrate(ctx reconcileRequestContext) (needRequeue bool, err error) {
@@ -102,7 +104,7 @@ func reconcileDataMigrate(ctx reconcileRequestContext) (needRequeue bool, err er
 		return true, errors.Wrap(err, "failed to get datamigrate")
 	}
 
-	updateStatusFn := func() error {
+	updateStatusFn := func(mutate func(opStatus *datav1alpha1.OperationStatus)) error {
 		tmp, err := utils.GetDataMigrate(ctx.Client, ctx.Name, ctx.Namespace)
 		if err != nil {
 			if utils.IgnoreNotFound(err) == nil {
@@ -112,7 +114,7 @@ func reconcileDataMigrate(ctx reconcileRequestContext) (needRequeue bool, err er
 		}
 
 		toUpdate := tmp.DeepCopy()
-		toUpdate.Status.WaitingFor.OperationComplete = ptr.To(false)
+		mutate(&toUpdate.Status)
 		if !reflect.DeepEqual(toUpdate.Status, tmp.Status) {
 			return ctx.Client.Status().Update(context.TODO(), toUpdate)
 		}
@@ -120,7 +122,7 @@ func reconcileDataMigrate(ctx reconcileRequestContext) (needRequeue bool, err er
 		return nil
 	}
 
-	return reconcileOperationDataFlow(ctx, dataMigrate, dataMigrate.Spec.RunAfter, dataMigrate.Status, updateStatusFn)
+	return reconcileOperationDataFlow(ctx, dataMigrate, updateStatusFn)
 }
 
 func reconcileDataProcess(ctx reconcileRequestContext) (needRequeue bool, err error) {
@@ -133,7 +135,7 @@ func reconcileDataProcess(ctx reconcileRequestContext) (needRequeue bool, err er
 		return true, errors.Wrap(err, "failed to get datamigrate")
 	}
 
-	updateStatusFn := func() error {
+	updateStatusFn := func(mutate func(opStatus *datav1alpha1.OperationStatus)) error {
 		tmp, err := utils.GetDataProcess(ctx.Client, ctx.Name, ctx.Namespace)
 		if err != nil {
 			if utils.IgnoreNotFound(err) == nil {
@@ -143,7 +145,7 @@ func reconcileDataProcess(ctx reconcileRequestContext) (needRequeue bool, err er
 		}
 
 		toUpdate := tmp.DeepCopy()
-		toUpdate.Status.WaitingFor.OperationComplete = ptr.To(false)
+		mutate(&toUpdate.Status)
 		if !reflect.DeepEqual(toUpdate.Status, tmp.Status) {
 			return ctx.Client.Status().Update(context.TODO(), toUpdate)
 		}
@@ -151,43 +153,82 @@ func reconcileDataProcess(ctx reconcileRequestContext) (needRequeue bool, err er
 		return nil
 	}
 
-	return reconcileOperationDataFlow(ctx, dataProcess, dataProcess.Spec.RunAfter, dataProcess.Status, updateStatusFn)
+	return reconcileOperationDataFlow(ctx, dataProcess, updateStatusFn)
 }
 
 func reconcileOperationDataFlow(ctx reconcileRequestContext,
 	object client.Object,
-	runAfter *datav1alpha1.OperationRef,
-	opStatus datav1alpha1.OperationStatus,
-	updateStatusFn func() error) (needRequeue bool, err error) {
+	updateStatusFn func(mutate func(opStatus *datav1alpha1.OperationStatus)) error) (needRequeue bool, err error) {
+
+	runAfters, mode := utils.GetPrecedingOperations(object)
+	states := make([]runAfterState, 0, len(runAfters))
+	for i := range runAfters {
+		runAfter := &runAfters[i]
+		opRefNamespace := ctx.Namespace
+		if len(runAfter.Namespace) != 0 {
+			opRefNamespace = runAfter.Namespace
+		}
 
-	opRefNamespace := ctx.Namespace
-	if len(runAfter.Namespace) != 0 {
-		opRefNamespace = runAfter.Namespace
-	}
+		precedingOpStatus, err := utils.GetPrecedingOperationStatus(ctx.Client, &runAfter.ObjectRef, opRefNamespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				// preceding operation not found
+				ctx.Recorder.Eventf(object, corev1.EventTypeWarning, common.DataOperationNotFound, "Preceding operation %s \"%s/%s\" not found",
+					runAfter.Kind,
+					opRefNamespace,
+					runAfter.Name)
+				states = append(states, runAfterWaiting)
+				continue
+			}
+			return true, errors.Wrapf(err, "failed to get preceding operation status (RunAfter: %v)", runAfter.ObjectRef)
+		}
 
-	precedingOpStatus, err := utils.GetPrecedingOperationStatus(ctx.Client, &runAfter.ObjectRef, opRefNamespace)
-	if err != nil {
-		if utils.IgnoreNotFound(err) == nil {
-			// preceding operation not found
-			ctx.Recorder.Eventf(object, corev1.EventTypeWarning, common.DataOperationNotFound, "Preceding operation %s \"%s/%s\" not found",
+		state := checkRunAfterCondition(utils.GetRunAfterCondition(runAfter), precedingOpStatus.Phase)
+		if state == runAfterWaiting {
+			ctx.Recorder.Eventf(object, corev1.EventTypeNormal, common.DataOperationWaiting, "Waiting for operation %s \"%s/%s\" to finish",
 				runAfter.Kind,
 				opRefNamespace,
 				runAfter.Name)
-			return true, nil
 		}
-		return true, errors.Wrapf(err, "failed to get preceding operation status (DataLoad.Spec.RunAfter: %v)", runAfter)
+		states = append(states, state)
 	}
 
-	if precedingOpStatus != nil && precedingOpStatus.Phase != common.PhaseComplete {
-		ctx.Recorder.Eventf(object, corev1.EventTypeNormal, common.DataOperationWaiting, "Waiting for operation %s \"%s/%s\" to complete",
-			runAfter.Kind,
-			opRefNamespace,
-			runAfter.Name)
+	var mutate func(opStatus *datav1alpha1.OperationStatus)
+	switch combineRunAfterStates(mode, states) {
+	case runAfterWaiting:
 		return true, nil
+	case runAfterMet:
+		// set opStatus.waitingFor.operationComplete back to false
+		mutate = func(opStatus *datav1alpha1.OperationStatus) {
+			opStatus.WaitingFor.OperationComplete = ptr.To(false)
+		}
+	case runAfterNotMet:
+		// the conditions can never be met, skip the operation by failing it
+		message := fmt.Sprintf("Skipped because the conditions of the preceding operations (%s) are not met", mode)
+		ctx.Recorder.Event(object, corev1.EventTypeWarning, common.DataOperationSkipped, message)
+		mutate = func(opStatus *datav1alpha1.OperationStatus) {
+			if opStatus.Phase == common.PhaseFailed {
+				return
+			}
+			now := metav1.Now()
+			opStatus.WaitingFor.OperationComplete = ptr.To(false)
+			opStatus.Phase = common.PhaseFailed
+			opStatus.Conditions = []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             corev1.ConditionTrue,
+					Reason:             common.DataOperationSkipped,
+					Message:            message,
+					LastProbeTime:      now,
+					LastTransitionTime: now,
+				},
+			}
+		}
 	}
 
-	// set opStatus.waitingFor.operationComplete back to false
-	err = retry.RetryOnConflict(retry.DefaultBackoff, updateStatusFn)
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		return updateStatusFn(mutate)
+	})
 
 	if err != nil {
 		return true, errors.Wrapf(err, "failed to update operation status waitingFor.OperationComplete=false")
@@ -195,3 +236,69 @@ func reconcileOperationDataFlow(ctx reconcileRequestContext,
 
 	return false, nil
 }
+
+// runAfterState is the state of the conditions of the preceding operations
+type runAfterState int
+
+const (
+	runAfterWaiting runAfterState = iota
+	runAfterMet
+	// runAfterNotMet means the conditions can never be met because the preceding operations have finished
+	runAfterNotMet
+)
+
+// checkRunAfterCondition checks the condition on the phase of the preceding operation.
+func checkRunAfterCondition(condition datav1alpha1.RunAfterCondition, phase common.Phase) runAfterState {
+	if phase != common.PhaseComplete && phase != common.PhaseFailed {
+		return runAfterWaiting
+	}
+	switch condition {
+	case datav1alpha1.AlwaysCondition:
+		return runAfterMet
+	case datav1alpha1.OnFailureCondition:
+		if phase == common.PhaseFailed {
+			return runAfterMet
+		}
+	default:
+		if phase == common.PhaseComplete {
+			return runAfterMet
+		}
+	}
+	return runAfterNotMet
+}
+
+// combineRunAfterStates combines the states of all the preceding operations by the mode. In AllOf mode, the
+// conditions are met only when all of them are met. In AnyOf mode, the conditions are met once any of them is met.
+func combineRunAfterStates(mode datav1alpha1.RunAfterMode, states []runAfterState) runAfterState {
+	if len(states) == 0 {
+		return runAfterMet
+	}
+
+	met, notMet := 0, 0
+	for _, state := range states {
+		switch state {
+		case runAfterMet:
+			met++
+		case runAfterNotMet:
+			notMet++
+		}
+	}
+
+	if mode == datav1alpha1.AnyOfMode {
+		if met > 0 {
+			return runAfterMet
+		}
+		if notMet == len(states) {
+			return runAfterNotMet
+		}
+		return runAfterWaiting
+	}
+
+	if notMet > 0 {
+		return runAfterNotMet
+	}
+	if met == len(states) {
+		return runAfterMet
+	}
+	return runAfterWaiting
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestCombineRunAfterStates(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   datav1alpha1.RunAfterMode
+		states []runAfterState
+		want   runAfterState
+	}{
+		{name: "all of met", mode: datav1alpha1.AllOfMode, states: []runAfterState{runAfterMet, runAfterMet}, want: runAfterMet},
+		{name: "all of waiting", mode: datav1alpha1.AllOfMode, states: []runAfterState{runAfterMet, runAfterWaiting}, want: runAfterWaiting},
+		{name: "all of not met", mode: datav1alpha1.AllOfMode, states: []runAfterState{runAfterWaiting, runAfterNotMet}, want: runAfterNotMet},
+		{name: "any of met", mode: datav1alpha1.AnyOfMode, states: []runAfterState{runAfterWaiting, runAfterMet}, want: runAfterMet},
+		{name: "any of waiting", mode: datav1alpha1.AnyOfMode, states: []runAfterState{runAfterNotMet, runAfterWaiting}, want: runAfterWaiting},
+		{name: "any of not met", mode: datav1alpha1.AnyOfMode, states: []runAfterState{runAfterNotMet, runAfterNotMet}, want: runAfterNotMet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := combineRunAfterStates(tt.mode, tt.states); got != tt.want {
+				t.Errorf("combineRunAfterStates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRunAfterCondition(t *testing.T) {
+	tests := []struct {
+		condition datav1alpha1.RunAfterCondition
+		phase     common.Phase
+		want      runAfterState
+	}{
+		{condition: "", phase: common.PhaseComplete, want: runAfterMet},
+		{condition: datav1alpha1.OnSuccessCondition, phase: common.PhaseFailed, want: runAfterNotMet},
+		{condition: datav1alpha1.OnFailureCondition, phase: common.PhaseFailed, want: runAfterMet},
+		{condition: datav1alpha1.OnFailureCondition, phase: common.PhaseComplete, want: runAfterNotMet},
+		{condition: datav1alpha1.AlwaysCondition, phase: common.PhaseFailed, want: runAfterMet},
+		{condition: datav1alpha1.AlwaysCondition, phase: common.PhaseExecuting, want: runAfterWaiting},
+	}
+	for _, tt := range tests {
+		if got := checkRunAfterCondition(tt.condition, tt.phase); got != tt.want {
+			t.Errorf("checkRunAfterCondition(%q, %q) = %v, want %v", tt.condition, tt.phase, got, tt.want)
+		}
+	}
+}
+
+func TestReconcileDataMigrate(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+
+	loadA := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{Name: "load-a", Namespace: "fluid"},
+		Status:     datav1alpha1.OperationStatus{Phase: common.PhaseComplete},
+	}
+	loadB := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{Name: "load-b", Namespace: "fluid"},
+		Status:     datav1alpha1.OperationStatus{Phase: common.PhaseExecuting},
+	}
+	runAfter := func(name string) datav1alpha1.OperationRef {
+		return datav1alpha1.OperationRef{
+			ObjectRef: datav1alpha1.ObjectRef{Kind: "DataLoad", Name: name},
+			Condition: datav1alpha1.OnFailureCondition,
+		}
+	}
+	// the cleanup runs if any of the loads fails
+	cleanup := &datav1alpha1.DataMigrate{
+		ObjectMeta: metav1.ObjectMeta{Name: "cleanup", Namespace: "fluid"},
+		Spec: datav1alpha1.DataMigrateSpec{
+			RunAfterList: &datav1alpha1.OperationRefList{
+				Mode:       datav1alpha1.AnyOfMode,
+				Operations: []datav1alpha1.OperationRef{runAfter("load-a"), runAfter("load-b")},
+			},
+		},
+		Status: datav1alpha1.OperationStatus{
+			Phase:      common.PhasePending,
+			WaitingFor: datav1alpha1.WaitingStatus{OperationComplete: ptr.To(true)},
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(testScheme, loadA, loadB, cleanup)
+	ctx := reconcileRequestContext{
+		Context:        context.TODO(),
+		NamespacedName: types.NamespacedName{Name: "cleanup", Namespace: "fluid"},
+		Client:         c,
+		Log:            fake.NullLogger(),
+		Recorder:       record.NewFakeRecorder(10),
+	}
+
+	// load-b is still executing
+	needRequeue, err := reconcileDataMigrate(ctx)
+	if err != nil || !needRequeue {
+		t.Fatalf("expect to wait for load-b, got needRequeue %v, err %v", needRequeue, err)
+	}
+
+	// load-b completes, neither of the loads fails
+	loadB.Status.Phase = common.PhaseComplete
+	if err = c.Status().Update(context.TODO(), loadB); err != nil {
+		t.Fatal(err)
+	}
+	needRequeue, err = reconcileDataMigrate(ctx)
+	if err != nil || needRequeue {
+		t.Fatalf("expect no requeue, got needRequeue %v, err %v", needRequeue, err)
+	}
+
+	got, err := utils.GetDataMigrate(c, "cleanup", "fluid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Phase != common.PhaseFailed || !utils.IsDataOperationSkipped(&got.Status) || *got.Status.WaitingFor.OperationComplete {
+		t.Errorf("expect the cleanup to be skipped, got status %v", got.Status)
+	}
+}
@@ -27,10 +27,16 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
@@ -113,20 +119,62 @@ func (r *DataLoadReconciler) Reconcile(context context.Context, req ctrl.Request
 
 // SetupWithManager sets up the controller with the given controller manager
 func (r *DataLoadReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
-	if compatibility.IsBatchV1CronJobSupported() {
-		return ctrl.NewControllerManagedBy(mgr).
-			WithOptions(options).
-			For(&datav1alpha1.DataLoad{}).
-			Owns(&batchv1.CronJob{}).
-			Complete(r)
-	} else {
+	var cronJob client.Object = &batchv1.CronJob{}
+	if !compatibility.IsBatchV1CronJobSupported() {
 		ctrl.Log.Info("batch/v1 cronjobs cannnot be found in cluster, fallback to watch batch/v1beta1 cronjobs for compatibility")
-		return ctrl.NewControllerManagedBy(mgr).
-			WithOptions(options).
-			For(&datav1alpha1.DataLoad{}).
-			Owns(&batchv1beta1.CronJob{}).
-			Complete(r)
+		cronJob = &batchv1beta1.CronJob{}
 	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DataLoad{}).
+		Owns(cronJob).
+		Watches(&datav1alpha1.Dataset{}, handler.EnqueueRequestsFromMapFunc(r.findOnEventDataLoadsForDataset))
+	// the runtimes are watched to detect the WorkersScaledOut event, which only happens when the ready workers change
+	for _, runtimeObj := range onEventWatchedRuntimes {
+		b = b.Watches(runtimeObj, handler.EnqueueRequestsFromMapFunc(r.findOnEventDataLoadsForDataset),
+			builder.WithPredicates(predicate.Funcs{
+				CreateFunc:  func(event.CreateEvent) bool { return false },
+				DeleteFunc:  func(event.DeleteEvent) bool { return false },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return utils.GetRuntimeWorkerNumberReady(e.ObjectOld) != utils.GetRuntimeWorkerNumberReady(e.ObjectNew)
+				},
+			}))
+	}
+	return b.Complete(r)
+}
+
+// onEventWatchedRuntimes are the runtimes whose workers can be scaled out to trigger the OnEvent DataLoads
+var onEventWatchedRuntimes = []client.Object{
+	&datav1alpha1.AlluxioRuntime{},
+	&datav1alpha1.JindoRuntime{},
+	&datav1alpha1.GooseFSRuntime{},
+	&datav1alpha1.JuiceFSRuntime{},
+	&datav1alpha1.ThinRuntime{},
+	&datav1alpha1.EFCRuntime{},
+	&datav1alpha1.VineyardRuntime{},
+}
+
+// findOnEventDataLoadsForDataset maps the changed dataset (or its runtime, which has the same name) to the DataLoads
+// with OnEvent policy targeting it, so that these DataLoads can check if a new run should be triggered.
+func (r *DataLoadReconciler) findOnEventDataLoadsForDataset(ctx context.Context, dataset client.Object) []reconcile.Request {
+	dataLoadList := &datav1alpha1.DataLoadList{}
+	if err := r.List(ctx, dataLoadList, client.InNamespace(dataset.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list DataLoads", "namespace", dataset.GetNamespace())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, dataLoad := range dataLoadList.Items {
+		if dataLoad.Spec.Policy != datav1alpha1.OnEvent || dataLoad.Spec.Dataset.Name != dataset.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: dataLoad.Namespace, Name: dataLoad.Name},
+		})
+	}
+	return requests
 }
 
 func (r *DataLoadReconciler) ControllerName() string {
@@ -18,6 +18,7 @@ package dataload
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/go-logr/logr"
 	"k8s.io/client-go/tools/record"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,6 +38,7 @@ import (
 	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
 type dataLoadOperation struct {
@@ -53,7 +56,7 @@ func (r *dataLoadOperation) GetOperationObject() client.Object {
 }
 
 func (r *dataLoadOperation) HasPrecedingOperation() bool {
-	return r.dataLoad.Spec.RunAfter != nil
+	return r.dataLoad.Spec.RunAfter != nil || r.dataLoad.Spec.RunAfterList != nil
 }
 
 func (r *dataLoadOperation) GetPossibleTargetDatasetNamespacedNames() []types.NamespacedName {
@@ -66,6 +69,11 @@ func (r *dataLoadOperation) GetTargetDataset() (*datav1alpha1.Dataset, error) {
 	return utils.GetDataset(r.Client, r.dataLoad.Spec.Dataset.Name, r.dataLoad.Spec.Dataset.Namespace)
 }
 
+// GetRelatedDatasetNamespacedNames returns nil, only the target dataset is locked by the DataLoad.
+func (r *dataLoadOperation) GetRelatedDatasetNamespacedNames() []types.NamespacedName {
+	return nil
+}
+
 func (r *dataLoadOperation) GetReleaseNameSpacedName() types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: r.dataLoad.GetNamespace(),
@@ -110,14 +118,63 @@ func (r *dataLoadOperation) Validate(ctx cruntime.ReconcileRequestContext) ([]da
 			},
 		}, err
 	}
+	// 2. Check the time window of the throttle can be parsed and is supported by the policy
+	if throttle := dataLoad.Spec.Throttle; throttle != nil {
+		if err := utils.ValidateTimeWindow(throttle.Window, dataLoad.Spec.Policy); err != nil {
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             common.InvalidTimeWindow,
+					Message:            err.Error(),
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, fmt.Errorf("dataLoad(%s) has invalid time window: %v", dataLoad.GetName(), err)
+		}
+	}
 	return nil, nil
 }
 
 func (r *dataLoadOperation) UpdateStatusInfoForCompleted(infos map[string]string) error {
-	// DataLoad does not need to update OperationStatus's Infos field.
+	// The results of the target paths are recorded by the status handlers when the job finishes, see recordLoadResults.
 	return nil
 }
 
+// recordLoadResults records the results of the target paths reported by the loader in the termination message,
+// e.g. the loader of VineyardRuntime. They are read from the succeeded pod of the finished job, or from the last
+// failed pod if the job failed. The recorded results are kept if no pod reports any result.
+func recordLoadResults(c client.Client, job *batchv1.Job, result *datav1alpha1.OperationStatus) error {
+	pod, err := kubeclient.GetLastFinishedPodForJob(c, job)
+	if err != nil || pod == nil {
+		return err
+	}
+
+	if results := getLoadResults(pod); len(results) != 0 {
+		result.Infos = results
+	}
+	return nil
+}
+
+// getLoadResults parses the results of target paths reported by the loader in the termination message,
+// which is a json object from the target path to its result. Messages in other formats are ignored.
+func getLoadResults(pod *v1.Pod) map[string]string {
+	results := map[string]string{}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated == nil || len(status.State.Terminated.Message) == 0 {
+			continue
+		}
+		var containerResults map[string]string
+		if err := json.Unmarshal([]byte(status.State.Terminated.Message), &containerResults); err != nil {
+			continue
+		}
+		for path, result := range containerResults {
+			results[path] = result
+		}
+	}
+	return results
+}
+
 func (r *dataLoadOperation) SetTargetDatasetStatusInProgress(dataset *datav1alpha1.Dataset) {
 	// DataLoad does not need to update Dataset other field except for DataOperationRef.
 }
@@ -162,3 +219,7 @@ func (r *dataLoadOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataLoadOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
+
+func (r *dataLoadOperation) GetThrottle() *datav1alpha1.Throttle {
+	return r.dataLoad.Spec.Throttle
+}
@@ -17,10 +17,18 @@ limitations under the License.
 package dataload
 
 import (
+	"reflect"
 	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
 func TestIsTargetPathUnderFluidNativeMounts(t *testing.T) {
@@ -105,3 +113,87 @@ func TestIsTargetPathUnderFluidNativeMounts(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordLoadResults(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = batchv1.AddToScheme(testScheme)
+	_ = corev1.AddToScheme(testScheme)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-loader-job",
+			Namespace: "default",
+		},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"job-name": "test-loader-job"},
+			},
+		},
+	}
+	now := time.Now().Truncate(time.Second)
+	newPod := func(name string, phase corev1.PodPhase, creationTime time.Time, message string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				Labels:            map[string]string{"job-name": "test-loader-job"},
+				CreationTimestamp: metav1.NewTime(creationTime),
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Message: message},
+					},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		infos     map[string]string
+		objs      []runtime.Object
+		wantInfos map[string]string
+	}{
+		{
+			name: "job succeeded",
+			objs: []runtime.Object{job,
+				newPod("test-loader-job-failed", corev1.PodFailed, now.Add(-time.Minute), `{"/data/a.parquet": "Failed"}`),
+				newPod("test-loader-job-succeed", corev1.PodSucceeded, now, `{"/data/a.parquet": "Loaded", "/data/b.parquet": "Loaded"}`),
+			},
+			wantInfos: map[string]string{"/data/a.parquet": "Loaded", "/data/b.parquet": "Loaded"},
+		},
+		{
+			name: "job failed",
+			objs: []runtime.Object{job,
+				newPod("test-loader-job-1", corev1.PodFailed, now, `{"/data/a.parquet": "Failed: timeout", "/data/b.parquet": "Loaded"}`),
+				newPod("test-loader-job-2", corev1.PodFailed, now.Add(-time.Minute), `{"/data/a.parquet": "Failed: not found"}`),
+			},
+			wantInfos: map[string]string{"/data/a.parquet": "Failed: timeout", "/data/b.parquet": "Loaded"},
+		},
+		{
+			name:      "results of the last run kept",
+			infos:     map[string]string{"/data/a.parquet": "Loaded"},
+			objs:      []runtime.Object{job, newPod("test-loader-job-succeed", corev1.PodSucceeded, now, "done")},
+			wantInfos: map[string]string{"/data/a.parquet": "Loaded"},
+		},
+		{
+			name: "no pod finished",
+			objs: []runtime.Object{job, newPod("test-loader-job-running", corev1.PodRunning, now, "")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &v1alpha1.OperationStatus{Infos: tt.infos}
+			if err := recordLoadResults(fake.NewFakeClientWithScheme(testScheme, tt.objs...), job, result); err != nil {
+				t.Fatalf("recordLoadResults() got error %v", err)
+			}
+			if !reflect.DeepEqual(result.Infos, tt.wantInfos) {
+				t.Errorf("expect infos %v, got %v", tt.wantInfos, result.Infos)
+			}
+		})
+	}
+}
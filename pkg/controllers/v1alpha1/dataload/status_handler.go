@@ -27,6 +27,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation/onevent"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
@@ -51,6 +52,8 @@ type OnEventStatusHandler struct {
 	dataLoad *datav1alpha1.DataLoad
 }
 
+var _ dataoperation.StatusHandler = &OnEventStatusHandler{}
+
 func (r *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
 	result = opStatus.DeepCopy()
 	// 2. Check running status of the DataLoad job
@@ -80,6 +83,11 @@ func (r *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 	}
 	isJobSucceed := finishedJobCondition.Type == batchv1.JobComplete
 
+	// the load results are optional, failing to record them doesn't block updating the phase
+	if err := recordLoadResults(r.Client, job, result); err != nil {
+		ctx.Log.Error(err, "can't record the load results", "namespace", ctx.Namespace, "jobName", jobName)
+	}
+
 	// set the node labels in status when job succeed
 	if result.NodeAffinity == nil && isJobSucceed {
 		// generate the node labels
@@ -167,6 +175,12 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		}
 		return
 	}
+
+	// the load results are optional, failing to record them doesn't block updating the phase
+	if err := recordLoadResults(c.Client, currentJob, result); err != nil {
+		ctx.Log.Error(err, "can't record the load results", "namespace", ctx.Namespace, "jobName", currentJob.Name)
+	}
+
 	// job either failed or complete, update dataload's phase status
 	result.Conditions = []datav1alpha1.Condition{
 		{
@@ -188,6 +202,45 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 }
 
 func (o *OnEventStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
-	//TODO implement me
-	return nil, nil
+	releaseName := utils.GetDataLoadReleaseName(o.dataLoad.GetName())
+	handler := &onevent.StatusHandler{
+		Client:            o.Client,
+		Operation:         o.dataLoad,
+		OperationType:     dataoperation.DataLoadType,
+		ReleaseName:       releaseName,
+		JobName:           utils.GetDataLoadJobName(releaseName),
+		ObserveEventState: o.observeEventState,
+		DetectEvents:      detectEvents,
+		UpdateJobStatus: func(result *datav1alpha1.OperationStatus, job *batchv1.Job) {
+			if kubeclient.GetFinishedJobCondition(job) == nil {
+				return
+			}
+			if err := recordLoadResults(o.Client, job, result); err != nil {
+				ctx.Log.Error(err, "can't record the load results", "namespace", ctx.Namespace, "jobName", job.Name)
+			}
+		},
+	}
+	return handler.GetOperationStatus(ctx, opStatus)
+}
+
+// detectEvents detects the events on the target dataset and the workers scaled out by the bound runtime.
+func detectEvents(observed, current *datav1alpha1.EventStatus) []string {
+	events := utils.DetectDatasetEvents(observed.ObservedDataset, current.ObservedDataset)
+	if current.ObservedWorkerNumberReady > observed.ObservedWorkerNumberReady {
+		events = append(events, utils.WorkersScaledOutEvent)
+	}
+	return events
+}
+
+// observeEventState records the state of target dataset and the ready worker number of the bound runtime.
+func (o *OnEventStatusHandler) observeEventState(ctx cruntime.ReconcileRequestContext, eventStatus *datav1alpha1.EventStatus) error {
+	dataset, err := utils.GetDataset(o.Client, o.dataLoad.Spec.Dataset.Name, o.dataLoad.Spec.Dataset.Namespace)
+	if err != nil {
+		ctx.Log.Error(err, "can't get target dataset", "dataset", o.dataLoad.Spec.Dataset.Name)
+		return err
+	}
+
+	eventStatus.ObservedDataset = utils.GetObservedDatasetState(dataset)
+	eventStatus.ObservedWorkerNumberReady = utils.GetRuntimeWorkerNumberReady(ctx.Runtime)
+	return nil
 }
@@ -17,18 +17,23 @@
 package dataload
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 )
 
 func TestOnceGetOperationStatus(t *testing.T) {
@@ -224,3 +229,226 @@ func TestCronGetOperationStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestOnEventGetOperationStatus(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = batchv1.AddToScheme(testScheme)
+
+	patch := gomonkey.ApplyFunc(helm.DeleteReleaseIfExists, func(name, namespace string) error {
+		return nil
+	})
+	defer patch.Reset()
+
+	completeTime := v1.NewTime(time.Now())
+	mockDataset := v1alpha1.Dataset{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "hadoop",
+			Namespace: "default",
+		},
+		Status: v1alpha1.DatasetStatus{
+			UfsTotal: "10.00GiB",
+			FileNum:  "100",
+			Mounts: []v1alpha1.Mount{
+				{MountPoint: "oss://bucket/a"},
+			},
+		},
+	}
+
+	mockJob := batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload-loader-job",
+			Namespace: "default",
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:               batchv1.JobComplete,
+					LastProbeTime:      completeTime,
+					LastTransitionTime: completeTime,
+				},
+			},
+		},
+	}
+
+	observedStatus := &v1alpha1.EventStatus{
+		ObservedDataset: v1alpha1.ObservedDatasetState{
+			UfsTotal:    "10.00GiB",
+			FileNum:     "100",
+			MountPoints: []string{"oss://bucket/a"},
+		},
+		ObservedWorkerNumberReady: 1,
+	}
+
+	testcases := []struct {
+		name                  string
+		opStatus              v1alpha1.OperationStatus
+		ufsTotal              string
+		workerNumberReady     int32
+		expectedPhase         common.Phase
+		expectedEvent         string
+		expectedTriggerdCount int32
+	}{
+		{
+			name:                  "first run complete",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseExecuting},
+			ufsTotal:              "10.00GiB",
+			workerNumberReady:     1,
+			expectedPhase:         common.PhaseComplete,
+			expectedTriggerdCount: 0,
+		},
+		{
+			name:                  "no event happened",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseComplete, EventStatus: observedStatus},
+			ufsTotal:              "10.00GiB",
+			workerNumberReady:     1,
+			expectedPhase:         common.PhaseComplete,
+			expectedTriggerdCount: 0,
+		},
+		{
+			name:                  "ufs changed",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseComplete, EventStatus: observedStatus},
+			ufsTotal:              "20.00GiB",
+			workerNumberReady:     1,
+			expectedPhase:         common.PhasePending,
+			expectedEvent:         utils.UFSChangedEvent,
+			expectedTriggerdCount: 1,
+		},
+		{
+			name:                  "workers scaled out",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseFailed, EventStatus: observedStatus},
+			ufsTotal:              "10.00GiB",
+			workerNumberReady:     3,
+			expectedPhase:         common.PhasePending,
+			expectedEvent:         utils.WorkersScaledOutEvent,
+			expectedTriggerdCount: 1,
+		},
+	}
+
+	for _, testcase := range testcases {
+		dataset := mockDataset.DeepCopy()
+		dataset.Status.UfsTotal = testcase.ufsTotal
+		dataLoad := &v1alpha1.DataLoad{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "test-dataload",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.DataLoadSpec{
+				Dataset: v1alpha1.TargetDataset{
+					Name:      "hadoop",
+					Namespace: "default",
+				},
+				Policy: v1alpha1.OnEvent,
+			},
+			Status: testcase.opStatus,
+		}
+		alluxioRuntime := &v1alpha1.AlluxioRuntime{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "hadoop",
+				Namespace: "default",
+			},
+			Status: v1alpha1.RuntimeStatus{
+				WorkerNumberReady: testcase.workerNumberReady,
+			},
+		}
+
+		client := fake.NewFakeClientWithScheme(testScheme, dataLoad, dataset, mockJob.DeepCopy())
+		onEventStatusHandler := &OnEventStatusHandler{Client: client, dataLoad: dataLoad}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      "hadoop",
+			},
+			Runtime:  alluxioRuntime,
+			Log:      fake.NullLogger(),
+			Recorder: record.NewFakeRecorder(1),
+		}
+		opStatus, err := onEventStatusHandler.GetOperationStatus(ctx, &dataLoad.Status)
+		if err != nil {
+			t.Errorf("testcase %s: fail to GetOperationStatus with error %v", testcase.name, err)
+			continue
+		}
+		if opStatus.Phase != testcase.expectedPhase {
+			t.Errorf("testcase %s: expected phase %s, get %s", testcase.name, testcase.expectedPhase, opStatus.Phase)
+		}
+		if opStatus.EventStatus == nil {
+			t.Errorf("testcase %s: expected event status recorded, get nil", testcase.name)
+			continue
+		}
+		if opStatus.EventStatus.LastTriggeredEvent != testcase.expectedEvent || opStatus.EventStatus.TriggeredCount != testcase.expectedTriggerdCount {
+			t.Errorf("testcase %s: expected event %s triggered %d times, get %v", testcase.name, testcase.expectedEvent, testcase.expectedTriggerdCount, opStatus.EventStatus)
+		}
+		if opStatus.EventStatus.ObservedDataset.UfsTotal != testcase.ufsTotal || opStatus.EventStatus.ObservedWorkerNumberReady != testcase.workerNumberReady {
+			t.Errorf("testcase %s: expected observed state refreshed, get %v", testcase.name, opStatus.EventStatus)
+		}
+		if testcase.opStatus.Phase == common.PhaseExecuting && len(opStatus.EventStatus.RunHistory) != 1 {
+			t.Errorf("testcase %s: expected the finished run recorded in history, get %v", testcase.name, opStatus.EventStatus.RunHistory)
+		}
+	}
+}
+
+func TestOnEventGetOperationStatusAfterMetadataResync(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = batchv1.AddToScheme(testScheme)
+
+	patch := gomonkey.ApplyFunc(helm.DeleteReleaseIfExists, func(name, namespace string) error {
+		return nil
+	})
+	defer patch.Reset()
+
+	dataset := &v1alpha1.Dataset{
+		ObjectMeta: v1.ObjectMeta{Name: "hadoop", Namespace: "default"},
+	}
+	dataLoad := &v1alpha1.DataLoad{
+		ObjectMeta: v1.ObjectMeta{Name: "test-dataload", Namespace: "default"},
+		Spec: v1alpha1.DataLoadSpec{
+			Dataset: v1alpha1.TargetDataset{Name: "hadoop", Namespace: "default"},
+			Policy:  v1alpha1.OnEvent,
+		},
+		Status: v1alpha1.OperationStatus{
+			Phase: common.PhaseComplete,
+			EventStatus: &v1alpha1.EventStatus{
+				ObservedDataset: v1alpha1.ObservedDatasetState{UfsTotal: "10.00GiB", FileNum: "100"},
+			},
+		},
+	}
+	client := fake.NewFakeClientWithScheme(testScheme, dataLoad, dataset)
+	onEventStatusHandler := &OnEventStatusHandler{Client: client, dataLoad: dataLoad}
+	ctx := cruntime.ReconcileRequestContext{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "hadoop"},
+		Runtime:        &v1alpha1.AlluxioRuntime{},
+		Log:            fake.NullLogger(),
+		Recorder:       record.NewFakeRecorder(1),
+	}
+
+	// known -> unknown (metadata resync, e.g. cleared by a DataProcess writing the dataset) -> changed
+	steps := []struct {
+		ufsTotal      string
+		fileNum       string
+		expectedPhase common.Phase
+	}{
+		{ufsTotal: "", fileNum: "", expectedPhase: common.PhaseComplete},
+		{ufsTotal: "[Calculating]", fileNum: "[Calculating]", expectedPhase: common.PhaseComplete},
+		{ufsTotal: "20.00GiB", fileNum: "200", expectedPhase: common.PhasePending},
+	}
+	opStatus := &dataLoad.Status
+	for i, step := range steps {
+		dataset.Status.UfsTotal = step.ufsTotal
+		dataset.Status.FileNum = step.fileNum
+		if err := client.Status().Update(context.TODO(), dataset); err != nil {
+			t.Fatalf("step %d: failed to update dataset: %v", i, err)
+		}
+		var err error
+		opStatus, err = onEventStatusHandler.GetOperationStatus(ctx, opStatus)
+		if err != nil {
+			t.Fatalf("step %d: fail to GetOperationStatus with error %v", i, err)
+		}
+		if opStatus.Phase != step.expectedPhase {
+			t.Errorf("step %d: expected phase %s, get %s", i, step.expectedPhase, opStatus.Phase)
+		}
+	}
+	if opStatus.EventStatus.LastTriggeredEvent != utils.UFSChangedEvent || opStatus.EventStatus.ObservedDataset.UfsTotal != "20.00GiB" {
+		t.Errorf("expected ufs changed event triggered after metadata resynced, get %v", opStatus.EventStatus)
+	}
+}
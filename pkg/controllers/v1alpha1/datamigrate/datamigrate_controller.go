@@ -25,14 +25,18 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
@@ -109,22 +113,89 @@ func (r *DataMigrateReconciler) Reconcile(context context.Context, req ctrl.Requ
 	return r.ReconcileInternal(ctx)
 }
 
+// precedingOperationKinds are the kinds of preceding operations whose completion triggers DataMigrates with OnEvent policy
+var precedingOperationKinds = map[string]struct {
+	object        client.Object
+	operationType dataoperation.OperationType
+}{
+	"dataload":    {object: &datav1alpha1.DataLoad{}, operationType: dataoperation.DataLoadType},
+	"dataprocess": {object: &datav1alpha1.DataProcess{}, operationType: dataoperation.DataProcessType},
+}
+
 // SetupWithManager sets up the controller with the given controller manager
 func (r *DataMigrateReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	bld := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DataMigrate{})
 	if compatibility.IsBatchV1CronJobSupported() {
-		return ctrl.NewControllerManagedBy(mgr).
-			WithOptions(options).
-			For(&datav1alpha1.DataMigrate{}).
-			Owns(&batchv1.CronJob{}).
-			Complete(r)
+		bld = bld.Owns(&batchv1.CronJob{})
 	} else {
 		ctrl.Log.Info("batch/v1 cronjobs cannot be found in cluster, fallback to watch batch/v1beta1 cronjobs for compatibility")
-		return ctrl.NewControllerManagedBy(mgr).
-			WithOptions(options).
-			For(&datav1alpha1.DataMigrate{}).
-			Owns(&batchv1beta1.CronJob{}).
-			Complete(r)
+		bld = bld.Owns(&batchv1beta1.CronJob{})
+	}
+
+	bld = bld.Watches(&datav1alpha1.Dataset{}, handler.EnqueueRequestsFromMapFunc(r.findOnEventDataMigratesForDataset))
+	for kind, preceding := range precedingOperationKinds {
+		if discovery.GetFluidDiscovery().ResourceEnabled(kind) {
+			bld = bld.Watches(preceding.object, handler.EnqueueRequestsFromMapFunc(r.findOnEventDataMigratesRunAfter(preceding.operationType)))
+		}
+	}
+
+	return bld.Complete(r)
+}
+
+// findOnEventDataMigratesForDataset maps the changed dataset to the DataMigrates with OnEvent policy migrating from it,
+// so that these DataMigrates can check if a new run should be triggered.
+func (r *DataMigrateReconciler) findOnEventDataMigratesForDataset(ctx context.Context, dataset client.Object) []reconcile.Request {
+	return r.findOnEventDataMigrates(ctx, func(dataMigrate *datav1alpha1.DataMigrate) bool {
+		source := dataMigrate.Spec.From.DataSet
+		return source != nil && source.Name == dataset.GetName() &&
+			defaultNamespace(source.Namespace, dataMigrate.Namespace) == dataset.GetNamespace()
+	})
+}
+
+// findOnEventDataMigratesRunAfter returns a map function which maps the changed operation to the DataMigrates
+// with OnEvent policy running after it.
+func (r *DataMigrateReconciler) findOnEventDataMigratesRunAfter(operationType dataoperation.OperationType) handler.MapFunc {
+	return func(ctx context.Context, operation client.Object) []reconcile.Request {
+		return r.findOnEventDataMigrates(ctx, func(dataMigrate *datav1alpha1.DataMigrate) bool {
+			runAfters, _ := utils.GetPrecedingOperations(dataMigrate)
+			for _, runAfter := range runAfters {
+				if runAfter.Kind == string(operationType) && runAfter.Name == operation.GetName() &&
+					defaultNamespace(runAfter.Namespace, dataMigrate.Namespace) == operation.GetNamespace() {
+					return true
+				}
+			}
+			return false
+		})
+	}
+}
+
+func (r *DataMigrateReconciler) findOnEventDataMigrates(ctx context.Context, match func(*datav1alpha1.DataMigrate) bool) []reconcile.Request {
+	dataMigrateList := &datav1alpha1.DataMigrateList{}
+	if err := r.List(ctx, dataMigrateList); err != nil {
+		r.Log.Error(err, "failed to list DataMigrates")
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for i := range dataMigrateList.Items {
+		dataMigrate := &dataMigrateList.Items[i]
+		if dataMigrate.Spec.Policy != datav1alpha1.OnEvent || !match(dataMigrate) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: dataMigrate.Namespace, Name: dataMigrate.Name},
+		})
+	}
+	return requests
+}
+
+func defaultNamespace(namespace, defaultValue string) string {
+	if len(namespace) == 0 {
+		return defaultValue
 	}
+	return namespace
 }
 
 func (r *DataMigrateReconciler) ControllerName() string {
@@ -53,7 +53,7 @@ func (r *dataMigrateOperation) GetOperationObject() client.Object {
 }
 
 func (r *dataMigrateOperation) HasPrecedingOperation() bool {
-	return r.dataMigrate.Spec.RunAfter != nil
+	return r.dataMigrate.Spec.RunAfter != nil || r.dataMigrate.Spec.RunAfterList != nil
 }
 
 func (r *dataMigrateOperation) GetPossibleTargetDatasetNamespacedNames() []types.NamespacedName {
@@ -76,6 +76,11 @@ func (r *dataMigrateOperation) GetTargetDataset() (*datav1alpha1.Dataset, error)
 	return utils.GetTargetDatasetOfMigrate(r.Client, r.dataMigrate)
 }
 
+// GetRelatedDatasetNamespacedNames returns nil, only the target dataset is locked by the DataMigrate.
+func (r *dataMigrateOperation) GetRelatedDatasetNamespacedNames() []types.NamespacedName {
+	return nil
+}
+
 func (r *dataMigrateOperation) GetReleaseNameSpacedName() types.NamespacedName {
 	releaseName := utils.GetDataMigrateReleaseName(r.dataMigrate.GetName())
 	return types.NamespacedName{
@@ -131,6 +136,21 @@ func (r *dataMigrateOperation) Validate(ctx cruntime.ReconcileRequestContext) ([
 			},
 		}, err
 	}
+	// Check the time window of the throttle can be parsed and is supported by the policy
+	if throttle := r.dataMigrate.Spec.Throttle; throttle != nil {
+		if err := utils.ValidateTimeWindow(throttle.Window, r.dataMigrate.Spec.Policy); err != nil {
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             common.InvalidTimeWindow,
+					Message:            err.Error(),
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, fmt.Errorf("DataMigrate(%s) has invalid time window: %v", r.dataMigrate.GetName(), err)
+		}
+	}
 	return nil, nil
 }
 
@@ -183,3 +203,7 @@ func (r *dataMigrateOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataMigrateOperation) GetParallelTaskNumber() int32 {
 	return r.dataMigrate.Spec.Parallelism
 }
+
+func (r *dataMigrateOperation) GetThrottle() *datav1alpha1.Throttle {
+	return r.dataMigrate.Spec.Throttle
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"testing"
@@ -61,6 +62,47 @@ func TestDataMigrateOperation_Validate(t *testing.T) {
 			reason:  common.TargetSSHSecretNameNotSet,
 			wantErr: true,
 		},
+		{
+			name: "time window with cron policy",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"},
+					Spec: datav1alpha1.DataMigrateSpec{
+						Policy:   datav1alpha1.Cron,
+						Schedule: "0 1 * * *",
+						Throttle: &datav1alpha1.Throttle{
+							Window: &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00"},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset: &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "dataset", Namespace: "default"}},
+				},
+			},
+			reason:  common.InvalidTimeWindow,
+			wantErr: true,
+		},
+		{
+			name: "time window with once policy",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"},
+					Spec: datav1alpha1.DataMigrateSpec{
+						Policy: datav1alpha1.Once,
+						Throttle: &datav1alpha1.Throttle{
+							Window: &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00"},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset: &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "dataset", Namespace: "default"}},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -27,6 +27,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation/onevent"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
@@ -213,6 +214,53 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 }
 
 func (o *OnEventStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
-	//TODO implement me
-	return nil, nil
+	releaseName := utils.GetDataMigrateReleaseName(o.dataMigrate.GetName())
+	handler := &onevent.StatusHandler{
+		Client:            o.Client,
+		Operation:         o.dataMigrate,
+		OperationType:     dataoperation.DataMigrateType,
+		ReleaseName:       releaseName,
+		JobName:           utils.GetDataMigrateJobName(releaseName),
+		ObserveEventState: o.observeEventState,
+		DetectEvents:      o.detectEvents,
+		// for parallel migrate, there are multiple pods, so can not set the node labels.
+		SkipNodeAffinity: o.dataMigrate.Spec.Parallelism != 1,
+	}
+	return handler.GetOperationStatus(ctx, opStatus)
+}
+
+// detectEvents detects the mount points changed on the source dataset and the completion of the preceding operation.
+func (o *OnEventStatusHandler) detectEvents(observed, current *datav1alpha1.EventStatus) (events []string) {
+	if o.dataMigrate.Spec.From.DataSet != nil && utils.IsDatasetMountsChanged(observed.ObservedDataset, current.ObservedDataset) {
+		events = append(events, utils.MountsChangedEvent)
+	}
+	if onevent.IsPrecedingOperationCompletedAgain(observed, current) {
+		events = append(events, utils.PrecedingOperationCompletedEvent)
+	}
+	return
+}
+
+// observeEventState records the mount points of source dataset and the completion time of the preceding operation.
+func (o *OnEventStatusHandler) observeEventState(ctx cruntime.ReconcileRequestContext, eventStatus *datav1alpha1.EventStatus) error {
+	if source := o.dataMigrate.Spec.From.DataSet; source != nil {
+		namespace := source.Namespace
+		if len(namespace) == 0 {
+			namespace = o.dataMigrate.GetNamespace()
+		}
+		dataset, err := utils.GetDataset(o.Client, source.Name, namespace)
+		if err != nil {
+			ctx.Log.Error(err, "can't get source dataset", "namespace", namespace, "dataset", source.Name)
+			return err
+		}
+		eventStatus.ObservedDataset = utils.GetObservedDatasetState(dataset)
+	}
+
+	precedingCompletionTime, err := utils.GetLatestPrecedingCompletionTime(o.Client, o.dataMigrate)
+	if err != nil {
+		ctx.Log.Error(err, "can't get preceding operations")
+		return err
+	}
+	eventStatus.ObservedPrecedingCompletionTime = precedingCompletionTime
+
+	return nil
 }
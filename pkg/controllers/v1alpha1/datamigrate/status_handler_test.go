@@ -17,7 +17,9 @@
 package datamigrate
 
 import (
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	appsv1 "k8s.io/api/apps/v1"
 	"reflect"
@@ -32,6 +34,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 )
 
 func TestOnceGetOperationStatus(t *testing.T) {
@@ -363,3 +366,159 @@ func TestCronGetOperationStatusWithParallelTasks(t1 *testing.T) {
 		})
 	}
 }
+
+func TestOnEventGetOperationStatus(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = batchv1.AddToScheme(testScheme)
+
+	patch := gomonkey.ApplyFunc(helm.DeleteReleaseIfExists, func(name, namespace string) error {
+		return nil
+	})
+	defer patch.Reset()
+
+	completeTime := v1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	precedingCompleteTime := v1.NewTime(completeTime.Add(-time.Minute))
+
+	mockJob := batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-migrate-migrate",
+			Namespace: "default",
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:               batchv1.JobComplete,
+					LastProbeTime:      completeTime,
+					LastTransitionTime: completeTime,
+				},
+			},
+		},
+	}
+
+	observedStatus := &v1alpha1.EventStatus{
+		ObservedDataset: v1alpha1.ObservedDatasetState{
+			MountPoints: []string{"oss://bucket/a"},
+		},
+		ObservedPrecedingCompletionTime: &precedingCompleteTime,
+	}
+
+	testcases := []struct {
+		name                  string
+		opStatus              v1alpha1.OperationStatus
+		mountPoints           []string
+		precedingCompleteTime v1.Time
+		expectedPhase         common.Phase
+		expectedEvent         string
+		expectedTriggerdCount int32
+		expectedHistoryLength int
+	}{
+		{
+			name:                  "first run complete",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseExecuting},
+			mountPoints:           []string{"oss://bucket/a"},
+			precedingCompleteTime: precedingCompleteTime,
+			expectedPhase:         common.PhaseComplete,
+			expectedHistoryLength: 1,
+		},
+		{
+			name:                  "no event happened",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseComplete, EventStatus: observedStatus},
+			mountPoints:           []string{"oss://bucket/a"},
+			precedingCompleteTime: precedingCompleteTime,
+			expectedPhase:         common.PhaseComplete,
+		},
+		{
+			name:                  "source dataset mounts changed",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseComplete, EventStatus: observedStatus},
+			mountPoints:           []string{"oss://bucket/b"},
+			precedingCompleteTime: precedingCompleteTime,
+			expectedPhase:         common.PhasePending,
+			expectedEvent:         utils.MountsChangedEvent,
+			expectedTriggerdCount: 1,
+		},
+		{
+			name:                  "preceding operation completed again",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseFailed, EventStatus: observedStatus},
+			mountPoints:           []string{"oss://bucket/a"},
+			precedingCompleteTime: v1.NewTime(completeTime.Add(time.Hour)),
+			expectedPhase:         common.PhasePending,
+			expectedEvent:         utils.PrecedingOperationCompletedEvent,
+			expectedTriggerdCount: 1,
+		},
+	}
+
+	for _, testcase := range testcases {
+		dataset := &v1alpha1.Dataset{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "source",
+				Namespace: "default",
+			},
+		}
+		for _, mountPoint := range testcase.mountPoints {
+			dataset.Status.Mounts = append(dataset.Status.Mounts, v1alpha1.Mount{MountPoint: mountPoint})
+		}
+		dataProcess := &v1alpha1.DataProcess{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "preprocess",
+				Namespace: "default",
+			},
+			Status: v1alpha1.OperationStatus{
+				Phase: common.PhaseComplete,
+				Conditions: []v1alpha1.Condition{
+					{Type: common.Complete, LastTransitionTime: testcase.precedingCompleteTime},
+				},
+			},
+		}
+		dataMigrate := &v1alpha1.DataMigrate{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.DataMigrateSpec{
+				From: v1alpha1.DataToMigrate{
+					DataSet: &v1alpha1.DatasetToMigrate{Name: "source"},
+				},
+				Policy:      v1alpha1.OnEvent,
+				Parallelism: 1,
+				RunAfter: &v1alpha1.OperationRef{
+					ObjectRef: v1alpha1.ObjectRef{Kind: "DataProcess", Name: "preprocess"},
+				},
+			},
+			Status: testcase.opStatus,
+		}
+
+		client := fake.NewFakeClientWithScheme(testScheme, dataMigrate, dataset, dataProcess, mockJob.DeepCopy())
+		onEventStatusHandler := &OnEventStatusHandler{Client: client, Log: fake.NullLogger(), dataMigrate: dataMigrate}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+			Log:      fake.NullLogger(),
+			Recorder: record.NewFakeRecorder(1),
+		}
+		opStatus, err := onEventStatusHandler.GetOperationStatus(ctx, &dataMigrate.Status)
+		if err != nil {
+			t.Errorf("testcase %s: fail to GetOperationStatus with error %v", testcase.name, err)
+			continue
+		}
+		if opStatus.Phase != testcase.expectedPhase {
+			t.Errorf("testcase %s: expected phase %s, get %s", testcase.name, testcase.expectedPhase, opStatus.Phase)
+		}
+		if opStatus.EventStatus == nil {
+			t.Errorf("testcase %s: expected event status recorded, get nil", testcase.name)
+			continue
+		}
+		if opStatus.EventStatus.LastTriggeredEvent != testcase.expectedEvent || opStatus.EventStatus.TriggeredCount != testcase.expectedTriggerdCount {
+			t.Errorf("testcase %s: expected event %s triggered %d times, get %v", testcase.name, testcase.expectedEvent, testcase.expectedTriggerdCount, opStatus.EventStatus)
+		}
+		if !opStatus.EventStatus.ObservedPrecedingCompletionTime.Equal(&testcase.precedingCompleteTime) ||
+			!reflect.DeepEqual(opStatus.EventStatus.ObservedDataset.MountPoints, testcase.mountPoints) {
+			t.Errorf("testcase %s: expected observed state refreshed, get %v", testcase.name, opStatus.EventStatus)
+		}
+		if len(opStatus.EventStatus.RunHistory) != testcase.expectedHistoryLength {
+			t.Errorf("testcase %s: expected %d records in history, get %v", testcase.name, testcase.expectedHistoryLength, opStatus.EventStatus.RunHistory)
+		}
+	}
+}
@@ -20,11 +20,16 @@ import (
 	"context"
 	"fmt"
 
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
@@ -33,6 +38,8 @@ import (
 	cdataprocess "github.com/fluid-cloudnative/fluid/pkg/dataprocess"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 )
@@ -113,12 +120,87 @@ func (r *DataProcessReconciler) Reconcile(context context.Context, req ctrl.Requ
 	return r.ReconcileInternal(ctx)
 }
 
+// precedingOperationKinds are the kinds of preceding operations whose completion triggers DataProcesses with OnEvent policy
+var precedingOperationKinds = map[string]struct {
+	object        client.Object
+	operationType dataoperation.OperationType
+}{
+	"dataload":    {object: &datav1alpha1.DataLoad{}, operationType: dataoperation.DataLoadType},
+	"datamigrate": {object: &datav1alpha1.DataMigrate{}, operationType: dataoperation.DataMigrateType},
+	"dataprocess": {object: &datav1alpha1.DataProcess{}, operationType: dataoperation.DataProcessType},
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *DataProcessReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	bld := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
-		For(&datav1alpha1.DataProcess{}).
-		Complete(r)
+		For(&datav1alpha1.DataProcess{})
+	if compatibility.IsBatchV1CronJobSupported() {
+		bld = bld.Owns(&batchv1.CronJob{})
+	} else {
+		ctrl.Log.Info("batch/v1 cronjobs cannot be found in cluster, fallback to watch batch/v1beta1 cronjobs for compatibility")
+		bld = bld.Owns(&batchv1beta1.CronJob{})
+	}
+
+	bld = bld.Watches(&datav1alpha1.Dataset{}, handler.EnqueueRequestsFromMapFunc(r.findOnEventDataProcessesForDataset))
+	for kind, preceding := range precedingOperationKinds {
+		if discovery.GetFluidDiscovery().ResourceEnabled(kind) {
+			bld = bld.Watches(preceding.object, handler.EnqueueRequestsFromMapFunc(r.findOnEventDataProcessesRunAfter(preceding.operationType)))
+		}
+	}
+
+	return bld.Complete(r)
+}
+
+// findOnEventDataProcessesForDataset maps the changed dataset to the DataProcesses with OnEvent policy processing it,
+// so that these DataProcesses can check if a new run should be triggered.
+func (r *DataProcessReconciler) findOnEventDataProcessesForDataset(ctx context.Context, dataset client.Object) []reconcile.Request {
+	return r.findOnEventDataProcesses(ctx, func(dataProcess *datav1alpha1.DataProcess) bool {
+		return dataProcess.Spec.Dataset.Name == dataset.GetName() && dataProcess.Spec.Dataset.Namespace == dataset.GetNamespace()
+	})
+}
+
+// findOnEventDataProcessesRunAfter returns a map function which maps the changed operation to the DataProcesses
+// with OnEvent policy running after it.
+func (r *DataProcessReconciler) findOnEventDataProcessesRunAfter(operationType dataoperation.OperationType) handler.MapFunc {
+	return func(ctx context.Context, operation client.Object) []reconcile.Request {
+		return r.findOnEventDataProcesses(ctx, func(dataProcess *datav1alpha1.DataProcess) bool {
+			runAfters, _ := utils.GetPrecedingOperations(dataProcess)
+			for _, runAfter := range runAfters {
+				if runAfter.Kind != string(operationType) || runAfter.Name != operation.GetName() {
+					continue
+				}
+				namespace := runAfter.Namespace
+				if len(namespace) == 0 {
+					namespace = dataProcess.Namespace
+				}
+				if namespace == operation.GetNamespace() {
+					return true
+				}
+			}
+			return false
+		})
+	}
+}
+
+func (r *DataProcessReconciler) findOnEventDataProcesses(ctx context.Context, match func(*datav1alpha1.DataProcess) bool) []reconcile.Request {
+	dataProcessList := &datav1alpha1.DataProcessList{}
+	if err := r.List(ctx, dataProcessList); err != nil {
+		r.Log.Error(err, "failed to list DataProcesses")
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for i := range dataProcessList.Items {
+		dataProcess := &dataProcessList.Items[i]
+		if dataProcess.Spec.Policy != datav1alpha1.OnEvent || !match(dataProcess) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: dataProcess.Namespace, Name: dataProcess.Name},
+		})
+	}
+	return requests
 }
 
 func (r *DataProcessReconciler) ControllerName() string {
@@ -50,7 +50,7 @@ func (r *dataProcessOperation) GetOperationObject() client.Object {
 }
 
 func (r *dataProcessOperation) HasPrecedingOperation() bool {
-	return r.dataProcess.Spec.RunAfter != nil
+	return r.dataProcess.Spec.RunAfter != nil || r.dataProcess.Spec.RunAfterList != nil
 }
 
 func (r *dataProcessOperation) GetPossibleTargetDatasetNamespacedNames() []types.NamespacedName {
@@ -65,6 +65,11 @@ func (r *dataProcessOperation) GetTargetDataset() (*datav1alpha1.Dataset, error)
 	return utils.GetDataset(r.Client, dataProcess.Spec.Dataset.Name, dataProcess.Spec.Dataset.Namespace)
 }
 
+// GetRelatedDatasetNamespacedNames returns the datasets in spec.datasets, which are locked together with spec.dataset.
+func (r *dataProcessOperation) GetRelatedDatasetNamespacedNames() []types.NamespacedName {
+	return cdataprocess.GetRelatedDatasetNamespacedNames(r.dataProcess)
+}
+
 // GetReleaseNameSpacedName get the installed helm chart name
 func (r *dataProcessOperation) GetReleaseNameSpacedName() types.NamespacedName {
 	return types.NamespacedName{
@@ -201,6 +206,52 @@ func (r *dataProcessOperation) Validate(ctx runtime.ReconcileRequestContext) ([]
 		}, err
 	}
 
+	// DataProcess should have valid sharding
+	if err := cdataprocess.ValidateSharding(dataProcess); err != nil {
+		r.Recorder.Eventf(dataProcess,
+			corev1.EventTypeWarning,
+			common.DataProcessInvalidSharding,
+			"DataProcess(%s) has invalid sharding: %v",
+			dataProcess.Name,
+			err,
+		)
+
+		now := time.Now()
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             corev1.ConditionTrue,
+				Reason:             common.DataProcessInvalidSharding,
+				Message:            err.Error(),
+				LastProbeTime:      metav1.NewTime(now),
+				LastTransitionTime: metav1.NewTime(now),
+			},
+		}, fmt.Errorf("DataProcess(%s/%s) has invalid sharding: %v", dataProcess.Namespace, dataProcess.Name, err)
+	}
+
+	// DataProcess should have valid datasets besides the target dataset
+	if err := cdataprocess.ValidateDatasets(dataProcess); err != nil {
+		r.Recorder.Eventf(dataProcess,
+			corev1.EventTypeWarning,
+			common.DataProcessInvalidDatasets,
+			"DataProcess(%s) has invalid spec.datasets: %v",
+			dataProcess.Name,
+			err,
+		)
+
+		now := time.Now()
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             corev1.ConditionTrue,
+				Reason:             common.DataProcessInvalidDatasets,
+				Message:            err.Error(),
+				LastProbeTime:      metav1.NewTime(now),
+				LastTransitionTime: metav1.NewTime(now),
+			},
+		}, fmt.Errorf("DataProcess(%s/%s) has invalid spec.datasets: %v", dataProcess.Namespace, dataProcess.Name, err)
+	}
+
 	return nil, nil
 }
 
@@ -216,21 +267,55 @@ func (r *dataProcessOperation) SetTargetDatasetStatusInProgress(dataset *datav1a
 
 // RemoveTargetDatasetStatusInProgress remove the dataset status for certain field when data operation finished.
 func (r *dataProcessOperation) RemoveTargetDatasetStatusInProgress(dataset *datav1alpha1.Dataset) {
-	// DataProcess does not need to recover Dataset status after execution.
+	// The files of the output datasets are changed by the DataProcess, clear the ufs total and the file number
+	// to trigger the metadata sync of the runtime.
+	if r.dataProcess.Status.Phase != common.PhaseComplete {
+		return
+	}
+	if cdataprocess.IsOutputDataset(r.dataProcess, types.NamespacedName{Namespace: dataset.Namespace, Name: dataset.Name}) {
+		dataset.Status.UfsTotal = ""
+		dataset.Status.FileNum = ""
+	}
 }
 
 func (r *dataProcessOperation) GetStatusHandler() dataoperation.StatusHandler {
-	// TODO: Support dataProcess.Spec.Policy
-	return &OnceStatusHandler{Client: r.Client, dataProcess: r.dataProcess}
+	policy := r.dataProcess.Spec.Policy
+
+	switch policy {
+	// DataProcess created before policy is supported has an empty policy
+	case datav1alpha1.Once, "":
+		return &OnceStatusHandler{Client: r.Client, dataProcess: r.dataProcess}
+	case datav1alpha1.Cron:
+		return &CronStatusHandler{Client: r.Client, dataProcess: r.dataProcess}
+	case datav1alpha1.OnEvent:
+		return &OnEventStatusHandler{Client: r.Client, dataProcess: r.dataProcess}
+	default:
+		return nil
+	}
 }
 
 // GetTTL implements dataoperation.OperationInterface.
 func (r *dataProcessOperation) GetTTL() (ttl *int32, err error) {
+	dataProcess := r.dataProcess
+
+	policy := dataProcess.Spec.Policy
+	switch policy {
+	case datav1alpha1.Once, "":
+		ttl = dataProcess.Spec.TTLSecondsAfterFinished
+	case datav1alpha1.Cron, datav1alpha1.OnEvent:
+		// For Cron and OnEvent policies, no TTL is provided
+		ttl = nil
+	default:
+		err = fmt.Errorf("unknown policy type: %s", policy)
+	}
 
-	ttl = r.dataProcess.Spec.TTLSecondsAfterFinished
 	return
 }
 
 func (r *dataProcessOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
+
+func (r *dataProcessOperation) GetThrottle() *datav1alpha1.Throttle {
+	return nil
+}
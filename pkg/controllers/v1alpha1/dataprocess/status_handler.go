@@ -21,12 +21,16 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation/onevent"
+	cdataprocess "github.com/fluid-cloudnative/fluid/pkg/dataprocess"
 	"github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -37,6 +41,20 @@ type OnceStatusHandler struct {
 
 var _ dataoperation.StatusHandler = &OnceStatusHandler{}
 
+type CronStatusHandler struct {
+	client.Client
+	dataProcess *datav1alpha1.DataProcess
+}
+
+var _ dataoperation.StatusHandler = &CronStatusHandler{}
+
+type OnEventStatusHandler struct {
+	client.Client
+	dataProcess *datav1alpha1.DataProcess
+}
+
+var _ dataoperation.StatusHandler = &OnEventStatusHandler{}
+
 // GetOperationStatus get operation status according to helm chart status
 func (handler *OnceStatusHandler) GetOperationStatus(ctx runtime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
 	result = opStatus.DeepCopy()
@@ -62,7 +80,13 @@ func (handler *OnceStatusHandler) GetOperationStatus(ctx runtime.ReconcileReques
 		return
 	}
 
-	finishedJobCondition := kubeclient.GetFinishedJobCondition(job)
+	updateShardStatus(result, object, job)
+
+	finishedJobCondition, err := cdataprocess.GetFinishedJobCondition(handler.Client, job)
+	if err != nil {
+		ctx.Log.Error(err, "can't get the finished condition of dataprocess job", "namespace", ctx.Namespace, "jobName", jobName)
+		return nil, err
+	}
 	if finishedJobCondition == nil {
 		ctx.Log.V(1).Info("DataProcess job still running", "namespace", ctx.Namespace, "jobName", jobName)
 		return
@@ -78,16 +102,14 @@ func (handler *OnceStatusHandler) GetOperationStatus(ctx runtime.ReconcileReques
 	}
 
 	// job either failed or complete, update DataLoad's phase status
-	jobCondition := job.Status.Conditions[0]
-
 	result.Conditions = []datav1alpha1.Condition{
 		{
-			Type:               common.ConditionType(jobCondition.Type),
-			Status:             jobCondition.Status,
-			Reason:             jobCondition.Reason,
-			Message:            jobCondition.Message,
-			LastProbeTime:      jobCondition.LastProbeTime,
-			LastTransitionTime: jobCondition.LastTransitionTime,
+			Type:               common.ConditionType(finishedJobCondition.Type),
+			Status:             finishedJobCondition.Status,
+			Reason:             finishedJobCondition.Reason,
+			Message:            finishedJobCondition.Message,
+			LastProbeTime:      finishedJobCondition.LastProbeTime,
+			LastTransitionTime: finishedJobCondition.LastTransitionTime,
 		},
 	}
 
@@ -96,7 +118,168 @@ func (handler *OnceStatusHandler) GetOperationStatus(ctx runtime.ReconcileReques
 	} else {
 		result.Phase = common.PhaseFailed
 	}
-	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, jobCondition.LastTransitionTime.Time)
+	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
 
 	return
 }
+
+// GetOperationStatus get operation status according to the newest job scheduled by the cronjob
+func (handler *CronStatusHandler) GetOperationStatus(ctx runtime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	result = opStatus.DeepCopy()
+	object := handler.dataProcess
+
+	releaseName := utils.GetDataProcessReleaseName(object.GetName())
+	cronjobName := utils.GetDataProcessJobName(releaseName)
+
+	cronjobStatus, err := kubeclient.GetCronJobStatus(handler.Client, types.NamespacedName{Namespace: object.GetNamespace(), Name: cronjobName})
+	if err != nil {
+		// In case of NotFound error
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.Info("Related cronjob missing, will delete helm chart and retry", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+			if err = helm.DeleteReleaseIfExists(releaseName, ctx.Namespace); err != nil {
+				ctx.Log.Error(err, "failed to delete dataprocess helm release", "namespace", ctx.Namespace, "releaseName", releaseName)
+				return
+			}
+			return
+		}
+
+		// In cases of other error
+		ctx.Log.Error(err, "can't get dataprocess cronjob", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		return
+	}
+
+	// update LastScheduleTime and LastSuccessfulTime
+	result.LastScheduleTime = cronjobStatus.LastScheduleTime
+	result.LastSuccessfulTime = cronjobStatus.LastSuccessfulTime
+	if cronjobStatus.LastScheduleTime == nil {
+		ctx.Log.V(1).Info("DataProcess cronjob has not been scheduled yet", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		return
+	}
+
+	jobs, err := utils.ListDataOperationJobByCronjob(handler.Client, types.NamespacedName{Namespace: object.GetNamespace(), Name: cronjobName})
+	if err != nil {
+		ctx.Log.Error(err, "can't list dataprocess job by cronjob", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		return
+	}
+
+	// get the newest job
+	var currentJob *batchv1.Job
+	for i := range jobs {
+		if !jobs[i].CreationTimestamp.Before(cronjobStatus.LastScheduleTime) {
+			currentJob = &jobs[i]
+			break
+		}
+	}
+	if currentJob == nil {
+		ctx.Log.Info("can't get newest job by cronjob, skip", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		return
+	}
+
+	updateShardStatus(result, object, currentJob)
+
+	finishedJobCondition := kubeclient.GetFinishedJobCondition(currentJob)
+	if finishedJobCondition == nil {
+		ctx.Log.V(1).Info("DataProcess job still running", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		if opStatus.Phase == common.PhaseComplete || opStatus.Phase == common.PhaseFailed {
+			// the last job finished but a new job is scheduled, set the DataProcess to pending first,
+			// the target dataset will be locked again when the DataProcess is pending.
+			result.Phase = common.PhasePending
+			result.Duration = "-"
+		}
+		return
+	}
+
+	// job either failed or complete, update DataProcess's phase status
+	result.Conditions = []datav1alpha1.Condition{
+		{
+			Type:               common.ConditionType(finishedJobCondition.Type),
+			Status:             finishedJobCondition.Status,
+			Reason:             finishedJobCondition.Reason,
+			Message:            finishedJobCondition.Message,
+			LastProbeTime:      finishedJobCondition.LastProbeTime,
+			LastTransitionTime: finishedJobCondition.LastTransitionTime,
+		},
+	}
+	if finishedJobCondition.Type == batchv1.JobFailed {
+		result.Phase = common.PhaseFailed
+	} else {
+		result.Phase = common.PhaseComplete
+	}
+	result.Duration = utils.CalculateDuration(currentJob.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
+
+	return
+}
+
+// GetOperationStatus get operation status of the current run, and starts a new run when any event happened after the last run finished
+func (handler *OnEventStatusHandler) GetOperationStatus(ctx runtime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	object := handler.dataProcess
+	releaseName := utils.GetDataProcessReleaseName(object.GetName())
+	statusHandler := &onevent.StatusHandler{
+		Client:            handler.Client,
+		Operation:         object,
+		OperationType:     dataoperation.DataProcessType,
+		ReleaseName:       releaseName,
+		JobName:           utils.GetDataProcessJobName(releaseName),
+		ObserveEventState: handler.observeEventState,
+		DetectEvents:      detectEvents,
+		UpdateJobStatus: func(result *datav1alpha1.OperationStatus, job *batchv1.Job) {
+			updateShardStatus(result, object, job)
+		},
+		FinishedJobCondition: func(job *batchv1.Job) (*batchv1.JobCondition, error) {
+			return cdataprocess.GetFinishedJobCondition(handler.Client, job)
+		},
+	}
+	return statusHandler.GetOperationStatus(ctx, opStatus)
+}
+
+// detectEvents detects the events on the target dataset and the completion of the preceding operation.
+func detectEvents(observed, current *datav1alpha1.EventStatus) []string {
+	events := utils.DetectDatasetEvents(observed.ObservedDataset, current.ObservedDataset)
+	if onevent.IsPrecedingOperationCompletedAgain(observed, current) {
+		events = append(events, utils.PrecedingOperationCompletedEvent)
+	}
+	return events
+}
+
+// observeEventState records the state of target dataset and the completion time of the preceding operation.
+func (handler *OnEventStatusHandler) observeEventState(ctx runtime.ReconcileRequestContext, eventStatus *datav1alpha1.EventStatus) error {
+	object := handler.dataProcess
+
+	dataset, err := utils.GetDataset(handler.Client, object.Spec.Dataset.Name, object.Spec.Dataset.Namespace)
+	if err != nil {
+		ctx.Log.Error(err, "can't get target dataset", "dataset", object.Spec.Dataset.Name)
+		return err
+	}
+	eventStatus.ObservedDataset = utils.GetObservedDatasetState(dataset)
+
+	precedingCompletionTime, err := utils.GetLatestPrecedingCompletionTime(handler.Client, object)
+	if err != nil {
+		ctx.Log.Error(err, "can't get preceding operations")
+		return err
+	}
+	eventStatus.ObservedPrecedingCompletionTime = precedingCompletionTime
+
+	return nil
+}
+
+// updateShardStatus records the progress of the shards, i.e. the completed and failed indexes of the Indexed Job.
+// The failed shards are not retried after the DataProcess fails, the failed indexes are recorded for users to
+// process the files of these shards again with a new DataProcess.
+func updateShardStatus(result *datav1alpha1.OperationStatus, dataProcess *datav1alpha1.DataProcess, job *batchv1.Job) {
+	if dataProcess.Spec.Sharding == nil {
+		result.ShardStatus = nil
+		return
+	}
+
+	shardStatus := &datav1alpha1.ShardStatus{
+		Shards:           dataProcess.Spec.Sharding.Shards,
+		CompletedIndexes: job.Status.CompletedIndexes,
+		FailedIndexes:    ptr.Deref(job.Status.FailedIndexes, ""),
+	}
+	if job.Spec.Completions != nil {
+		shardStatus.Shards = *job.Spec.Completions
+	}
+	shardStatus.Completed = kubeclient.CountJobIndexes(shardStatus.CompletedIndexes)
+	shardStatus.Failed = kubeclient.CountJobIndexes(shardStatus.FailedIndexes)
+	result.ShardStatus = shardStatus
+}
@@ -20,14 +20,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 )
 
 func TestOnceGetOperationStatus(t *testing.T) {
@@ -111,3 +116,231 @@ func TestOnceGetOperationStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestCronGetOperationStatus(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = batchv1.AddToScheme(testScheme)
+
+	patch := gomonkey.ApplyFunc(compatibility.IsBatchV1CronJobSupported, func() bool {
+		return true
+	})
+	defer patch.Reset()
+
+	lastScheduleTime := v1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	lastTransitionTime := v1.NewTime(lastScheduleTime.Add(time.Minute))
+
+	mockCronJob := batchv1.CronJob{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-processor-job",
+			Namespace: "default",
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 0 * * *",
+		},
+		Status: batchv1.CronJobStatus{
+			LastScheduleTime: &lastScheduleTime,
+		},
+	}
+
+	testcases := []struct {
+		name          string
+		phase         common.Phase
+		jobConditions []batchv1.JobCondition
+		expectedPhase common.Phase
+	}{
+		{
+			name:  "job complete",
+			phase: common.PhaseExecuting,
+			jobConditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, LastTransitionTime: lastTransitionTime},
+			},
+			expectedPhase: common.PhaseComplete,
+		},
+		{
+			name:  "job failed",
+			phase: common.PhaseExecuting,
+			jobConditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, LastTransitionTime: lastTransitionTime},
+			},
+			expectedPhase: common.PhaseFailed,
+		},
+		{
+			name:          "new job scheduled after last run complete",
+			phase:         common.PhaseComplete,
+			expectedPhase: common.PhasePending,
+		},
+	}
+
+	for _, testcase := range testcases {
+		dataProcess := &v1alpha1.DataProcess{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.DataProcessSpec{
+				Policy:   v1alpha1.Cron,
+				Schedule: "0 0 * * *",
+			},
+			Status: v1alpha1.OperationStatus{Phase: testcase.phase},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{
+				Name:              "test-processor-job-1",
+				Namespace:         "default",
+				Labels:            map[string]string{"cronjob": "test-processor-job"},
+				CreationTimestamp: lastScheduleTime,
+			},
+			Status: batchv1.JobStatus{
+				Conditions: testcase.jobConditions,
+			},
+		}
+
+		client := fake.NewFakeClientWithScheme(testScheme, dataProcess, mockCronJob.DeepCopy(), job)
+		cronStatusHandler := &CronStatusHandler{Client: client, dataProcess: dataProcess}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+			Log: fake.NullLogger(),
+		}
+		opStatus, err := cronStatusHandler.GetOperationStatus(ctx, &dataProcess.Status)
+		if err != nil {
+			t.Errorf("testcase %s: fail to GetOperationStatus with error %v", testcase.name, err)
+			continue
+		}
+		if opStatus.Phase != testcase.expectedPhase {
+			t.Errorf("testcase %s: expected phase %s, get %s", testcase.name, testcase.expectedPhase, opStatus.Phase)
+		}
+		if !opStatus.LastScheduleTime.Equal(&lastScheduleTime) {
+			t.Errorf("testcase %s: expected last schedule time %v, get %v", testcase.name, lastScheduleTime, opStatus.LastScheduleTime)
+		}
+	}
+}
+
+func TestOnEventGetOperationStatus(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = batchv1.AddToScheme(testScheme)
+
+	patch := gomonkey.ApplyFunc(helm.DeleteReleaseIfExists, func(name, namespace string) error {
+		return nil
+	})
+	defer patch.Reset()
+
+	completeTime := v1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mockJob := batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-processor-job",
+			Namespace: "default",
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:               batchv1.JobComplete,
+					LastProbeTime:      completeTime,
+					LastTransitionTime: completeTime,
+				},
+			},
+		},
+	}
+
+	observedStatus := &v1alpha1.EventStatus{
+		ObservedDataset: v1alpha1.ObservedDatasetState{
+			UfsTotal:    "10.00GiB",
+			FileNum:     "100",
+			MountPoints: []string{"oss://bucket/a"},
+		},
+	}
+
+	testcases := []struct {
+		name                  string
+		opStatus              v1alpha1.OperationStatus
+		fileNum               string
+		expectedPhase         common.Phase
+		expectedEvent         string
+		expectedTriggerdCount int32
+	}{
+		{
+			name:          "first run complete",
+			opStatus:      v1alpha1.OperationStatus{Phase: common.PhaseExecuting},
+			fileNum:       "100",
+			expectedPhase: common.PhaseComplete,
+		},
+		{
+			name:          "no event happened",
+			opStatus:      v1alpha1.OperationStatus{Phase: common.PhaseComplete, EventStatus: observedStatus},
+			fileNum:       "100",
+			expectedPhase: common.PhaseComplete,
+		},
+		{
+			name:                  "ufs changed",
+			opStatus:              v1alpha1.OperationStatus{Phase: common.PhaseComplete, EventStatus: observedStatus},
+			fileNum:               "200",
+			expectedPhase:         common.PhasePending,
+			expectedEvent:         utils.UFSChangedEvent,
+			expectedTriggerdCount: 1,
+		},
+	}
+
+	for _, testcase := range testcases {
+		dataset := &v1alpha1.Dataset{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "demo",
+				Namespace: "default",
+			},
+			Status: v1alpha1.DatasetStatus{
+				UfsTotal: "10.00GiB",
+				FileNum:  testcase.fileNum,
+				Mounts: []v1alpha1.Mount{
+					{MountPoint: "oss://bucket/a"},
+				},
+			},
+		}
+		dataProcess := &v1alpha1.DataProcess{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.DataProcessSpec{
+				Dataset: v1alpha1.TargetDatasetWithMountPath{
+					TargetDataset: v1alpha1.TargetDataset{Name: "demo", Namespace: "default"},
+					MountPath:     "/data",
+				},
+				Policy: v1alpha1.OnEvent,
+			},
+			Status: testcase.opStatus,
+		}
+
+		client := fake.NewFakeClientWithScheme(testScheme, dataProcess, dataset, mockJob.DeepCopy())
+		onEventStatusHandler := &OnEventStatusHandler{Client: client, dataProcess: dataProcess}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+			Log:      fake.NullLogger(),
+			Recorder: record.NewFakeRecorder(1),
+		}
+		opStatus, err := onEventStatusHandler.GetOperationStatus(ctx, &dataProcess.Status)
+		if err != nil {
+			t.Errorf("testcase %s: fail to GetOperationStatus with error %v", testcase.name, err)
+			continue
+		}
+		if opStatus.Phase != testcase.expectedPhase {
+			t.Errorf("testcase %s: expected phase %s, get %s", testcase.name, testcase.expectedPhase, opStatus.Phase)
+		}
+		if opStatus.EventStatus == nil {
+			t.Errorf("testcase %s: expected event status recorded, get nil", testcase.name)
+			continue
+		}
+		if opStatus.EventStatus.LastTriggeredEvent != testcase.expectedEvent || opStatus.EventStatus.TriggeredCount != testcase.expectedTriggerdCount {
+			t.Errorf("testcase %s: expected event %s triggered %d times, get %v", testcase.name, testcase.expectedEvent, testcase.expectedTriggerdCount, opStatus.EventStatus)
+		}
+		if opStatus.EventStatus.ObservedDataset.FileNum != testcase.fileNum {
+			t.Errorf("testcase %s: expected observed state refreshed, get %v", testcase.name, opStatus.EventStatus)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheadmission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	stateHeld     = "Held"
+	stateAdmitted = "Admitted"
+	stateFinished = "Finished"
+
+	defaultResyncPeriod = 10 * time.Second
+)
+
+// WorkloadReconciler holds a kind of workload until the cache of the dataset is prewarmed, and optionally starts
+// a DataMigrate or a DataProcess (e.g. evicting the cache) after the workload finishes.
+type WorkloadReconciler struct {
+	// Client reads the Datasets and the DataLoads, and writes the workloads
+	client.Client
+	// Reader reads the workloads from the api server, since they are only watched with metadata
+	Reader       client.Reader
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+	kind         workloadKind
+}
+
+func NewWorkloadReconciler(client client.Client,
+	reader client.Reader,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	kind workloadKind) *WorkloadReconciler {
+	return &WorkloadReconciler{
+		Client:       client,
+		Reader:       reader,
+		Recorder:     recorder,
+		Log:          log.WithValues("kind", kind.gvk.Kind),
+		ResyncPeriod: defaultResyncPeriod,
+		kind:         kind,
+	}
+}
+
+func (r *WorkloadReconciler) ControllerName() string {
+	return "CacheAdmission" + r.kind.gvk.Kind + "Controller"
+}
+
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasets;dataloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datamigrates;dataprocesses,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+func (r *WorkloadReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.Log.WithValues("namespacedName", request.NamespacedName)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(r.kind.gvk)
+	if err := r.Reader.Get(ctx, request.NamespacedName, obj); err != nil {
+		if apierrs.IsNotFound(err) {
+			return utils.NoRequeue()
+		}
+		return utils.RequeueIfError(err)
+	}
+
+	// The Jobs managed by Kueue are held by their Workloads
+	if r.kind.gvk != KueueWorkloadKind.gvk {
+		if _, found := obj.GetLabels()[kueueQueueNameLabel]; found {
+			return utils.NoRequeue()
+		}
+	}
+
+	annotations, err := r.policyAnnotations(ctx, obj)
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+	policy, err := parsePolicy(annotations, obj.GetNamespace())
+	if err != nil {
+		log.Error(err, "invalid cache admission policy")
+		r.Recorder.Eventf(obj, corev1.EventTypeWarning, common.WorkloadAdmissionFailed, "Invalid cache admission policy: %v", err)
+		return utils.NoRequeue()
+	}
+	if policy == nil {
+		return utils.NoRequeue()
+	}
+
+	state := obj.GetAnnotations()[common.AnnotationCacheAdmissionState]
+	switch {
+	case state == stateFinished:
+		return utils.NoRequeue()
+	case r.kind.isFinished(obj):
+		return r.reconcileFinished(ctx, log, obj, policy)
+	case state == stateAdmitted:
+		return utils.NoRequeue()
+	}
+
+	satisfied, reason, err := policy.isSatisfied(ctx, r.Client)
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+	if satisfied {
+		if err = r.kind.setHeld(obj, false); err != nil {
+			return utils.RequeueIfError(err)
+		}
+		if err = r.updateState(ctx, obj, stateAdmitted); err != nil {
+			return utils.RequeueIfError(err)
+		}
+		log.Info("The cache is prewarmed, admit the workload")
+		r.Recorder.Event(obj, corev1.EventTypeNormal, common.WorkloadAdmitted, "The cache is prewarmed, admit the workload")
+		return utils.NoRequeue()
+	}
+
+	held := r.kind.isHeld(obj)
+	if !held {
+		if r.kind.createdHeld {
+			log.Info("The workload is not created suspended, skip holding it", "reason", reason)
+			r.Recorder.Eventf(obj, corev1.EventTypeWarning, common.WorkloadAdmissionFailed, "The workload must be created suspended to be held until the cache is prewarmed: %s", reason)
+			return utils.RequeueIfError(r.updateState(ctx, obj, stateAdmitted))
+		}
+		if r.kind.isStarted(obj) {
+			log.Info("The workload has started before the cache is prewarmed, skip holding it", "reason", reason)
+			r.Recorder.Eventf(obj, corev1.EventTypeWarning, common.WorkloadAdmissionFailed, "The workload has started before the cache is prewarmed: %s", reason)
+			return utils.RequeueIfError(r.updateState(ctx, obj, stateAdmitted))
+		}
+		if err = r.kind.setHeld(obj, true); err != nil {
+			return utils.RequeueIfError(err)
+		}
+	}
+	if state != stateHeld || !held {
+		if err = r.updateState(ctx, obj, stateHeld); err != nil {
+			return utils.RequeueIfError(err)
+		}
+		r.Recorder.Eventf(obj, corev1.EventTypeNormal, common.WorkloadHeld, "Hold the workload until the cache is prewarmed: %s", reason)
+	}
+	log.V(1).Info("Hold the workload until the cache is prewarmed", "reason", reason)
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// policyAnnotations returns the annotations of the workload. The Workload of Kueue inherits the annotations of
+// the Job or the JobSet it's created for.
+func (r *WorkloadReconciler) policyAnnotations(ctx context.Context, obj *unstructured.Unstructured) (map[string]string, error) {
+	if r.kind.gvk != KueueWorkloadKind.gvk || hasPolicy(obj.GetAnnotations()) {
+		return obj.GetAnnotations(), nil
+	}
+	ownerRef := metav1.GetControllerOf(obj)
+	if ownerRef == nil {
+		return nil, nil
+	}
+	owner := &unstructured.Unstructured{}
+	owner.SetAPIVersion(ownerRef.APIVersion)
+	owner.SetKind(ownerRef.Kind)
+	if err := r.Reader.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: ownerRef.Name}, owner); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return owner.GetAnnotations(), nil
+}
+
+// reconcileFinished starts the operations after the workload finishes.
+func (r *WorkloadReconciler) reconcileFinished(ctx context.Context, log logr.Logger, obj *unstructured.Unstructured, policy *admissionPolicy) (reconcile.Result, error) {
+	// resources are the resources of the operations, which are checked before creating them
+	var operations []client.Object
+	var resources []string
+	if policy.onFinishDataMigrate != nil {
+		operations = append(operations, &datav1alpha1.DataMigrate{
+			ObjectMeta: onFinishObjectMeta(obj, "migrate"),
+			Spec:       *policy.onFinishDataMigrate,
+		})
+		resources = append(resources, "datamigrates")
+	}
+	if policy.onFinishDataProcess != nil {
+		operations = append(operations, &datav1alpha1.DataProcess{
+			ObjectMeta: onFinishObjectMeta(obj, "process"),
+			Spec:       *policy.onFinishDataProcess,
+		})
+		resources = append(resources, "dataprocesses")
+	}
+	for i, operation := range operations {
+		denied, err := r.deniedServiceAccount(ctx, obj, resources[i])
+		if err != nil {
+			return utils.RequeueIfError(err)
+		}
+		if len(denied) > 0 {
+			log.Info("The service account of the workload is not allowed to create the operation, skip it", "name", operation.GetName(), "serviceAccount", denied)
+			r.Recorder.Eventf(obj, corev1.EventTypeWarning, common.WorkloadAdmissionFailed, "Skip creating %s after the workload finishes, because the service account %s is not allowed to create %s", operation.GetName(), denied, resources[i])
+			continue
+		}
+		if err := r.Create(ctx, operation); err != nil && !apierrs.IsAlreadyExists(err) {
+			log.Error(err, "failed to create the operation after the workload finishes", "name", operation.GetName())
+			r.Recorder.Eventf(obj, corev1.EventTypeWarning, common.WorkloadAdmissionFailed, "Failed to create %s after the workload finishes: %v", operation.GetName(), err)
+			return utils.RequeueIfError(err)
+		}
+		log.Info("Created the operation after the workload finishes", "name", operation.GetName())
+	}
+	return utils.RequeueIfError(r.updateState(ctx, obj, stateFinished))
+}
+
+// deniedServiceAccount returns the first service account the workload runs as which is not allowed to create the
+// resource in the namespace of the workload. The creator of the workload is not recorded, while whoever creates the
+// workload can act as its service accounts, so the operations are created only if they're allowed to create them.
+func (r *WorkloadReconciler) deniedServiceAccount(ctx context.Context, obj *unstructured.Unstructured, resource string) (string, error) {
+	namespace := obj.GetNamespace()
+	for _, serviceAccount := range r.kind.serviceAccounts(obj) {
+		review := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:   fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount),
+				Groups: []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace, "system:authenticated"},
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      "create",
+					Group:     datav1alpha1.GroupVersion.Group,
+					Resource:  resource,
+				},
+			},
+		}
+		if err := r.Create(ctx, review); err != nil {
+			return "", err
+		}
+		if !review.Status.Allowed {
+			return serviceAccount, nil
+		}
+	}
+	return "", nil
+}
+
+func onFinishObjectMeta(obj *unstructured.Unstructured, suffix string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      fmt.Sprintf("%s-%s", obj.GetName(), suffix),
+		Namespace: obj.GetNamespace(),
+		Labels: map[string]string{
+			common.LabelAnnotationManagedBy: common.Fluid,
+		},
+	}
+}
+
+func (r *WorkloadReconciler) updateState(ctx context.Context, obj *unstructured.Unstructured, state string) error {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.AnnotationCacheAdmissionState] = state
+	obj.SetAnnotations(annotations)
+	return r.Update(ctx, obj)
+}
+
+// SetupWithManager watches the metadata of the workloads in a separate cache, since the cache of the manager may
+// filter the Jobs by labels.
+func (r *WorkloadReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options, workloadCache cache.Cache) error {
+	options.Reconciler = r
+	c, err := controller.New(r.ControllerName(), mgr, options)
+	if err != nil {
+		return err
+	}
+
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(r.kind.gvk)
+	return c.Watch(source.Kind(workloadCache, obj), &handler.EnqueueRequestForObject{}, predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return r.shouldInQueue(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectNew.GetResourceVersion() != e.ObjectOld.GetResourceVersion() && r.shouldInQueue(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+	})
+}
+
+func (r *WorkloadReconciler) shouldInQueue(obj client.Object) bool {
+	if obj.GetAnnotations()[common.AnnotationCacheAdmissionState] == stateFinished {
+		return false
+	}
+	// The policy of the Workload may be inherited from its owner
+	if r.kind.gvk == KueueWorkloadKind.gvk {
+		return hasPolicy(obj.GetAnnotations()) || metav1.GetControllerOf(obj) != nil
+	}
+	return hasPolicy(obj.GetAnnotations())
+}
+
+// SetupWithManager registers the reconcilers of the workload kinds installed in the cluster.
+func SetupWithManager(mgr ctrl.Manager, options controller.Options, log logr.Logger) error {
+	workloadCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient: mgr.GetHTTPClient(),
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+	})
+	if err != nil {
+		return err
+	}
+	if err = mgr.Add(workloadCache); err != nil {
+		return err
+	}
+
+	for _, kind := range supportedKinds {
+		if _, err = mgr.GetRESTMapper().RESTMapping(kind.gvk.GroupKind(), kind.gvk.Version); err != nil {
+			log.Info("Skip the cache-aware admission of the workload kind which is not installed", "kind", kind.gvk.String(), "error", err.Error())
+			continue
+		}
+		r := NewWorkloadReconciler(mgr.GetClient(), mgr.GetAPIReader(), log, mgr.GetEventRecorderFor("CacheAdmission"), kind)
+		if err = r.SetupWithManager(mgr, options, workloadCache); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheadmission
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func newTestJob(suspend bool, annotations map[string]string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "train",
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{Suspend: ptr.To(suspend)},
+	}
+}
+
+func newTestReconciler(kind workloadKind, objs ...runtime.Object) (*WorkloadReconciler, client.Client) {
+	s := runtime.NewScheme()
+	_ = batchv1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s, objs...)
+	return NewWorkloadReconciler(c, c, fake.NullLogger(), record.NewFakeRecorder(10), kind), c
+}
+
+func reconcileJob(t *testing.T, r *WorkloadReconciler, c client.Client) (*batchv1.Job, reconcile.Result) {
+	key := types.NamespacedName{Namespace: "default", Name: "train"}
+	result, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+	job := &batchv1.Job{}
+	if err = c.Get(context.TODO(), key, job); err != nil {
+		t.Fatal(err)
+	}
+	return job, result
+}
+
+func TestReconcile_Job(t *testing.T) {
+	dataLoadAnnotations := map[string]string{common.AnnotationCacheAdmissionDataLoad: "hbase-load"}
+	newDataLoad := func(phase common.Phase) *datav1alpha1.DataLoad {
+		return &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-load", Namespace: "default"},
+			Status:     datav1alpha1.OperationStatus{Phase: phase},
+		}
+	}
+	newDataset := func(cachedPercentage string) *datav1alpha1.Dataset {
+		return &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+			Status:     datav1alpha1.DatasetStatus{CacheStates: common.CacheStateList{common.CachedPercentage: cachedPercentage}},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		job         *batchv1.Job
+		objs        []runtime.Object
+		wantSuspend bool
+		wantState   string
+		wantRequeue bool
+	}{
+		{
+			name:        "hold the suspended job until the dataload completes",
+			job:         newTestJob(true, dataLoadAnnotations),
+			objs:        []runtime.Object{newDataLoad(common.PhaseExecuting)},
+			wantSuspend: true,
+			wantState:   stateHeld,
+			wantRequeue: true,
+		},
+		{
+			name:        "admit the job after the dataload completes",
+			job:         newTestJob(true, dataLoadAnnotations),
+			objs:        []runtime.Object{newDataLoad(common.PhaseComplete)},
+			wantSuspend: false,
+			wantState:   stateAdmitted,
+		},
+		{
+			name: "admit the job after the cached percentage reaches the threshold",
+			job: newTestJob(true, map[string]string{
+				common.AnnotationCacheAdmissionDataset:          "hbase",
+				common.AnnotationCacheAdmissionCachedPercentage: "80",
+			}),
+			objs:        []runtime.Object{newDataset("85.2%")},
+			wantSuspend: false,
+			wantState:   stateAdmitted,
+		},
+		{
+			name: "hold the job when the cached percentage is below the threshold",
+			job: newTestJob(true, map[string]string{
+				common.AnnotationCacheAdmissionDataset:          "hbase",
+				common.AnnotationCacheAdmissionCachedPercentage: "80",
+			}),
+			objs:        []runtime.Object{newDataset("12.0%")},
+			wantSuspend: true,
+			wantState:   stateHeld,
+			wantRequeue: true,
+		},
+		{
+			name:        "skip the job which is not created suspended",
+			job:         newTestJob(false, dataLoadAnnotations),
+			wantSuspend: false,
+			wantState:   stateAdmitted,
+		},
+		{
+			name: "skip the job which has started",
+			job: func() *batchv1.Job {
+				job := newTestJob(false, dataLoadAnnotations)
+				job.Status.StartTime = &metav1.Time{Time: time.Now()}
+				return job
+			}(),
+			wantSuspend: false,
+			wantState:   stateAdmitted,
+		},
+		{
+			name: "skip the job managed by kueue",
+			job: func() *batchv1.Job {
+				job := newTestJob(true, dataLoadAnnotations)
+				job.Labels = map[string]string{kueueQueueNameLabel: "user-queue"}
+				return job
+			}(),
+			wantSuspend: true,
+		},
+		{
+			name:        "skip the job without policy",
+			job:         newTestJob(true, nil),
+			wantSuspend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, c := newTestReconciler(JobKind, append(tt.objs, tt.job)...)
+			job, result := reconcileJob(t, r, c)
+			if *job.Spec.Suspend != tt.wantSuspend {
+				t.Errorf("expect suspend %v, got %v", tt.wantSuspend, *job.Spec.Suspend)
+			}
+			if state := job.Annotations[common.AnnotationCacheAdmissionState]; state != tt.wantState {
+				t.Errorf("expect state %q, got %q", tt.wantState, state)
+			}
+			if (result.RequeueAfter > 0) != tt.wantRequeue {
+				t.Errorf("expect requeue %v, got %v", tt.wantRequeue, result)
+			}
+		})
+	}
+}
+
+func TestReconcile_FinishedJob(t *testing.T) {
+	tests := []struct {
+		name              string
+		allowedUser       string
+		wantCreated       bool
+		wantReviewedUsers []string
+	}{
+		{
+			name:              "create the datamigrate allowed for the service account",
+			allowedUser:       "system:serviceaccount:default:trainer",
+			wantCreated:       true,
+			wantReviewedUsers: []string{"system:serviceaccount:default:trainer"},
+		},
+		{
+			name:              "skip the datamigrate denied for the service account",
+			allowedUser:       "system:serviceaccount:default:admin",
+			wantCreated:       false,
+			wantReviewedUsers: []string{"system:serviceaccount:default:trainer"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := newTestJob(false, map[string]string{
+				common.AnnotationCacheAdmissionDataLoad:            "hbase-load",
+				common.AnnotationCacheAdmissionState:               stateAdmitted,
+				common.AnnotationCacheAdmissionOnFinishDataMigrate: `{"from":{"dataset":{"name":"hbase","namespace":"default"}},"to":{"externalStorage":{"uri":"s3://bucket/path"}}}`,
+			})
+			job.Spec.Template.Spec.ServiceAccountName = "trainer"
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: "True"}}
+
+			s := runtime.NewScheme()
+			_ = batchv1.AddToScheme(s)
+			_ = authorizationv1.AddToScheme(s)
+			_ = datav1alpha1.AddToScheme(s)
+			var reviewedUsers []string
+			c := crfake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(job).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if review, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+						reviewedUsers = append(reviewedUsers, review.Spec.User)
+						review.Status.Allowed = review.Spec.User == tt.allowedUser &&
+							review.Spec.ResourceAttributes.Resource == "datamigrates" && review.Spec.ResourceAttributes.Namespace == "default"
+						return nil
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).Build()
+			r := NewWorkloadReconciler(c, c, fake.NullLogger(), record.NewFakeRecorder(10), JobKind)
+
+			job, _ = reconcileJob(t, r, c)
+			if state := job.Annotations[common.AnnotationCacheAdmissionState]; state != stateFinished {
+				t.Errorf("expect state %q, got %q", stateFinished, state)
+			}
+			if !reflect.DeepEqual(reviewedUsers, tt.wantReviewedUsers) {
+				t.Errorf("expect the reviewed users %v, got %v", tt.wantReviewedUsers, reviewedUsers)
+			}
+			dataMigrate := &datav1alpha1.DataMigrate{}
+			err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "train-migrate"}, dataMigrate)
+			if !tt.wantCreated {
+				if !apierrs.IsNotFound(err) {
+					t.Errorf("expect the datamigrate not to be created, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect the datamigrate to be created, got %v", err)
+			}
+			if dataMigrate.Spec.To.ExternalStorage.URI != "s3://bucket/path" {
+				t.Errorf("unexpected datamigrate spec %+v", dataMigrate.Spec)
+			}
+		})
+	}
+}
+
+func TestReconcile_KueueWorkload(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = batchv1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	owner := newTestJob(true, map[string]string{common.AnnotationCacheAdmissionDataLoad: "hbase-load"})
+	owner.UID = "job-uid"
+	workload := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":      "job-train",
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"queueName": "user-queue",
+		},
+	}}
+	workload.SetGroupVersionKind(KueueWorkloadKind.gvk)
+	workload.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: "batch/v1",
+		Kind:       "Job",
+		Name:       owner.Name,
+		UID:        owner.UID,
+		Controller: ptr.To(true),
+	}})
+	c := fake.NewFakeClientWithScheme(s, owner, workload)
+	r := NewWorkloadReconciler(c, c, fake.NullLogger(), record.NewFakeRecorder(10), KueueWorkloadKind)
+
+	key := types.NamespacedName{Namespace: "default", Name: "job-train"}
+	if _, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(KueueWorkloadKind.gvk)
+	if err := c.Get(context.TODO(), key, got); err != nil {
+		t.Fatal(err)
+	}
+	if active, found, _ := unstructured.NestedBool(got.Object, "spec", "active"); !found || active {
+		t.Errorf("expect the workload to be deactivated, got %v", got.Object["spec"])
+	}
+	if state := got.GetAnnotations()[common.AnnotationCacheAdmissionState]; state != stateHeld {
+		t.Errorf("expect state %q, got %q", stateHeld, state)
+	}
+}
+
+func TestServiceAccounts(t *testing.T) {
+	podSpec := func(serviceAccount string) map[string]interface{} {
+		return map[string]interface{}{"serviceAccountName": serviceAccount}
+	}
+	tests := []struct {
+		name string
+		kind workloadKind
+		obj  map[string]interface{}
+		want []string
+	}{
+		{
+			name: "job with the default service account",
+			kind: JobKind,
+			obj:  map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{}}},
+			want: []string{"default"},
+		},
+		{
+			name: "jobset with the service accounts of the replicated jobs",
+			kind: JobSetKind,
+			obj: map[string]interface{}{"spec": map[string]interface{}{"replicatedJobs": []interface{}{
+				map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{"spec": podSpec("worker")}}}},
+				map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{"spec": podSpec("launcher")}}}},
+			}}},
+			want: []string{"launcher", "worker"},
+		},
+		{
+			name: "kueue workload with the service accounts of the pod sets",
+			kind: KueueWorkloadKind,
+			obj: map[string]interface{}{"spec": map[string]interface{}{"podSets": []interface{}{
+				map[string]interface{}{"template": map[string]interface{}{"spec": podSpec("trainer")}},
+				map[string]interface{}{"template": map[string]interface{}{"spec": podSpec("trainer")}},
+			}}},
+			want: []string{"trainer"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.kind.serviceAccounts(&unstructured.Unstructured{Object: tt.obj}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expect service accounts %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
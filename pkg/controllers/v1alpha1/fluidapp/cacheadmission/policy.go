@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheadmission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// admissionPolicy is parsed from the annotations of the workload. The workload is admitted once the DataLoad
+// completes or the cached percentage of the Dataset reaches the threshold.
+type admissionPolicy struct {
+	dataLoad         *types.NamespacedName
+	dataset          *types.NamespacedName
+	cachedPercentage float64
+
+	onFinishDataMigrate *datav1alpha1.DataMigrateSpec
+	onFinishDataProcess *datav1alpha1.DataProcessSpec
+}
+
+// hasPolicy checks if the cache-aware admission is enabled by the annotations.
+func hasPolicy(annotations map[string]string) bool {
+	_, hasDataLoad := annotations[common.AnnotationCacheAdmissionDataLoad]
+	_, hasDataset := annotations[common.AnnotationCacheAdmissionDataset]
+	return hasDataLoad || hasDataset
+}
+
+// parsePolicy parses the admission policy from the annotations, returns nil if the cache-aware admission is not enabled.
+func parsePolicy(annotations map[string]string, namespace string) (*admissionPolicy, error) {
+	if !hasPolicy(annotations) {
+		return nil, nil
+	}
+	policy := &admissionPolicy{}
+	var err error
+	if value, found := annotations[common.AnnotationCacheAdmissionDataLoad]; found {
+		if policy.dataLoad, err = parseNamespacedName(common.AnnotationCacheAdmissionDataLoad, value, namespace); err != nil {
+			return nil, err
+		}
+	}
+	if value, found := annotations[common.AnnotationCacheAdmissionDataset]; found {
+		if policy.dataset, err = parseNamespacedName(common.AnnotationCacheAdmissionDataset, value, namespace); err != nil {
+			return nil, err
+		}
+		threshold, found := annotations[common.AnnotationCacheAdmissionCachedPercentage]
+		if !found {
+			return nil, fmt.Errorf("annotation %s is required with %s", common.AnnotationCacheAdmissionCachedPercentage, common.AnnotationCacheAdmissionDataset)
+		}
+		percentage, err := strconv.ParseFloat(strings.TrimSuffix(threshold, "%"), 64)
+		if err != nil || percentage < 0 || percentage > 100 {
+			return nil, fmt.Errorf("invalid %s %q, it should be a number between 0 and 100", common.AnnotationCacheAdmissionCachedPercentage, threshold)
+		}
+		policy.cachedPercentage = percentage
+	}
+
+	if value, found := annotations[common.AnnotationCacheAdmissionOnFinishDataMigrate]; found {
+		policy.onFinishDataMigrate = &datav1alpha1.DataMigrateSpec{}
+		if err := json.Unmarshal([]byte(value), policy.onFinishDataMigrate); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", common.AnnotationCacheAdmissionOnFinishDataMigrate, err)
+		}
+	}
+	if value, found := annotations[common.AnnotationCacheAdmissionOnFinishDataProcess]; found {
+		policy.onFinishDataProcess = &datav1alpha1.DataProcessSpec{}
+		if err := json.Unmarshal([]byte(value), policy.onFinishDataProcess); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", common.AnnotationCacheAdmissionOnFinishDataProcess, err)
+		}
+	}
+	return policy, nil
+}
+
+// parseNamespacedName parses the object referenced by the annotation, which must be in the namespace of the workload.
+func parseNamespacedName(annotation, value, workloadNamespace string) (*types.NamespacedName, error) {
+	name := value
+	if namespace, nameInNamespace, found := strings.Cut(value, "/"); found {
+		if namespace != workloadNamespace {
+			return nil, fmt.Errorf("%s %q is not in the namespace %s of the workload", annotation, value, workloadNamespace)
+		}
+		name = nameInNamespace
+	}
+	return &types.NamespacedName{Namespace: workloadNamespace, Name: name}, nil
+}
+
+// isSatisfied checks if the cache is prewarmed, and returns the reason if not.
+func (p *admissionPolicy) isSatisfied(ctx context.Context, c client.Reader) (satisfied bool, reason string, err error) {
+	var reasons []string
+	if p.dataLoad != nil {
+		dataLoad := &datav1alpha1.DataLoad{}
+		if err = c.Get(ctx, *p.dataLoad, dataLoad); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return false, "", err
+			}
+			reasons = append(reasons, fmt.Sprintf("dataload %s is not found", p.dataLoad))
+		} else if dataLoad.Status.Phase == common.PhaseComplete {
+			return true, "", nil
+		} else {
+			reasons = append(reasons, fmt.Sprintf("dataload %s is %s", p.dataLoad, phaseOrPending(dataLoad.Status.Phase)))
+		}
+	}
+
+	if p.dataset != nil {
+		dataset := &datav1alpha1.Dataset{}
+		if err = c.Get(ctx, *p.dataset, dataset); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return false, "", err
+			}
+			reasons = append(reasons, fmt.Sprintf("dataset %s is not found", p.dataset))
+		} else {
+			value := dataset.Status.CacheStates[common.CachedPercentage]
+			percentage, parseErr := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+			if parseErr == nil && percentage >= p.cachedPercentage {
+				return true, "", nil
+			}
+			reasons = append(reasons, fmt.Sprintf("cached percentage of dataset %s is %q, less than %.1f%%", p.dataset, value, p.cachedPercentage))
+		}
+	}
+	return false, strings.Join(reasons, ", "), nil
+}
+
+func phaseOrPending(phase common.Phase) common.Phase {
+	if phase == common.PhaseNone {
+		return common.PhasePending
+	}
+	return phase
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheadmission
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantNil     bool
+		wantErr     bool
+		check       func(t *testing.T, p *admissionPolicy)
+	}{
+		{
+			name:        "no policy",
+			annotations: map[string]string{"foo": "bar"},
+			wantNil:     true,
+		},
+		{
+			name:        "dataload in the same namespace",
+			annotations: map[string]string{common.AnnotationCacheAdmissionDataLoad: "hbase-load"},
+			check: func(t *testing.T, p *admissionPolicy) {
+				if *p.dataLoad != (types.NamespacedName{Namespace: "default", Name: "hbase-load"}) || p.dataset != nil {
+					t.Errorf("unexpected policy %+v", p)
+				}
+			},
+		},
+		{
+			name: "dataset with the namespace of the workload",
+			annotations: map[string]string{
+				common.AnnotationCacheAdmissionDataset:          "default/hbase",
+				common.AnnotationCacheAdmissionCachedPercentage: "80%",
+			},
+			check: func(t *testing.T, p *admissionPolicy) {
+				if *p.dataset != (types.NamespacedName{Namespace: "default", Name: "hbase"}) || p.cachedPercentage != 80 {
+					t.Errorf("unexpected policy %+v", p)
+				}
+			},
+		},
+		{
+			name: "dataset in another namespace",
+			annotations: map[string]string{
+				common.AnnotationCacheAdmissionDataset:          "fluid/hbase",
+				common.AnnotationCacheAdmissionCachedPercentage: "80%",
+			},
+			wantErr: true,
+		},
+		{
+			name:        "dataload in another namespace",
+			annotations: map[string]string{common.AnnotationCacheAdmissionDataLoad: "fluid/hbase-load"},
+			wantErr:     true,
+		},
+		{
+			name:        "dataset without threshold",
+			annotations: map[string]string{common.AnnotationCacheAdmissionDataset: "hbase"},
+			wantErr:     true,
+		},
+		{
+			name: "invalid threshold",
+			annotations: map[string]string{
+				common.AnnotationCacheAdmissionDataset:          "hbase",
+				common.AnnotationCacheAdmissionCachedPercentage: "120",
+			},
+			wantErr: true,
+		},
+		{
+			name: "on finish operations",
+			annotations: map[string]string{
+				common.AnnotationCacheAdmissionDataLoad:            "hbase-load",
+				common.AnnotationCacheAdmissionOnFinishDataMigrate: `{"from":{"dataset":{"name":"hbase","namespace":"default"}},"to":{"externalStorage":{"uri":"s3://bucket/path"}}}`,
+				common.AnnotationCacheAdmissionOnFinishDataProcess: `{"dataset":{"name":"hbase","namespace":"default","mountPath":"/data"}}`,
+			},
+			check: func(t *testing.T, p *admissionPolicy) {
+				if p.onFinishDataMigrate == nil || p.onFinishDataMigrate.From.DataSet.Name != "hbase" {
+					t.Errorf("unexpected datamigrate %+v", p.onFinishDataMigrate)
+				}
+				if p.onFinishDataProcess == nil || p.onFinishDataProcess.Dataset.MountPath != "/data" {
+					t.Errorf("unexpected dataprocess %+v", p.onFinishDataProcess)
+				}
+			},
+		},
+		{
+			name: "invalid on finish operation",
+			annotations: map[string]string{
+				common.AnnotationCacheAdmissionDataLoad:            "hbase-load",
+				common.AnnotationCacheAdmissionOnFinishDataMigrate: `{`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := parsePolicy(tt.annotations, "default")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (p == nil) != tt.wantNil {
+				t.Fatalf("parsePolicy() = %v, wantNil %v", p, tt.wantNil)
+			}
+			if tt.check != nil {
+				tt.check(t, p)
+			}
+		})
+	}
+}
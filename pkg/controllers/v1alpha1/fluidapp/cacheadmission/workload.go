@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheadmission
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// workloadKind describes how to hold and release a kind of workload.
+type workloadKind struct {
+	gvk schema.GroupVersionKind
+	// holdField is the boolean field to hold the workload, and heldValue is its value when the workload is held
+	holdField []string
+	heldValue bool
+	// finishedConditions are the condition types meaning the workload is finished
+	finishedConditions []string
+	// startedConditions are the condition types meaning the workload can't be held anymore
+	startedConditions []string
+	// startedField is set once the workload starts running
+	startedField []string
+	// createdHeld means the workload is held only if it's created held, because it may start as soon as it's
+	// created otherwise, and holding it then interrupts it
+	createdHeld bool
+	// podSetsField is the list of the pod sets, and podSpecField is the pod spec in each of them, or in the
+	// workload itself if podSetsField is empty
+	podSetsField []string
+	podSpecField []string
+}
+
+var (
+	// JobKind is the batch Job, which is held by spec.suspend
+	JobKind = workloadKind{
+		gvk:                schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+		holdField:          []string{"spec", "suspend"},
+		heldValue:          true,
+		finishedConditions: []string{"Complete", "Failed"},
+		startedField:       []string{"status", "startTime"},
+		createdHeld:        true,
+		podSpecField:       []string{"spec", "template", "spec"},
+	}
+
+	// JobSetKind is the JobSet, which is held by spec.suspend
+	JobSetKind = workloadKind{
+		gvk:                schema.GroupVersionKind{Group: "jobset.x-k8s.io", Version: "v1alpha2", Kind: "JobSet"},
+		holdField:          []string{"spec", "suspend"},
+		heldValue:          true,
+		finishedConditions: []string{"Completed", "Failed"},
+		startedField:       []string{"status", "replicatedJobsStatus"},
+		createdHeld:        true,
+		podSetsField:       []string{"spec", "replicatedJobs"},
+		podSpecField:       []string{"template", "spec", "template", "spec"},
+	}
+
+	// KueueWorkloadKind is the Workload of Kueue, which is held by spec.active. A Workload can't be held once it's
+	// admitted by Kueue.
+	KueueWorkloadKind = workloadKind{
+		gvk:                schema.GroupVersionKind{Group: "kueue.x-k8s.io", Version: "v1beta1", Kind: "Workload"},
+		holdField:          []string{"spec", "active"},
+		heldValue:          false,
+		finishedConditions: []string{"Finished"},
+		startedConditions:  []string{"QuotaReserved", "Admitted"},
+		podSetsField:       []string{"spec", "podSets"},
+		podSpecField:       []string{"template", "spec"},
+	}
+
+	supportedKinds = []workloadKind{JobKind, JobSetKind, KueueWorkloadKind}
+)
+
+// kueueQueueNameLabel marks the Jobs and JobSets managed by Kueue, which are held by their Workloads instead.
+const kueueQueueNameLabel = "kueue.x-k8s.io/queue-name"
+
+func (k workloadKind) isHeld(obj *unstructured.Unstructured) bool {
+	value, found, err := unstructured.NestedBool(obj.Object, k.holdField...)
+	if err != nil || !found {
+		// the workloads are not held by default
+		return false
+	}
+	return value == k.heldValue
+}
+
+func (k workloadKind) setHeld(obj *unstructured.Unstructured, held bool) error {
+	value := k.heldValue
+	if !held {
+		value = !k.heldValue
+	}
+	return unstructured.SetNestedField(obj.Object, value, k.holdField...)
+}
+
+func (k workloadKind) isFinished(obj *unstructured.Unstructured) bool {
+	return hasTrueCondition(obj, k.finishedConditions)
+}
+
+// isStarted checks if the workload has started, a started workload is not held to avoid interrupting it.
+func (k workloadKind) isStarted(obj *unstructured.Unstructured) bool {
+	if len(k.startedField) > 0 {
+		if value, found, _ := unstructured.NestedFieldNoCopy(obj.Object, k.startedField...); found && value != nil {
+			return true
+		}
+	}
+	return hasTrueCondition(obj, k.startedConditions)
+}
+
+// serviceAccounts returns the service accounts the pods of the workload run as.
+func (k workloadKind) serviceAccounts(obj *unstructured.Unstructured) []string {
+	podSets := []interface{}{obj.Object}
+	if len(k.podSetsField) > 0 {
+		podSets, _, _ = unstructured.NestedSlice(obj.Object, k.podSetsField...)
+	}
+	serviceAccounts := sets.New[string]()
+	for _, podSet := range podSets {
+		podSetMap, ok := podSet.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(podSetMap, append(k.podSpecField, "serviceAccountName")...)
+		if len(name) == 0 {
+			name = "default"
+		}
+		serviceAccounts.Insert(name)
+	}
+	return sets.List(serviceAccounts)
+}
+
+func hasTrueCondition(obj *unstructured.Unstructured, types []string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["status"] != "True" {
+			continue
+		}
+		for _, t := range types {
+			if condition["type"] == t {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaldataset
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+// startEnv starts an api server with Fluid's CRDs installed.
+func startEnv(t *testing.T) (*envtest.Environment, client.Client) {
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("failed to start test environment: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = env.Stop()
+	})
+	c, err := client.New(cfg, client.Options{Scheme: memberScheme})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return env, c
+}
+
+// TestGlobalDatasetWithEnvtest runs the controller against a hub and two member api servers.
+func TestGlobalDatasetWithEnvtest(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set, skip the test with envtest api servers")
+	}
+	ctx := context.TODO()
+
+	_, hub := startEnv(t)
+	if err := hub.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}}); err != nil {
+		t.Fatal(err)
+	}
+	clusters := []string{"beijing", "shanghai"}
+	members := map[string]client.Client{}
+	for _, cluster := range clusters {
+		env, member := startEnv(t)
+		user, err := env.AddUser(envtest.User{Name: "globaldataset", Groups: []string{"system:masters"}}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		kubeConfig, err := user.KubeConfig()
+		if err != nil {
+			t.Fatal(err)
+		}
+		secret := newKubeConfigSecret(cluster)
+		secret.ResourceVersion = ""
+		secret.Data[defaultKubeConfigKey] = kubeConfig
+		if err = hub.Create(ctx, secret); err != nil {
+			t.Fatal(err)
+		}
+		if err = member.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fluid"}}); err != nil {
+			t.Fatal(err)
+		}
+		members[cluster] = member
+	}
+
+	globalDataset := newGlobalDataset(clusters...)
+	globalDataset.Finalizers = nil
+	if err := hub.Create(ctx, globalDataset); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewGlobalDatasetReconciler(hub, hub, fake.NullLogger(), record.NewFakeRecorder(100), time.Second, testNamespace)
+	reconcile := func() {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: globalDataset.Name}}); err != nil {
+			t.Fatalf("failed to reconcile: %v", err)
+		}
+	}
+	// The first round adds the finalizer
+	reconcile()
+	reconcile()
+
+	for cluster, member := range members {
+		dataset, err := getMemberDataset(member)
+		if err != nil {
+			t.Fatalf("expect dataset to be created in cluster %s, got %v", cluster, err)
+		}
+		dataset.Status.Phase = datav1alpha1.BoundDatasetPhase
+		dataset.Status.Conditions = []datav1alpha1.DatasetCondition{}
+		if err = member.Status().Update(ctx, dataset); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = getMemberRuntime(member); err != nil {
+			t.Fatalf("expect runtime to be created in cluster %s, got %v", cluster, err)
+		}
+	}
+	reconcile()
+
+	if err := hub.Get(ctx, client.ObjectKeyFromObject(globalDataset), globalDataset); err != nil {
+		t.Fatal(err)
+	}
+	if globalDataset.Status.Phase != datav1alpha1.BoundDatasetPhase || globalDataset.Status.ReadyClusters != "2/2" {
+		t.Errorf("unexpected status %+v", globalDataset.Status)
+	}
+
+	if err := hub.Delete(ctx, globalDataset); err != nil {
+		t.Fatal(err)
+	}
+	reconcile()
+	for cluster, member := range members {
+		if _, err := getMemberDataset(member); !apierrs.IsNotFound(err) {
+			t.Errorf("expect dataset in cluster %s to be deleted, got %v", cluster, err)
+		}
+	}
+	if err := hub.Get(ctx, client.ObjectKeyFromObject(globalDataset), globalDataset); !apierrs.IsNotFound(err) {
+		t.Errorf("expect globaldataset to be deleted, got %v", err)
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaldataset
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	finalizer      = "fluid-globaldataset-controller-finalizer"
+	controllerName = "GlobalDatasetController"
+)
+
+// GlobalDatasetReconciler reconciles a GlobalDataset object in the hub cluster. It creates the Dataset and the runtime
+// in every member cluster and aggregates their status. The member clusters are not watched, so the status is
+// refreshed every ResyncPeriod.
+type GlobalDatasetReconciler struct {
+	client.Client
+	Recorder      record.EventRecorder
+	Log           logr.Logger
+	ResyncPeriod  time.Duration
+	MemberClients *MemberClients
+}
+
+type reconcileRequestContext struct {
+	context.Context
+	Log           logr.Logger
+	GlobalDataset datav1alpha1.GlobalDataset
+}
+
+func NewGlobalDatasetReconciler(client client.Client,
+	apiReader client.Reader,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration,
+	kubeConfigNamespace string) *GlobalDatasetReconciler {
+	return &GlobalDatasetReconciler{
+		Client:        client,
+		Recorder:      recorder,
+		Log:           log,
+		ResyncPeriod:  resyncPeriod,
+		MemberClients: NewMemberClients(apiReader, kubeConfigNamespace, NewMemberClient),
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=globaldatasets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=data.fluid.io,resources=globaldatasets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",namespace=fluid-system,resources=secrets,verbs=get
+
+func (r *GlobalDatasetReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := reconcileRequestContext{
+		Context: context,
+		Log:     r.Log.WithValues("globaldataset", req.Name),
+	}
+	ctx.Log.V(1).Info("process the request", "request", req)
+
+	if err := r.Get(ctx, req.NamespacedName, &ctx.GlobalDataset); err != nil {
+		if utils.IgnoreNotFound(err) != nil {
+			ctx.Log.Error(err, "failed to get globaldataset")
+			return utils.RequeueIfError(err)
+		}
+		ctx.Log.V(1).Info("Not found.")
+		return utils.NoRequeue()
+	}
+
+	if utils.HasDeletionTimestamp(ctx.GlobalDataset.ObjectMeta) {
+		return r.reconcileGlobalDatasetDeletion(ctx)
+	}
+
+	if !utils.ContainsString(ctx.GlobalDataset.GetFinalizers(), finalizer) {
+		return r.addFinalizerAndRequeue(ctx)
+	}
+
+	return r.reconcileGlobalDataset(ctx)
+}
+
+// reconcileGlobalDataset syncs the Datasets in member clusters and updates the aggregated status
+func (r *GlobalDatasetReconciler) reconcileGlobalDataset(ctx reconcileRequestContext) (ctrl.Result, error) {
+	globalDataset := &ctx.GlobalDataset
+	previous := map[string]datav1alpha1.MemberDatasetStatus{}
+	for _, member := range globalDataset.Status.Clusters {
+		previous[member.Name] = member
+	}
+
+	// 1. Sync the Dataset and the runtime to every member cluster
+	var members []datav1alpha1.MemberDatasetStatus
+	inSpec := map[string]bool{}
+	for _, cluster := range globalDataset.Spec.Clusters {
+		inSpec[cluster.Name] = true
+		member := datav1alpha1.MemberDatasetStatus{}
+		memberClient, err := r.MemberClients.Get(ctx, cluster)
+		if err == nil {
+			member, err = syncMember(ctx, memberClient, globalDataset)
+		}
+		if err != nil {
+			ctx.Log.Error(err, "failed to sync member cluster", "cluster", cluster.Name)
+			r.Recorder.Eventf(globalDataset, v1.EventTypeWarning, common.ErrorSyncMemberCluster, "Failed to sync member cluster %s: %v", cluster.Name, err)
+			member.Message = err.Error()
+		}
+		member.Name = cluster.Name
+		member.KubeConfigSecret = cluster.KubeConfigSecret
+		members = append(members, keepLastSyncTime(member, previous[cluster.Name]))
+	}
+
+	// 2. Clean up the member clusters removed from the spec
+	for _, member := range globalDataset.Status.Clusters {
+		if inSpec[member.Name] {
+			continue
+		}
+		if err := r.deleteMember(ctx, datav1alpha1.MemberCluster{Name: member.Name, KubeConfigSecret: member.KubeConfigSecret}); err != nil {
+			ctx.Log.Error(err, "failed to clean up member cluster", "cluster", member.Name)
+			member.Message = err.Error()
+			members = append(members, member)
+		}
+	}
+
+	// 3. Update the aggregated status
+	status := aggregateStatus(members)
+	status.ObservedGeneration = globalDataset.Generation
+	if !reflect.DeepEqual(status, globalDataset.Status) {
+		globalDatasetToUpdate := globalDataset.DeepCopy()
+		globalDatasetToUpdate.Status = status
+		if err := r.Status().Update(ctx, globalDatasetToUpdate); err != nil {
+			ctx.Log.Error(err, "Failed to update the globaldataset status")
+			return utils.RequeueIfError(err)
+		}
+		ctx.Log.V(1).Info("Update the status of the globaldataset successfully", "phase", status.Phase, "readyClusters", status.ReadyClusters)
+	}
+
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// keepLastSyncTime keeps the last sync time if the status of the member cluster doesn't change,
+// so that the GlobalDataset is not updated on every resync.
+func keepLastSyncTime(current, previous datav1alpha1.MemberDatasetStatus) datav1alpha1.MemberDatasetStatus {
+	current.LastSyncTime = previous.LastSyncTime
+	if !reflect.DeepEqual(current, previous) {
+		current.LastSyncTime = metav1.NewTime(time.Now())
+	}
+	return current
+}
+
+// reconcileGlobalDatasetDeletion deletes the Datasets in member clusters and removes the finalizer
+func (r *GlobalDatasetReconciler) reconcileGlobalDatasetDeletion(ctx reconcileRequestContext) (ctrl.Result, error) {
+	log := ctx.Log.WithName("reconcileGlobalDatasetDeletion")
+	globalDataset := &ctx.GlobalDataset
+
+	clusters := append([]datav1alpha1.MemberCluster{}, globalDataset.Spec.Clusters...)
+	for _, member := range globalDataset.Status.Clusters {
+		clusters = append(clusters, datav1alpha1.MemberCluster{Name: member.Name, KubeConfigSecret: member.KubeConfigSecret})
+	}
+	deleted := map[string]bool{}
+	for _, cluster := range clusters {
+		if deleted[cluster.Name] {
+			continue
+		}
+		if err := r.deleteMember(ctx, cluster); err != nil {
+			log.Error(err, "failed to delete the dataset in member cluster", "cluster", cluster.Name)
+			r.Recorder.Eventf(globalDataset, v1.EventTypeWarning, common.ErrorDeleteDataset, "Failed to delete the dataset in member cluster %s: %v", cluster.Name, err)
+			return utils.RequeueAfterInterval(10 * time.Second)
+		}
+		deleted[cluster.Name] = true
+	}
+
+	if utils.ContainsString(globalDataset.GetFinalizers(), finalizer) {
+		globalDataset.Finalizers = utils.RemoveString(globalDataset.Finalizers, finalizer)
+		if err := r.Update(ctx, globalDataset); err != nil {
+			log.Error(err, "Failed to remove finalizer")
+			return utils.RequeueIfError(err)
+		}
+		log.Info("Finalizer is removed", "globaldataset", globalDataset.Name)
+	}
+	return utils.NoRequeue()
+}
+
+// deleteMember deletes the Dataset in the member cluster. The member cluster is skipped if its kubeconfig Secret
+// is deleted, since it's no longer accessible.
+func (r *GlobalDatasetReconciler) deleteMember(ctx reconcileRequestContext, cluster datav1alpha1.MemberCluster) error {
+	memberClient, err := r.MemberClients.Get(ctx, cluster)
+	if apierrs.IsNotFound(err) {
+		ctx.Log.Info("Skip cleaning up the member cluster whose kubeconfig secret is not found", "cluster", cluster.Name)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return deleteMember(ctx, memberClient, &ctx.GlobalDataset)
+}
+
+func (r *GlobalDatasetReconciler) addFinalizerAndRequeue(ctx reconcileRequestContext) (ctrl.Result, error) {
+	ctx.GlobalDataset.Finalizers = append(ctx.GlobalDataset.Finalizers, finalizer)
+	ctx.Log.Info("Add finalizer and Requeue")
+	prevGeneration := ctx.GlobalDataset.GetGeneration()
+	if err := r.Update(ctx, &ctx.GlobalDataset); err != nil {
+		ctx.Log.Error(err, "Failed to add finalizer")
+		return utils.RequeueIfError(err)
+	}
+
+	return utils.RequeueImmediatelyUnlessGenerationChanged(prevGeneration, ctx.GlobalDataset.GetGeneration())
+}
+
+func (r *GlobalDatasetReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.GlobalDataset{}).
+		Complete(r)
+}
+
+func (r *GlobalDatasetReconciler) ControllerName() string {
+	return controllerName
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaldataset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+const testNamespace = "fluid-system"
+
+func newTestScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	return s
+}
+
+func newKubeConfigSecret(cluster string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: cluster + "-kubeconfig", Namespace: testNamespace, ResourceVersion: "1"},
+		Data:       map[string][]byte{defaultKubeConfigKey: []byte(cluster)},
+	}
+}
+
+func newMemberCluster(cluster string) datav1alpha1.MemberCluster {
+	return datav1alpha1.MemberCluster{
+		Name:             cluster,
+		KubeConfigSecret: datav1alpha1.KubeConfigSecretReference{Name: cluster + "-kubeconfig", Namespace: testNamespace},
+	}
+}
+
+func newGlobalDataset(clusters ...string) *datav1alpha1.GlobalDataset {
+	globalDataset := &datav1alpha1.GlobalDataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Finalizers: []string{finalizer}},
+		Spec: datav1alpha1.GlobalDatasetSpec{
+			Template: datav1alpha1.GlobalDatasetTemplate{
+				Namespace: "fluid",
+				Labels:    map[string]string{"team": "ai"},
+				Dataset: datav1alpha1.DatasetSpec{
+					Mounts: []datav1alpha1.Mount{{MountPoint: "https://mirrors.bit.edu.cn/apache/hbase/stable/", Name: "hbase"}},
+				},
+				Runtime: &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"data.fluid.io/v1alpha1","kind":"AlluxioRuntime","metadata":{"name":"ignored"},"spec":{"replicas":2}}`),
+				},
+			},
+		},
+	}
+	for _, cluster := range clusters {
+		globalDataset.Spec.Clusters = append(globalDataset.Spec.Clusters, newMemberCluster(cluster))
+	}
+	return globalDataset
+}
+
+type testEnv struct {
+	hub     client.Client
+	members map[string]client.Client
+	r       *GlobalDatasetReconciler
+}
+
+func newTestEnv(globalDataset *datav1alpha1.GlobalDataset, clusters ...string) *testEnv {
+	objs := []runtime.Object{globalDataset}
+	members := map[string]client.Client{}
+	for _, cluster := range clusters {
+		objs = append(objs, newKubeConfigSecret(cluster))
+		members[cluster] = crfake.NewClientBuilder().WithScheme(newTestScheme()).WithStatusSubresource(&datav1alpha1.Dataset{}).Build()
+	}
+	hub := fake.NewFakeClientWithScheme(newTestScheme(), objs...)
+	newClient := func(kubeConfig []byte) (client.Client, error) {
+		c, found := members[string(kubeConfig)]
+		if !found {
+			return nil, fmt.Errorf("cluster %s is unreachable", kubeConfig)
+		}
+		return c, nil
+	}
+	return &testEnv{
+		hub:     hub,
+		members: members,
+		r: &GlobalDatasetReconciler{
+			Client:        hub,
+			Recorder:      record.NewFakeRecorder(100),
+			Log:           fake.NullLogger(),
+			ResyncPeriod:  30 * time.Second,
+			MemberClients: NewMemberClients(hub, testNamespace, newClient),
+		},
+	}
+}
+
+func (e *testEnv) reconcile(t *testing.T) {
+	_, err := e.r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "hbase"}})
+	if err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+}
+
+func (e *testEnv) getGlobalDataset(t *testing.T) *datav1alpha1.GlobalDataset {
+	globalDataset := &datav1alpha1.GlobalDataset{}
+	if err := e.hub.Get(context.TODO(), types.NamespacedName{Name: "hbase"}, globalDataset); err != nil {
+		t.Fatalf("failed to get globaldataset: %v", err)
+	}
+	return globalDataset
+}
+
+func getMemberDataset(c client.Client) (*datav1alpha1.Dataset, error) {
+	dataset := &datav1alpha1.Dataset{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase"}, dataset)
+	return dataset, err
+}
+
+func getMemberRuntime(c client.Client) (*unstructured.Unstructured, error) {
+	runtime := &unstructured.Unstructured{}
+	runtime.SetAPIVersion("data.fluid.io/v1alpha1")
+	runtime.SetKind("AlluxioRuntime")
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase"}, runtime)
+	return runtime, err
+}
+
+func TestReconcile_SyncMembers(t *testing.T) {
+	e := newTestEnv(newGlobalDataset("beijing", "shanghai"), "beijing", "shanghai")
+	e.reconcile(t)
+
+	for cluster, c := range e.members {
+		dataset, err := getMemberDataset(c)
+		if err != nil {
+			t.Fatalf("expect dataset to be created in cluster %s, got %v", cluster, err)
+		}
+		if dataset.Labels[common.LabelGlobalDataset] != "hbase" || dataset.Labels["team"] != "ai" {
+			t.Errorf("unexpected labels %v of dataset in cluster %s", dataset.Labels, cluster)
+		}
+		if len(dataset.Spec.Mounts) != 1 {
+			t.Errorf("unexpected spec %v of dataset in cluster %s", dataset.Spec, cluster)
+		}
+		runtime, err := getMemberRuntime(c)
+		if err != nil {
+			t.Fatalf("expect runtime to be created in cluster %s, got %v", cluster, err)
+		}
+		if replicas, _, _ := unstructured.NestedInt64(runtime.Object, "spec", "replicas"); replicas != 2 {
+			t.Errorf("expect replicas of runtime in cluster %s to be 2, got %d", cluster, replicas)
+		}
+	}
+
+	status := e.getGlobalDataset(t).Status
+	if status.Phase != datav1alpha1.NotBoundDatasetPhase || status.ReadyClusters != "0/2" || len(status.Clusters) != 2 {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	// The datasets are bound in member clusters
+	for _, c := range e.members {
+		dataset, _ := getMemberDataset(c)
+		dataset.Status.Phase = datav1alpha1.BoundDatasetPhase
+		dataset.Status.UfsTotal = "2.00GiB"
+		dataset.Status.CacheStates = common.CacheStateList{common.Cached: "1.00GiB", common.CacheCapacity: "4.00GiB"}
+		if err := c.Status().Update(context.TODO(), dataset); err != nil {
+			t.Fatal(err)
+		}
+	}
+	e.reconcile(t)
+
+	status = e.getGlobalDataset(t).Status
+	if status.Phase != datav1alpha1.BoundDatasetPhase || status.ReadyClusters != "2/2" || status.UfsTotal != "2.00GiB" {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if status.CacheStates[common.Cached] != "2.00GiB" || status.CacheStates[common.CacheCapacity] != "8.00GiB" || status.CacheStates[common.CachedPercentage] != "50.0%" {
+		t.Errorf("unexpected cache states %v", status.CacheStates)
+	}
+
+	// Updating the template updates the member datasets
+	globalDataset := e.getGlobalDataset(t)
+	globalDataset.Spec.Template.Dataset.Mounts = append(globalDataset.Spec.Template.Dataset.Mounts, datav1alpha1.Mount{MountPoint: "local:///mnt/data", Name: "data"})
+	if err := e.hub.Update(context.TODO(), globalDataset); err != nil {
+		t.Fatal(err)
+	}
+	e.reconcile(t)
+	for cluster, c := range e.members {
+		dataset, _ := getMemberDataset(c)
+		if len(dataset.Spec.Mounts) != 2 || dataset.Status.Phase != datav1alpha1.BoundDatasetPhase {
+			t.Errorf("expect dataset in cluster %s to be updated, got %+v", cluster, dataset)
+		}
+	}
+}
+
+func TestReconcile_UnreachableMember(t *testing.T) {
+	e := newTestEnv(newGlobalDataset("beijing", "shanghai"), "beijing")
+	e.reconcile(t)
+
+	status := e.getGlobalDataset(t).Status
+	if status.Phase != datav1alpha1.FailedDatasetPhase || len(status.Clusters) != 2 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	if status.Clusters[0].Message != "" || status.Clusters[1].Message == "" {
+		t.Errorf("expect only the unreachable cluster to have a message, got %+v", status.Clusters)
+	}
+}
+
+func TestReconcile_SecretOutOfNamespace(t *testing.T) {
+	globalDataset := newGlobalDataset("beijing")
+	globalDataset.Spec.Clusters[0].KubeConfigSecret.Namespace = "default"
+	e := newTestEnv(globalDataset, "beijing")
+	secret := newKubeConfigSecret("beijing")
+	secret.Namespace = "default"
+	secret.ResourceVersion = ""
+	if err := e.hub.Create(context.TODO(), secret); err != nil {
+		t.Fatal(err)
+	}
+	e.reconcile(t)
+
+	status := e.getGlobalDataset(t).Status
+	if status.Phase != datav1alpha1.FailedDatasetPhase || !strings.Contains(status.Clusters[0].Message, "is not in namespace "+testNamespace) {
+		t.Errorf("expect the secret out of namespace %s to be rejected, got %+v", testNamespace, status)
+	}
+	if _, err := getMemberDataset(e.members["beijing"]); err == nil {
+		t.Errorf("expect no dataset synced to cluster beijing")
+	}
+}
+
+func TestReconcile_DatasetNotManaged(t *testing.T) {
+	e := newTestEnv(newGlobalDataset("beijing"), "beijing")
+	existing := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"}}
+	if err := e.members["beijing"].Create(context.TODO(), existing); err != nil {
+		t.Fatal(err)
+	}
+	e.reconcile(t)
+
+	status := e.getGlobalDataset(t).Status
+	if status.Phase != datav1alpha1.FailedDatasetPhase || status.Clusters[0].Message == "" {
+		t.Errorf("expect the existing dataset not to be overridden, got %+v", status)
+	}
+	dataset, _ := getMemberDataset(e.members["beijing"])
+	if len(dataset.Spec.Mounts) != 0 {
+		t.Errorf("expect the existing dataset untouched, got %+v", dataset)
+	}
+}
+
+func TestReconcile_RemoveMember(t *testing.T) {
+	e := newTestEnv(newGlobalDataset("beijing", "shanghai"), "beijing", "shanghai")
+	e.reconcile(t)
+
+	globalDataset := e.getGlobalDataset(t)
+	globalDataset.Spec.Clusters = globalDataset.Spec.Clusters[:1]
+	if err := e.hub.Update(context.TODO(), globalDataset); err != nil {
+		t.Fatal(err)
+	}
+	e.reconcile(t)
+
+	if _, err := getMemberDataset(e.members["shanghai"]); !apierrs.IsNotFound(err) {
+		t.Errorf("expect dataset in the removed cluster to be deleted, got %v", err)
+	}
+	if _, err := getMemberRuntime(e.members["shanghai"]); !apierrs.IsNotFound(err) {
+		t.Errorf("expect runtime in the removed cluster to be deleted, got %v", err)
+	}
+	if _, err := getMemberDataset(e.members["beijing"]); err != nil {
+		t.Errorf("expect dataset in the remaining cluster to exist, got %v", err)
+	}
+	if status := e.getGlobalDataset(t).Status; len(status.Clusters) != 1 || status.ReadyClusters != "0/1" {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestReconcile_Deletion(t *testing.T) {
+	e := newTestEnv(newGlobalDataset("beijing", "shanghai"), "beijing", "shanghai")
+	e.reconcile(t)
+
+	if err := e.hub.Delete(context.TODO(), e.getGlobalDataset(t)); err != nil {
+		t.Fatal(err)
+	}
+	e.reconcile(t)
+
+	for cluster, c := range e.members {
+		if _, err := getMemberDataset(c); !apierrs.IsNotFound(err) {
+			t.Errorf("expect dataset in cluster %s to be deleted, got %v", cluster, err)
+		}
+		if _, err := getMemberRuntime(c); !apierrs.IsNotFound(err) {
+			t.Errorf("expect runtime in cluster %s to be deleted, got %v", cluster, err)
+		}
+	}
+	err := e.hub.Get(context.TODO(), types.NamespacedName{Name: "hbase"}, &datav1alpha1.GlobalDataset{})
+	if !apierrs.IsNotFound(err) {
+		t.Errorf("expect globaldataset to be deleted after removing the finalizer, got %v", err)
+	}
+}
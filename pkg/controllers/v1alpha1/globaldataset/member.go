@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaldataset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+const defaultMemberNamespace = "default"
+
+// memberNamespace returns the namespace of the Dataset and the runtime in member clusters.
+func memberNamespace(globalDataset *datav1alpha1.GlobalDataset) string {
+	if globalDataset.Spec.Template.Namespace != "" {
+		return globalDataset.Spec.Template.Namespace
+	}
+	return defaultMemberNamespace
+}
+
+func isManagedBy(obj metav1.Object, globalDataset *datav1alpha1.GlobalDataset) bool {
+	return obj.GetLabels()[common.LabelGlobalDataset] == globalDataset.Name
+}
+
+// buildMemberDataset builds the Dataset in member clusters from the template.
+func buildMemberDataset(globalDataset *datav1alpha1.GlobalDataset) *datav1alpha1.Dataset {
+	template := globalDataset.Spec.Template
+	labels := map[string]string{}
+	for k, v := range template.Labels {
+		labels[k] = v
+	}
+	labels[common.LabelGlobalDataset] = globalDataset.Name
+
+	return &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        globalDataset.Name,
+			Namespace:   memberNamespace(globalDataset),
+			Labels:      labels,
+			Annotations: template.Annotations,
+		},
+		Spec: *template.Dataset.DeepCopy(),
+	}
+}
+
+// buildMemberRuntime builds the runtime in member clusters from the template, returns nil if the runtime is not set.
+func buildMemberRuntime(globalDataset *datav1alpha1.GlobalDataset) (*unstructured.Unstructured, error) {
+	raw := globalDataset.Spec.Template.Runtime
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, nil
+	}
+	runtime := &unstructured.Unstructured{}
+	if err := json.Unmarshal(raw.Raw, &runtime.Object); err != nil {
+		return nil, fmt.Errorf("failed to parse the runtime template: %v", err)
+	}
+	if runtime.GetAPIVersion() == "" || runtime.GetKind() == "" {
+		return nil, fmt.Errorf("apiVersion or kind of the runtime template is not set")
+	}
+
+	// The runtime is bound to the Dataset with the same name and namespace
+	runtime.SetName(globalDataset.Name)
+	runtime.SetNamespace(memberNamespace(globalDataset))
+	labels := runtime.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[common.LabelGlobalDataset] = globalDataset.Name
+	runtime.SetLabels(labels)
+	return runtime, nil
+}
+
+// syncMember creates or updates the Dataset and the runtime in the member cluster,
+// and returns the status of the Dataset.
+func syncMember(ctx context.Context, c client.Client, globalDataset *datav1alpha1.GlobalDataset) (status datav1alpha1.MemberDatasetStatus, err error) {
+	runtime, err := buildMemberRuntime(globalDataset)
+	if err != nil {
+		return
+	}
+	if runtime != nil {
+		if err = syncMemberRuntime(ctx, c, globalDataset, runtime); err != nil {
+			return
+		}
+	}
+
+	dataset, err := syncMemberDataset(ctx, c, globalDataset)
+	if err != nil {
+		return
+	}
+	status.Phase = dataset.Status.Phase
+	status.UfsTotal = dataset.Status.UfsTotal
+	status.CacheStates = dataset.Status.CacheStates
+	return
+}
+
+func syncMemberDataset(ctx context.Context, c client.Client, globalDataset *datav1alpha1.GlobalDataset) (*datav1alpha1.Dataset, error) {
+	desired := buildMemberDataset(globalDataset)
+	dataset := &datav1alpha1.Dataset{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(desired), dataset)
+	if apierrs.IsNotFound(err) {
+		if err = c.Create(ctx, desired); err != nil {
+			return nil, fmt.Errorf("failed to create dataset %s/%s: %v", desired.Namespace, desired.Name, err)
+		}
+		return desired, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !isManagedBy(dataset, globalDataset) {
+		return nil, fmt.Errorf("dataset %s/%s already exists and is not managed by the GlobalDataset", dataset.Namespace, dataset.Name)
+	}
+	datasetToUpdate := dataset.DeepCopy()
+	datasetToUpdate.Spec = desired.Spec
+	datasetToUpdate.Labels = mergeMap(datasetToUpdate.Labels, desired.Labels)
+	datasetToUpdate.Annotations = mergeMap(datasetToUpdate.Annotations, desired.Annotations)
+	if reflect.DeepEqual(dataset, datasetToUpdate) {
+		return dataset, nil
+	}
+	if err = c.Update(ctx, datasetToUpdate); err != nil {
+		return nil, fmt.Errorf("failed to update dataset %s/%s: %v", dataset.Namespace, dataset.Name, err)
+	}
+	return datasetToUpdate, nil
+}
+
+func syncMemberRuntime(ctx context.Context, c client.Client, globalDataset *datav1alpha1.GlobalDataset, desired *unstructured.Unstructured) error {
+	runtime := &unstructured.Unstructured{}
+	runtime.SetGroupVersionKind(desired.GroupVersionKind())
+	err := c.Get(ctx, client.ObjectKeyFromObject(desired), runtime)
+	if apierrs.IsNotFound(err) {
+		if err = c.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create %s %s/%s: %v", desired.GetKind(), desired.GetNamespace(), desired.GetName(), err)
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !isManagedBy(runtime, globalDataset) {
+		return fmt.Errorf("%s %s/%s already exists and is not managed by the GlobalDataset", runtime.GetKind(), runtime.GetNamespace(), runtime.GetName())
+	}
+	runtimeToUpdate := runtime.DeepCopy()
+	runtimeToUpdate.Object["spec"] = desired.Object["spec"]
+	runtimeToUpdate.SetLabels(mergeMap(runtimeToUpdate.GetLabels(), desired.GetLabels()))
+	runtimeToUpdate.SetAnnotations(mergeMap(runtimeToUpdate.GetAnnotations(), desired.GetAnnotations()))
+	if reflect.DeepEqual(runtime, runtimeToUpdate) {
+		return nil
+	}
+	if err = c.Update(ctx, runtimeToUpdate); err != nil {
+		return fmt.Errorf("failed to update %s %s/%s: %v", runtime.GetKind(), runtime.GetNamespace(), runtime.GetName(), err)
+	}
+	return nil
+}
+
+// deleteMember deletes the runtime and the Dataset managed by the GlobalDataset in the member cluster.
+func deleteMember(ctx context.Context, c client.Client, globalDataset *datav1alpha1.GlobalDataset) error {
+	runtime, err := buildMemberRuntime(globalDataset)
+	if err != nil {
+		return err
+	}
+	if runtime != nil {
+		if err = deleteIfManaged(ctx, c, globalDataset, runtime); err != nil {
+			return err
+		}
+	}
+	return deleteIfManaged(ctx, c, globalDataset, buildMemberDataset(globalDataset))
+}
+
+func deleteIfManaged(ctx context.Context, c client.Client, globalDataset *datav1alpha1.GlobalDataset, obj client.Object) error {
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !isManagedBy(obj, globalDataset) {
+		return nil
+	}
+	if err := c.Delete(ctx, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return nil
+}
+
+// mergeMap returns a copy of dst overridden by src, nil is returned if both are empty.
+func mergeMap(dst, src map[string]string) map[string]string {
+	if len(dst) == 0 && len(src) == 0 {
+		return dst
+	}
+	ret := make(map[string]string, len(dst)+len(src))
+	for k, v := range dst {
+		ret[k] = v
+	}
+	for k, v := range src {
+		ret[k] = v
+	}
+	return ret
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaldataset
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const defaultKubeConfigKey = "kubeconfig"
+
+// NewClientFunc builds the client of a member cluster from its kubeconfig.
+type NewClientFunc func(kubeConfig []byte) (client.Client, error)
+
+// memberScheme is the scheme of the clients of member clusters.
+var memberScheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(memberScheme)
+	_ = datav1alpha1.AddToScheme(memberScheme)
+}
+
+// NewMemberClient builds the client of a member cluster from its kubeconfig.
+func NewMemberClient(kubeConfig []byte) (client.Client, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Scheme: memberScheme})
+}
+
+type cachedClient struct {
+	secretVersion string
+	client        client.Client
+}
+
+// MemberClients caches the clients of member clusters. A client is rebuilt when the kubeconfig Secret changes.
+type MemberClients struct {
+	// hubReader reads the kubeconfig Secrets from the api server directly, so that Secrets are not cached
+	hubReader client.Reader
+	// secretNamespace is the only namespace allowed to store the kubeconfig Secrets
+	secretNamespace string
+	newClient       NewClientFunc
+
+	mutex   sync.Mutex
+	clients map[string]cachedClient
+}
+
+func NewMemberClients(hubReader client.Reader, secretNamespace string, newClient NewClientFunc) *MemberClients {
+	return &MemberClients{
+		hubReader:       hubReader,
+		secretNamespace: secretNamespace,
+		newClient:       newClient,
+		clients:         map[string]cachedClient{},
+	}
+}
+
+// Get returns the client of the member cluster with the kubeconfig Secret in the hub cluster.
+func (m *MemberClients) Get(ctx context.Context, cluster datav1alpha1.MemberCluster) (client.Client, error) {
+	ref := cluster.KubeConfigSecret
+	if ref.Namespace != m.secretNamespace {
+		return nil, fmt.Errorf("kubeconfig secret %s/%s of cluster %s is not in namespace %s", ref.Namespace, ref.Name, cluster.Name, m.secretNamespace)
+	}
+	secret := &corev1.Secret{}
+	if err := m.hubReader.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig secret %s/%s of cluster %s: %w", ref.Namespace, ref.Name, cluster.Name, err)
+	}
+
+	cacheKey := fmt.Sprintf("%s/%s/%s", ref.Namespace, ref.Name, ref.Key)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if cached, found := m.clients[cacheKey]; found && cached.secretVersion == secret.ResourceVersion {
+		return cached.client, nil
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = defaultKubeConfigKey
+	}
+	kubeConfig, found := secret.Data[key]
+	if !found {
+		return nil, fmt.Errorf("key %s is not found in kubeconfig secret %s/%s of cluster %s", key, ref.Namespace, ref.Name, cluster.Name)
+	}
+	c, err := m.newClient(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client of cluster %s: %v", cluster.Name, err)
+	}
+	m.clients[cacheKey] = cachedClient{secretVersion: secret.ResourceVersion, client: c}
+	return c, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaldataset
+
+import (
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// aggregateStatus aggregates the status of the Datasets in member clusters into the status of the GlobalDataset.
+func aggregateStatus(members []datav1alpha1.MemberDatasetStatus) (status datav1alpha1.GlobalDatasetStatus) {
+	status.Clusters = members
+
+	var bound, pending, failed int
+	var ufsTotal, cached, cacheCapacity int64
+	var reported int
+	for _, member := range members {
+		switch {
+		case member.Message != "" || member.Phase == datav1alpha1.FailedDatasetPhase:
+			failed++
+		case member.Phase == datav1alpha1.BoundDatasetPhase:
+			bound++
+		case member.Phase == datav1alpha1.PendingDatasetPhase:
+			pending++
+		}
+
+		// The member clusters share the same underlying data, take the largest one in case some of them are still calculating
+		if size, err := utils.FromHumanSize(member.UfsTotal); err == nil && size > ufsTotal {
+			ufsTotal = size
+			status.UfsTotal = member.UfsTotal
+		}
+		if size, err := utils.FromHumanSize(member.CacheStates[common.Cached]); err == nil {
+			cached += size
+			reported++
+		}
+		if size, err := utils.FromHumanSize(member.CacheStates[common.CacheCapacity]); err == nil {
+			cacheCapacity += size
+		}
+	}
+
+	switch {
+	case len(members) == 0:
+		status.Phase = datav1alpha1.NotBoundDatasetPhase
+	case failed > 0:
+		status.Phase = datav1alpha1.FailedDatasetPhase
+	case bound == len(members):
+		status.Phase = datav1alpha1.BoundDatasetPhase
+	case pending > 0:
+		status.Phase = datav1alpha1.PendingDatasetPhase
+	default:
+		status.Phase = datav1alpha1.NotBoundDatasetPhase
+	}
+	status.ReadyClusters = fmt.Sprintf("%d/%d", bound, len(members))
+
+	if reported > 0 {
+		status.CacheStates = common.CacheStateList{
+			common.Cached:        utils.BytesSize(float64(cached)),
+			common.CacheCapacity: utils.BytesSize(float64(cacheCapacity)),
+		}
+		// Every member cluster caches its own copy of the data
+		if ufsTotal > 0 {
+			status.CacheStates[common.CachedPercentage] = fmt.Sprintf("%.1f%%", float64(cached)*100.0/float64(ufsTotal*int64(reported)))
+		}
+	}
+	return
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaldataset
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestAggregateStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		members       []datav1alpha1.MemberDatasetStatus
+		wantPhase     datav1alpha1.DatasetPhase
+		wantReady     string
+		wantUfsTotal  string
+		wantCacheStat common.CacheStateList
+	}{
+		{
+			name:      "no member",
+			wantPhase: datav1alpha1.NotBoundDatasetPhase,
+			wantReady: "0/0",
+		},
+		{
+			name: "all bound",
+			members: []datav1alpha1.MemberDatasetStatus{
+				{Name: "a", Phase: datav1alpha1.BoundDatasetPhase, UfsTotal: "1.00GiB", CacheStates: common.CacheStateList{common.Cached: "512.00MiB", common.CacheCapacity: "2.00GiB"}},
+				{Name: "b", Phase: datav1alpha1.BoundDatasetPhase, UfsTotal: "[Calculating]", CacheStates: common.CacheStateList{common.Cached: "0.00B", common.CacheCapacity: "2.00GiB"}},
+			},
+			wantPhase:     datav1alpha1.BoundDatasetPhase,
+			wantReady:     "2/2",
+			wantUfsTotal:  "1.00GiB",
+			wantCacheStat: common.CacheStateList{common.Cached: "512.00MiB", common.CacheCapacity: "4.00GiB", common.CachedPercentage: "25.0%"},
+		},
+		{
+			name: "partially pending",
+			members: []datav1alpha1.MemberDatasetStatus{
+				{Name: "a", Phase: datav1alpha1.BoundDatasetPhase},
+				{Name: "b", Phase: datav1alpha1.PendingDatasetPhase},
+			},
+			wantPhase: datav1alpha1.PendingDatasetPhase,
+			wantReady: "1/2",
+		},
+		{
+			name: "failed to sync",
+			members: []datav1alpha1.MemberDatasetStatus{
+				{Name: "a", Phase: datav1alpha1.BoundDatasetPhase},
+				{Name: "b", Message: "cluster is unreachable"},
+			},
+			wantPhase: datav1alpha1.FailedDatasetPhase,
+			wantReady: "1/2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := aggregateStatus(tt.members)
+			if status.Phase != tt.wantPhase || status.ReadyClusters != tt.wantReady || status.UfsTotal != tt.wantUfsTotal {
+				t.Errorf("expect phase %s, ready clusters %s, ufs total %s, got %+v", tt.wantPhase, tt.wantReady, tt.wantUfsTotal, status)
+			}
+			if len(status.CacheStates) != len(tt.wantCacheStat) {
+				t.Fatalf("expect cache states %v, got %v", tt.wantCacheStat, status.CacheStates)
+			}
+			for k, v := range tt.wantCacheStat {
+				if status.CacheStates[k] != v {
+					t.Errorf("expect cache states %v, got %v", tt.wantCacheStat, status.CacheStates)
+				}
+			}
+		})
+	}
+}
@@ -31,10 +31,12 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
@@ -128,12 +130,15 @@ func (r *ThinRuntimeReconciler) Reconcile(context context.Context, req ctrl.Requ
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ThinRuntimeReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options, eventDriven bool) error {
+	// The ConfigMaps and the Secrets are watched to keep their copies for the reference datasets up to date
 	if eventDriven {
-		return watch.SetupWatcherForReconcilerWithDataset(mgr, options, r, common.ThinRuntime)
+		return watch.SetupWatcherForReconcilerWithSharedResources(mgr, options, r, common.ThinRuntime)
 	} else {
 		return ctrl.NewControllerManagedBy(mgr).
 			WithOptions(options).
 			For(&datav1alpha1.ThinRuntime{}).
+			WatchesMetadata(&corev1.ConfigMap{}, watch.EnqueueReferenceRuntimesForSharedResource(mgr.GetClient()), builder.WithPredicates(watch.SharedResourcePredicate())).
+			WatchesMetadata(&corev1.Secret{}, watch.EnqueueReferenceRuntimesForSharedResource(mgr.GetClient()), builder.WithPredicates(watch.SharedResourcePredicate())).
 			Complete(r)
 	}
 }
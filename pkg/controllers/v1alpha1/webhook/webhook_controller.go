@@ -26,9 +26,10 @@ import (
 )
 
 type WebhookReconciler struct {
-	CertBuilder *webhook.CertificateBuilder
-	WebhookName string
-	CaCert      []byte
+	CertBuilder           *webhook.CertificateBuilder
+	WebhookName           string
+	ValidatingWebhookName string
+	CaCert                []byte
 }
 
 func (r *WebhookReconciler) Reconcile(context.Context, ctrl.Request) (ctrl.Result, error) {
@@ -37,5 +38,13 @@ func (r *WebhookReconciler) Reconcile(context.Context, ctrl.Request) (ctrl.Resul
 	if err != nil {
 		return utils.RequeueAfterInterval(10 * time.Second)
 	}
+
+	// patch ca of ValidatingWebhookConfiguration, which is not installed if the validation is disabled
+	if len(r.ValidatingWebhookName) > 0 {
+		err = r.CertBuilder.PatchValidatingCABundle(r.ValidatingWebhookName, r.CaCert)
+		if utils.IgnoreNotFound(err) != nil {
+			return utils.RequeueAfterInterval(10 * time.Second)
+		}
+	}
 	return utils.NoRequeue()
 }
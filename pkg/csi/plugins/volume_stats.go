@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/mount"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+)
+
+// volumeStatsTimeout is the max time to wait for the stat of a volume path
+var volumeStatsTimeout = 10 * time.Second
+
+var errVolumeStatsTimeout = errors.New("timeout to stat the volume")
+
+// statingVolumes records the volume paths whose stat has not returned
+var statingVolumes sync.Map
+
+// NodeGetVolumeStats reports the capacity and usage of the FUSE mount point behind the volume, and marks
+// the volume abnormal if the FUSE connection is dead or the bind mount of the volume is broken.
+func (ns *nodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolumeStatsRequest) (*csi.NodeGetVolumeStatsResponse, error) {
+	volumePath := req.GetVolumePath()
+	if len(volumePath) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "NodeGetVolumeStats operation requires volumePath but is not provided")
+	}
+
+	statfs, err := statVolume(ctx, volumePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Errorf(codes.NotFound, "volume path %s does not exist", volumePath)
+		}
+		if mount.IsCorruptedMnt(err) {
+			glog.Warningf("NodeGetVolumeStats: the FUSE connection of volume path %s is dead: %v", volumePath, err)
+			return abnormalVolumeStats(fmt.Sprintf("the FUSE connection of the volume is dead: %v", err)), nil
+		}
+		if errors.Is(err, errVolumeStatsTimeout) {
+			glog.Warningf("NodeGetVolumeStats: the FUSE of volume path %s doesn't respond: %v", volumePath, err)
+			return abnormalVolumeStats(fmt.Sprintf("the FUSE of the volume doesn't respond: %v", err)), nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &csi.NodeGetVolumeStatsResponse{
+		Usage: getVolumeUsage(statfs),
+		VolumeCondition: &csi.VolumeCondition{
+			Abnormal: false,
+			Message:  "volume is healthy",
+		},
+	}
+
+	broken, err := isBrokenBindMount(volumePath)
+	if err != nil {
+		// the usage is still valid, report it without the broken check
+		glog.Warningf("NodeGetVolumeStats: failed to check the bind mount of volume path %s: %v", volumePath, err)
+	} else if broken {
+		glog.Warningf("NodeGetVolumeStats: the bind mount of volume path %s is broken", volumePath)
+		resp.VolumeCondition = &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  "the bind mount of the volume is broken, the FUSE of the dataset may have been restarted",
+		}
+	}
+
+	return resp, nil
+}
+
+// statVolume stats the volume path with a timeout, because the stat hangs if the FUSE doesn't respond.
+// The stat of a path is not started again while the previous one still hangs, so that the hanging
+// goroutines don't pile up when the volume is checked periodically.
+func statVolume(ctx context.Context, volumePath string) (*syscall.Statfs_t, error) {
+	if _, loaded := statingVolumes.LoadOrStore(volumePath, struct{}{}); loaded {
+		return nil, fmt.Errorf("%w: the previous stat of the volume path is not finished", errVolumeStatsTimeout)
+	}
+
+	type result struct {
+		statfs *syscall.Statfs_t
+		err    error
+	}
+	// buffered so that the goroutine exits even if no one receives the result after the timeout
+	done := make(chan result, 1)
+	go func() {
+		defer statingVolumes.Delete(volumePath)
+		if _, err := os.Stat(volumePath); err != nil {
+			done <- result{err: err}
+			return
+		}
+		var statfs syscall.Statfs_t
+		if err := syscall.Statfs(volumePath, &statfs); err != nil {
+			done <- result{err: err}
+			return
+		}
+		done <- result{statfs: &statfs}
+	}()
+
+	timer := time.NewTimer(volumeStatsTimeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		return r.statfs, r.err
+	case <-timer.C:
+		return nil, fmt.Errorf("%w: the stat of the volume path doesn't return in %v", errVolumeStatsTimeout, volumeStatsTimeout)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func getVolumeUsage(statfs *syscall.Statfs_t) (usage []*csi.VolumeUsage) {
+	blockSize := int64(statfs.Bsize)
+	usage = append(usage, &csi.VolumeUsage{
+		Unit:      csi.VolumeUsage_BYTES,
+		Total:     int64(statfs.Blocks) * blockSize,
+		Available: int64(statfs.Bavail) * blockSize,
+		Used:      int64(statfs.Blocks-statfs.Bfree) * blockSize,
+	})
+
+	// many FUSE file systems don't report inodes
+	if statfs.Files > 0 {
+		usage = append(usage, &csi.VolumeUsage{
+			Unit:      csi.VolumeUsage_INODES,
+			Total:     int64(statfs.Files),
+			Available: int64(statfs.Ffree),
+			Used:      int64(statfs.Files - statfs.Ffree),
+		})
+	}
+	return
+}
+
+func isBrokenBindMount(volumePath string) (bool, error) {
+	brokenMounts, err := mountinfo.GetBrokenMountPoints()
+	if err != nil {
+		return false, err
+	}
+	volumePath = filepath.Clean(volumePath)
+	for _, brokenMount := range brokenMounts {
+		if filepath.Clean(brokenMount.MountPath) == volumePath {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// abnormalVolumeStats reports the abnormal condition with a zero usage, because kubelet discards the
+// response without any usage.
+func abnormalVolumeStats(message string) *csi.NodeGetVolumeStatsResponse {
+	return &csi.NodeGetVolumeStatsResponse{
+		Usage: []*csi.VolumeUsage{
+			{
+				Unit: csi.VolumeUsage_BYTES,
+			},
+		},
+		VolumeCondition: &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  message,
+		},
+	}
+}
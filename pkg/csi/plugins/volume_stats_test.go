@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"errors"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+)
+
+func TestNodeGetVolumeStats(t *testing.T) {
+	volumePath := t.TempDir()
+
+	tests := []struct {
+		name         string
+		volumePath   string
+		brokenMounts []mountinfo.MountPoint
+		brokenErr    error
+		wantCode     codes.Code
+		wantAbnormal bool
+	}{
+		{
+			name:       "healthy volume",
+			volumePath: volumePath,
+			brokenMounts: []mountinfo.MountPoint{
+				{MountPath: "/var/lib/kubelet/pods/uid/volumes/kubernetes.io~csi/default-hbase/mount"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:       "broken bind mount",
+			volumePath: volumePath,
+			brokenMounts: []mountinfo.MountPoint{
+				{MountPath: volumePath + "/"},
+			},
+			wantCode:     codes.OK,
+			wantAbnormal: true,
+		},
+		{
+			name:       "failed to check bind mounts",
+			volumePath: volumePath,
+			brokenErr:  errors.New("failed to read mountinfo"),
+			wantCode:   codes.OK,
+		},
+		{
+			name:       "volume path not exist",
+			volumePath: filepath.Join(volumePath, "not-exist"),
+			wantCode:   codes.NotFound,
+		},
+		{
+			name:     "volume path not provided",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(mountinfo.GetBrokenMountPoints, func() ([]mountinfo.MountPoint, error) {
+				return tt.brokenMounts, tt.brokenErr
+			})
+			defer patches.Reset()
+
+			ns := &nodeServer{}
+			resp, err := ns.NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{VolumePath: tt.volumePath})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expect code %v, got error %v", tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if resp.GetVolumeCondition().GetAbnormal() != tt.wantAbnormal {
+				t.Errorf("expect abnormal %v, got %v", tt.wantAbnormal, resp.GetVolumeCondition())
+			}
+			if len(resp.GetUsage()) == 0 || resp.GetUsage()[0].GetUnit() != csi.VolumeUsage_BYTES || resp.GetUsage()[0].GetTotal() <= 0 {
+				t.Errorf("expect the usage in bytes, got %v", resp.GetUsage())
+			}
+		})
+	}
+}
+
+func TestNodeGetVolumeStatsTimeout(t *testing.T) {
+	volumePath := t.TempDir()
+
+	release := make(chan struct{})
+	patches := gomonkey.ApplyFunc(syscall.Statfs, func(path string, buf *syscall.Statfs_t) error {
+		<-release
+		return nil
+	})
+	defer patches.Reset()
+	patches.ApplyGlobalVar(&volumeStatsTimeout, 10*time.Millisecond)
+
+	ns := &nodeServer{}
+	// the second request reports abnormal without waiting while the stat of the first one still hangs
+	for i := 0; i < 2; i++ {
+		resp, err := ns.NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{VolumePath: volumePath})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !resp.GetVolumeCondition().GetAbnormal() {
+			t.Errorf("expect the volume abnormal when the stat hangs, got %v", resp.GetVolumeCondition())
+		}
+		if len(resp.GetUsage()) == 0 || resp.GetUsage()[0].GetUnit() != csi.VolumeUsage_BYTES {
+			t.Errorf("expect the zero usage in bytes with the abnormal condition, got %v", resp.GetUsage())
+		}
+	}
+
+	close(release)
+	for i := 0; i < 100; i++ {
+		if _, found := statingVolumes.Load(volumePath); !found {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("expect the volume path not recorded after the stat returns")
+}
+
+func TestNodeGetCapabilities(t *testing.T) {
+	ns := &nodeServer{}
+	resp, err := ns.NodeGetCapabilities(context.TODO(), &csi.NodeGetCapabilitiesRequest{})
+	if err != nil {
+		t.Fatalf("failed to get capabilities: %v", err)
+	}
+
+	wants := map[csi.NodeServiceCapability_RPC_Type]bool{
+		csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME: false,
+		csi.NodeServiceCapability_RPC_GET_VOLUME_STATS:     false,
+		csi.NodeServiceCapability_RPC_VOLUME_CONDITION:     false,
+	}
+	for _, capability := range resp.GetCapabilities() {
+		wants[capability.GetRpc().GetType()] = true
+	}
+	for capability, found := range wants {
+		if !found {
+			t.Errorf("expect capability %v to be advertised", capability)
+		}
+	}
+}
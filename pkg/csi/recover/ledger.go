@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recover
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+)
+
+// RecoveryRecord is the recovery history of a broken bind mount point on the node.
+type RecoveryRecord struct {
+	MountPath  string `json:"mountPath"`
+	SourcePath string `json:"sourcePath"`
+	// Dataset is the namespaced name of the dataset (i.e. the volume id) the mount point belongs to
+	Dataset string `json:"dataset"`
+
+	Attempts            int    `json:"attempts"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+	LastError           string `json:"lastError,omitempty"`
+	// LastSkipReason is the reason why the latest tick didn't try to recover the mount point
+	LastSkipReason string `json:"lastSkipReason,omitempty"`
+
+	LastAttemptTime metav1.Time `json:"lastAttemptTime,omitempty"`
+	LastSuccessTime metav1.Time `json:"lastSuccessTime,omitempty"`
+	// NextAttemptTime is the earliest time of the next attempt if the mount point is still broken
+	NextAttemptTime metav1.Time `json:"nextAttemptTime,omitempty"`
+}
+
+// recoveryLedger keeps the recovery records of the node, keyed by mount path.
+type recoveryLedger struct {
+	mu      sync.RWMutex
+	records map[string]*RecoveryRecord
+}
+
+func newRecoveryLedger() *recoveryLedger {
+	return &recoveryLedger{
+		records: map[string]*RecoveryRecord{},
+	}
+}
+
+// getOrCreate must be called with the lock held.
+func (l *recoveryLedger) getOrCreate(point mountinfo.MountPoint) *RecoveryRecord {
+	record, found := l.records[point.MountPath]
+	if !found {
+		record = &RecoveryRecord{MountPath: point.MountPath}
+		l.records[point.MountPath] = record
+	}
+	record.SourcePath = point.SourcePath
+	record.Dataset = point.NamespacedDatasetName
+	return record
+}
+
+func (l *recoveryLedger) recordSkipped(point mountinfo.MountPoint, reason string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.getOrCreate(point).LastSkipReason = reason
+}
+
+func (l *recoveryLedger) recordResult(point mountinfo.MountPoint, err error, now, next time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record := l.getOrCreate(point)
+	record.Attempts++
+	record.LastSkipReason = ""
+	record.LastAttemptTime = metav1.NewTime(now)
+	record.NextAttemptTime = metav1.NewTime(next)
+	if err != nil {
+		record.ConsecutiveFailures++
+		record.LastError = err.Error()
+		return
+	}
+	record.ConsecutiveFailures = 0
+	record.LastError = ""
+	record.LastSuccessTime = metav1.NewTime(now)
+}
+
+// markHealthy clears the pending state of the mount points that are no longer broken.
+func (l *recoveryLedger) markHealthy(brokenMountPaths map[string]bool) (healthy []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for mountPath, record := range l.records {
+		if brokenMountPaths[mountPath] {
+			continue
+		}
+		record.ConsecutiveFailures = 0
+		record.LastSkipReason = ""
+		record.NextAttemptTime = metav1.Time{}
+		healthy = append(healthy, mountPath)
+	}
+	return
+}
+
+// remove deletes the record of the mount path, and returns the dataset it belongs to.
+func (l *recoveryLedger) remove(mountPath string) (dataset string, found bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record, found := l.records[mountPath]
+	if !found {
+		return "", false
+	}
+	delete(l.records, mountPath)
+	return record.Dataset, true
+}
+
+// hasDataset checks if any record belongs to the dataset.
+func (l *recoveryLedger) hasDataset(dataset string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, record := range l.records {
+		if record.Dataset == dataset {
+			return true
+		}
+	}
+	return false
+}
+
+// list returns a copy of all the records sorted by mount path.
+func (l *recoveryLedger) list() []RecoveryRecord {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	records := make([]RecoveryRecord, 0, len(l.records))
+	for _, record := range l.records {
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].MountPath < records[j].MountPath
+	})
+	return records
+}
+
+// ServeHTTP writes all the recovery records of the node in json.
+func (l *recoveryLedger) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(l.list()); err != nil {
+		glog.Errorf("FuseRecovery: failed to write recovery records: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recover
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+)
+
+func TestRecoveryLedger(t *testing.T) {
+	pointA := mountinfo.MountPoint{
+		SourcePath:            "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse",
+		MountPath:             "/var/lib/kubelet/pods/a/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+		NamespacedDatasetName: "default-jfsdemo",
+	}
+	pointB := mountinfo.MountPoint{
+		SourcePath:            "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse",
+		MountPath:             "/var/lib/kubelet/pods/b/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+		NamespacedDatasetName: "default-jfsdemo",
+	}
+	now := time.Now()
+
+	ledger := newRecoveryLedger()
+	ledger.recordResult(pointB, errors.New("mount failed"), now, now.Add(time.Minute))
+	ledger.recordResult(pointB, errors.New("mount failed"), now, now.Add(2*time.Minute))
+	ledger.recordResult(pointA, nil, now, now.Add(time.Minute))
+	ledger.recordSkipped(pointA, skipReasonBackoff)
+
+	records := ledger.list()
+	if len(records) != 2 || records[0].MountPath != pointA.MountPath || records[1].MountPath != pointB.MountPath {
+		t.Fatalf("expect records sorted by mount path, got %v", records)
+	}
+	if records[0].Attempts != 1 || records[0].ConsecutiveFailures != 0 || records[0].LastSuccessTime.IsZero() ||
+		records[0].LastSkipReason != skipReasonBackoff {
+		t.Errorf("unexpected record of succeeded mount point: %v", records[0])
+	}
+	if records[1].Attempts != 2 || records[1].ConsecutiveFailures != 2 || records[1].LastError != "mount failed" ||
+		!records[1].LastSuccessTime.IsZero() || !records[1].NextAttemptTime.Time.Equal(now.Add(2*time.Minute)) {
+		t.Errorf("unexpected record of failed mount point: %v", records[1])
+	}
+
+	healthy := ledger.markHealthy(map[string]bool{pointB.MountPath: true})
+	if len(healthy) != 1 || healthy[0] != pointA.MountPath {
+		t.Errorf("expect %s to be healthy, got %v", pointA.MountPath, healthy)
+	}
+	if record := ledger.list()[0]; record.LastSkipReason != "" || !record.NextAttemptTime.IsZero() {
+		t.Errorf("expect pending state of healthy mount point to be cleared, got %v", record)
+	}
+
+	if dataset, found := ledger.remove(pointA.MountPath); !found || dataset != "default-jfsdemo" {
+		t.Errorf("expect record of %s to be removed, got %s, %v", pointA.MountPath, dataset, found)
+	}
+	if !ledger.hasDataset("default-jfsdemo") {
+		t.Errorf("expect records of default-jfsdemo to exist")
+	}
+	ledger.remove(pointB.MountPath)
+	if ledger.hasDataset("default-jfsdemo") {
+		t.Errorf("expect no records of default-jfsdemo")
+	}
+}
+
+func TestRecoveryLedger_ServeHTTP(t *testing.T) {
+	ledger := newRecoveryLedger()
+	ledger.recordResult(mountinfo.MountPoint{
+		SourcePath:            "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse",
+		MountPath:             "/var/lib/kubelet/pods/a/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+		NamespacedDatasetName: "default-jfsdemo",
+	}, errors.New("mount failed"), time.Now(), time.Now())
+
+	tests := []struct {
+		name       string
+		method     string
+		wantStatus int
+	}{
+		{
+			name:       "get records",
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "method not allowed",
+			method:     http.MethodPost,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ledger.ServeHTTP(w, httptest.NewRequest(tt.method, RecoverStatusPath, nil))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expect status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var records []RecoveryRecord
+			if err := json.Unmarshal(w.Body.Bytes(), &records); err != nil {
+				t.Fatalf("failed to decode records: %v", err)
+			}
+			if len(records) != 1 || records[0].Dataset != "default-jfsdemo" || records[0].LastError != "mount failed" {
+				t.Errorf("unexpected records %v", records)
+			}
+		})
+	}
+}
@@ -18,12 +18,14 @@ package recover
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubelet"
@@ -31,9 +33,11 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	k8sexec "k8s.io/utils/exec"
 	"k8s.io/utils/mount"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,9 +48,18 @@ const (
 	defaultKubeletTimeout          = 10
 	defaultFuseRecoveryPeriod      = 5 * time.Second
 	defaultRecoverWarningThreshold = 50
+	defaultRecoverMaxBackoff       = 5 * time.Minute
+	defaultRecoverStatusAddr       = "127.0.0.1:8089"
 	serviceAccountTokenFile        = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 	FuseRecoveryPeriod             = "RECOVER_FUSE_PERIOD"
 	RecoverWarningThreshold        = "RECOVER_WARNING_THRESHOLD"
+	RecoverMaxBackoff              = "RECOVER_MAX_BACKOFF"
+	// RecoverStatusAddr is the address to serve the recovery records of the node, empty value disables it
+	RecoverStatusAddr = "RECOVER_STATUS_ADDR"
+	RecoverStatusPath = "/recover/status"
+
+	skipReasonBackoff        = "backoff"
+	skipReasonPodTerminating = "pod_terminating"
 )
 
 var _ manager.Runnable = &FuseRecover{}
@@ -62,6 +75,13 @@ type FuseRecover struct {
 	recoverWarningThreshold int
 
 	locks *utils.VolumeLocks
+
+	// nodeName is used to find the terminating pods on the node, whose mount points needn't be recovered
+	nodeName   string
+	statusAddr string
+	// backoff delays the next recovery of a mount point which is still broken after recovering
+	backoff *flowcontrol.Backoff
+	ledger  *recoveryLedger
 }
 
 func initializeKubeletClient() (*kubelet.KubeletClient, error) {
@@ -104,7 +124,7 @@ func initializeKubeletClient() (*kubelet.KubeletClient, error) {
 	return kubeletClient, nil
 }
 
-func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, apiReader client.Reader, locks *utils.VolumeLocks) (*FuseRecover, error) {
+func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, apiReader client.Reader, locks *utils.VolumeLocks, nodeName string) (*FuseRecover, error) {
 	glog.V(3).Infoln("start csi recover")
 	mountRoot, err := utils.GetMountRoot()
 	if err != nil {
@@ -121,6 +141,14 @@ func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, api
 	if !found {
 		recoverWarningThreshold = defaultRecoverWarningThreshold
 	}
+	recoverMaxBackoff := utils.GetDurationValueFromEnv(RecoverMaxBackoff, defaultRecoverMaxBackoff)
+	if recoverMaxBackoff < recoverFusePeriod {
+		recoverMaxBackoff = recoverFusePeriod
+	}
+	statusAddr, found := os.LookupEnv(RecoverStatusAddr)
+	if !found {
+		statusAddr = defaultRecoverStatusAddr
+	}
 	return &FuseRecover{
 		SafeFormatAndMount: mount.SafeFormatAndMount{
 			Interface: mount.New(""),
@@ -132,10 +160,16 @@ func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, api
 		recoverFusePeriod:       recoverFusePeriod,
 		recoverWarningThreshold: recoverWarningThreshold,
 		locks:                   locks,
+		nodeName:                nodeName,
+		statusAddr:              statusAddr,
+		backoff:                 flowcontrol.NewBackOff(recoverFusePeriod, recoverMaxBackoff),
+		ledger:                  newRecoveryLedger(),
 	}, nil
 }
 
 func (r *FuseRecover) Start(ctx context.Context) error {
+	r.startStatusServer(ctx)
+
 	// do recovering at beginning
 	// recover set containerStat in memory, it's none when start
 	r.recover()
@@ -144,6 +178,36 @@ func (r *FuseRecover) Start(ctx context.Context) error {
 	return nil
 }
 
+// startStatusServer serves the recovery records of the node over http, e.g.
+// curl http://127.0.0.1:8089/recover/status
+func (r *FuseRecover) startStatusServer(ctx context.Context) {
+	if len(r.statusAddr) == 0 {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(RecoverStatusPath, r.ledger)
+	server := &http.Server{
+		Addr:    r.statusAddr,
+		Handler: mux,
+	}
+	glog.Infof("FuseRecovery: starting recovery status server at %s", r.statusAddr)
+
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			glog.Errorf("FuseRecovery: failed to shutdown recovery status server: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			// the recovery itself still works without the status server
+			glog.Errorf("FuseRecovery: failed to start recovery status server: %v", err)
+		}
+	}()
+}
+
 func (r *FuseRecover) run(stopCh <-chan struct{}) {
 	go wait.Until(r.runOnce, r.recoverFusePeriod, stopCh)
 	<-stopCh
@@ -161,11 +225,80 @@ func (r *FuseRecover) recover() {
 		return
 	}
 
+	brokenMountPaths := map[string]bool{}
+	for _, point := range brokenMounts {
+		brokenMountPaths[point.MountPath] = true
+	}
+	r.cleanupRecovered(brokenMountPaths)
+
+	if len(brokenMounts) == 0 {
+		return
+	}
+
+	terminatingPods, err := r.getTerminatingPods()
+	if err != nil {
+		// recover all the broken mount points as before
+		glog.Warningf("FuseRecovery: failed to get terminating pods on node %s: %v", r.nodeName, err)
+	}
+
 	for _, point := range brokenMounts {
+		if podUID := getPodUIDFromMountPath(point.MountPath); len(podUID) > 0 && terminatingPods[podUID] {
+			// the pod is being deleted (e.g. the node is draining), the mount point will be cleaned up soon
+			glog.V(3).Infof("FuseRecovery: pod %s of mount point %s is terminating, skip recovering it", podUID, point.MountPath)
+			r.ledger.recordSkipped(point, skipReasonPodTerminating)
+			metrics.GetOrCreateFuseRecoverMetrics(point.NamespacedDatasetName).SkippedInc(skipReasonPodTerminating)
+			continue
+		}
 		r.doRecover(point)
 	}
 }
 
+// cleanupRecovered resets the backoff of the mount points which are no longer broken,
+// and removes the records of the mount points which have been cleaned up.
+func (r *FuseRecover) cleanupRecovered(brokenMountPaths map[string]bool) {
+	for _, mountPath := range r.ledger.markHealthy(brokenMountPaths) {
+		r.backoff.Reset(mountPath)
+		if _, err := os.Stat(mountPath); !os.IsNotExist(err) {
+			continue
+		}
+		dataset, found := r.ledger.remove(mountPath)
+		if found && !r.ledger.hasDataset(dataset) {
+			metrics.GetOrCreateFuseRecoverMetrics(dataset).Forget()
+		}
+	}
+}
+
+// getTerminatingPods returns the uid set of the pods being deleted on the node.
+func (r *FuseRecover) getTerminatingPods() (map[types.UID]bool, error) {
+	terminatingPods := map[types.UID]bool{}
+	if len(r.nodeName) == 0 {
+		return terminatingPods, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.ApiReader.List(context.TODO(), podList, client.MatchingFields{"spec.nodeName": r.nodeName}); err != nil {
+		return terminatingPods, err
+	}
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp != nil {
+			terminatingPods[pod.UID] = true
+		}
+	}
+	return terminatingPods, nil
+}
+
+// getPodUIDFromMountPath parses the pod uid from the mount path like
+// /var/lib/kubelet/pods/<pod-uid>/volumes/kubernetes.io~csi/<pv-name>/mount
+func getPodUIDFromMountPath(mountPath string) types.UID {
+	strs := strings.Split(mountPath, "/")
+	for i := 0; i < len(strs)-1; i++ {
+		if strs[i] == "pods" && i+2 < len(strs) && strs[i+2] == "volumes" {
+			return types.UID(strs[i+1])
+		}
+	}
+	return ""
+}
+
 func (r *FuseRecover) recoverBrokenMount(point mountinfo.MountPoint) (err error) {
 	// recovery for each bind mount path
 	mountOption := []string{"bind"}
@@ -174,7 +307,7 @@ func (r *FuseRecover) recoverBrokenMount(point mountinfo.MountPoint) (err error)
 	}
 
 	glog.V(3).Infof("FuseRecovery: Start exec cmd: mount %s %s -o %v \n", point.SourcePath, point.MountPath, mountOption)
-	if err := r.Mount(point.SourcePath, point.MountPath, "none", mountOption); err != nil {
+	if err = r.Mount(point.SourcePath, point.MountPath, "none", mountOption); err != nil {
 		glog.Errorf("FuseRecovery: exec cmd: mount -o bind %s %s with err :%v", point.SourcePath, point.MountPath, err)
 	}
 	return
@@ -254,6 +387,14 @@ func (r *FuseRecover) doRecover(point mountinfo.MountPoint) {
 		return
 	}
 
+	now := r.backoff.Clock.Now()
+	if r.backoff.IsInBackOffSinceUpdate(point.MountPath, now) {
+		glog.V(4).Infof("FuseRecovery: path %s is still broken after recovering, skip recovering it until backoff expires", point.MountPath)
+		r.ledger.recordSkipped(point, skipReasonBackoff)
+		metrics.GetOrCreateFuseRecoverMetrics(point.NamespacedDatasetName).SkippedInc(skipReasonBackoff)
+		return
+	}
+
 	glog.V(3).Infof("FuseRecovery: recovering broken mount point: %v", point)
 	// if app container restart, umount duplicate mount may lead to recover successes but can not access data
 	// so we only umountDuplicate when it has mounted more than the recoverWarningThreshold
@@ -263,9 +404,20 @@ func (r *FuseRecover) doRecover(point mountinfo.MountPoint) {
 		r.eventRecord(point, corev1.EventTypeWarning, common.FuseUmountDuplicate)
 		r.umountDuplicate(point)
 	}
-	if err := r.recoverBrokenMount(point); err != nil {
+
+	// the bind mount may succeed while the FUSE is still down, so back off every attempt
+	// until the mount point is no longer broken
+	r.backoff.Next(point.MountPath, now)
+	err = r.recoverBrokenMount(point)
+	r.ledger.recordResult(point, err, now, now.Add(r.backoff.Get(point.MountPath)))
+
+	m := metrics.GetOrCreateFuseRecoverMetrics(point.NamespacedDatasetName)
+	m.AttemptInc()
+	if err != nil {
+		m.FailedInc()
 		r.eventRecord(point, corev1.EventTypeWarning, common.FuseRecoverFailed)
 		return
 	}
+	m.SucceededInc()
 	r.eventRecord(point, corev1.EventTypeNormal, common.FuseRecoverSucceed)
 }
@@ -17,9 +17,11 @@ limitations under the License.
 package recover
 
 import (
+	"errors"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/agiledragon/gomonkey/v2"
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -32,11 +34,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	testingclock "k8s.io/utils/clock/testing"
 	k8sexec "k8s.io/utils/exec"
 	"k8s.io/utils/mount"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const testfuseRecoverPeriod = 30
@@ -98,6 +104,8 @@ func TestRecover_run(t *testing.T) {
 				Recorder:          record.NewFakeRecorder(1),
 				recoverFusePeriod: testfuseRecoverPeriod,
 				locks:             utils.NewVolumeLocks(),
+				backoff:           flowcontrol.NewBackOff(testfuseRecoverPeriod, 10*testfuseRecoverPeriod),
+				ledger:            newRecoveryLedger(),
 			}
 
 			patch1 := ApplyMethod(reflect.TypeOf(fakeMounter), "Mount", func(_ *mount.FakeMounter, source string, target string, _ string, _ []string) error {
@@ -331,6 +339,10 @@ func TestNewFuseRecover(t *testing.T) {
 				recoverFusePeriod:       defaultFuseRecoveryPeriod,
 				recoverWarningThreshold: defaultRecoverWarningThreshold,
 				locks:                   volumeLocks,
+				nodeName:                "node1",
+				statusAddr:              defaultRecoverStatusAddr,
+				backoff:                 flowcontrol.NewBackOff(defaultFuseRecoveryPeriod, defaultRecoverMaxBackoff),
+				ledger:                  newRecoveryLedger(),
 			},
 			wantErr: false,
 		},
@@ -340,7 +352,7 @@ func TestNewFuseRecover(t *testing.T) {
 			t.Setenv(utils.MountRoot, "/runtime-mnt")
 			t.Setenv(FuseRecoveryPeriod, tt.args.recoverFusePeriod)
 
-			got, err := NewFuseRecover(tt.args.kubeClient, tt.args.recorder, tt.args.kubeClient, tt.args.locks)
+			got, err := NewFuseRecover(tt.args.kubeClient, tt.args.recorder, tt.args.kubeClient, tt.args.locks, "node1")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewFuseRecover() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -351,3 +363,216 @@ func TestNewFuseRecover(t *testing.T) {
 		})
 	}
 }
+
+func TestFuseRecover_recoverWithBackoff(t *testing.T) {
+	const (
+		sourcePath = "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse"
+		targetPath = "/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-jfsdemo/mount"
+		period     = 5 * time.Second
+	)
+
+	s := apimachineryRuntime.NewScheme()
+	_ = v1alpha1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+	fakeClient := fake.NewFakeClientWithScheme(s)
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	fakeMounter := &mount.FakeMounter{}
+	r := &FuseRecover{
+		SafeFormatAndMount: mount.SafeFormatAndMount{
+			Interface: fakeMounter,
+		},
+		KubeClient:        fakeClient,
+		ApiReader:         fakeClient,
+		Recorder:          record.NewFakeRecorder(10),
+		recoverFusePeriod: period,
+		locks:             utils.NewVolumeLocks(),
+		backoff:           flowcontrol.NewFakeBackOff(period, 4*period, fakeClock),
+		ledger:            newRecoveryLedger(),
+	}
+
+	mountCalls := 0
+	patch1 := ApplyMethod(reflect.TypeOf(fakeMounter), "Mount", func(_ *mount.FakeMounter, source string, target string, _ string, _ []string) error {
+		mountCalls++
+		return errors.New("mount failed")
+	})
+	defer patch1.Reset()
+
+	brokenMounts := []mountinfo.MountPoint{{
+		SourcePath:            sourcePath,
+		MountPath:             targetPath,
+		FilesystemType:        "fuse.juicefs",
+		NamespacedDatasetName: "default-jfsdemo",
+	}}
+	patch2 := ApplyFunc(mountinfo.GetBrokenMountPoints, func() ([]mountinfo.MountPoint, error) {
+		return brokenMounts, nil
+	})
+	defer patch2.Reset()
+
+	patch3 := ApplyPrivateMethod(r, "shouldRecover", func(mountPath string) (bool, error) {
+		return true, nil
+	})
+	defer patch3.Reset()
+
+	steps := []struct {
+		elapsed        time.Duration
+		wantMountCalls int
+		wantSkipReason string
+	}{
+		{elapsed: 0, wantMountCalls: 1},
+		{elapsed: time.Second, wantMountCalls: 1, wantSkipReason: skipReasonBackoff},
+		{elapsed: period, wantMountCalls: 2},
+		// the backoff has been doubled
+		{elapsed: period + time.Second, wantMountCalls: 2, wantSkipReason: skipReasonBackoff},
+		{elapsed: period, wantMountCalls: 3},
+	}
+	for i, step := range steps {
+		fakeClock.Step(step.elapsed)
+		r.recover()
+
+		if mountCalls != step.wantMountCalls {
+			t.Fatalf("step %d: expect %d mount calls, got %d", i, step.wantMountCalls, mountCalls)
+		}
+		records := r.ledger.list()
+		if len(records) != 1 {
+			t.Fatalf("step %d: expect 1 record, got %v", i, records)
+		}
+		if records[0].Attempts != step.wantMountCalls || records[0].ConsecutiveFailures != step.wantMountCalls {
+			t.Errorf("step %d: expect %d attempts and failures, got %v", i, step.wantMountCalls, records[0])
+		}
+		if records[0].LastSkipReason != step.wantSkipReason {
+			t.Errorf("step %d: expect skip reason %q, got %q", i, step.wantSkipReason, records[0].LastSkipReason)
+		}
+		if records[0].LastError != "mount failed" || records[0].Dataset != "default-jfsdemo" {
+			t.Errorf("step %d: unexpected record %v", i, records[0])
+		}
+	}
+
+	// the mount point is no longer broken and has been cleaned up
+	brokenMounts = nil
+	r.recover()
+	if records := r.ledger.list(); len(records) != 0 {
+		t.Errorf("expect records of cleaned up mount points to be removed, got %v", records)
+	}
+	if backoff := r.backoff.Get(targetPath); backoff != 0 {
+		t.Errorf("expect backoff to be reset, got %v", backoff)
+	}
+}
+
+func TestFuseRecover_skipTerminatingPods(t *testing.T) {
+	const targetPath = "/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-jfsdemo/mount"
+
+	fakeMounter := &mount.FakeMounter{}
+	r := &FuseRecover{
+		SafeFormatAndMount: mount.SafeFormatAndMount{
+			Interface: fakeMounter,
+		},
+		locks:   utils.NewVolumeLocks(),
+		backoff: flowcontrol.NewBackOff(testfuseRecoverPeriod, 10*testfuseRecoverPeriod),
+		ledger:  newRecoveryLedger(),
+	}
+
+	patch1 := ApplyFunc(mountinfo.GetBrokenMountPoints, func() ([]mountinfo.MountPoint, error) {
+		return []mountinfo.MountPoint{{
+			SourcePath:            "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse",
+			MountPath:             targetPath,
+			FilesystemType:        "fuse.juicefs",
+			NamespacedDatasetName: "default-jfsdemo",
+		}}, nil
+	})
+	defer patch1.Reset()
+
+	patch2 := ApplyPrivateMethod(r, "getTerminatingPods", func() (map[types.UID]bool, error) {
+		return map[types.UID]bool{"1140aa96-18c2-4896-a14f-7e3965a51406": true}, nil
+	})
+	defer patch2.Reset()
+
+	r.recover()
+
+	if log := fakeMounter.GetLog(); len(log) != 0 {
+		t.Errorf("expect no mount actions for terminating pods, got %v", log)
+	}
+	records := r.ledger.list()
+	if len(records) != 1 || records[0].Attempts != 0 || records[0].LastSkipReason != skipReasonPodTerminating {
+		t.Errorf("expect the mount point to be skipped, got %v", records)
+	}
+}
+
+func TestGetTerminatingPods(t *testing.T) {
+	now := metav1.Now()
+	pods := []apimachineryRuntime.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "default", UID: "uid-running"},
+			Spec:       corev1.PodSpec{NodeName: "node1"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "terminating", Namespace: "default", UID: "uid-terminating",
+				DeletionTimestamp: &now, Finalizers: []string{"fluid.io/test"}},
+			Spec: corev1.PodSpec{NodeName: "node1"},
+		},
+	}
+
+	fakeClient := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(pods...).
+		WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+			return []string{obj.(*corev1.Pod).Spec.NodeName}
+		}).Build()
+
+	tests := []struct {
+		name     string
+		nodeName string
+		want     map[types.UID]bool
+	}{
+		{
+			name:     "terminating pods on the node",
+			nodeName: "node1",
+			want:     map[types.UID]bool{"uid-terminating": true},
+		},
+		{
+			name:     "no pods on the node",
+			nodeName: "node2",
+			want:     map[types.UID]bool{},
+		},
+		{
+			name:     "node name not set",
+			nodeName: "",
+			want:     map[types.UID]bool{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &FuseRecover{ApiReader: fakeClient, nodeName: tt.nodeName}
+			got, err := r.getTerminatingPods()
+			if err != nil {
+				t.Fatalf("getTerminatingPods() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getTerminatingPods() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPodUIDFromMountPath(t *testing.T) {
+	tests := []struct {
+		mountPath string
+		want      types.UID
+	}{
+		{
+			mountPath: "/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+			want:      "1140aa96-18c2-4896-a14f-7e3965a51406",
+		},
+		{
+			mountPath: "/data/kubelet/pods/uid/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+			want:      "uid",
+		},
+		{
+			mountPath: "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse",
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		if got := getPodUIDFromMountPath(tt.mountPath); got != tt.want {
+			t.Errorf("getPodUIDFromMountPath(%s) = %v, want %v", tt.mountPath, got, tt.want)
+		}
+	}
+}
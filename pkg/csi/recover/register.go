@@ -28,7 +28,7 @@ import (
 
 // Register initializes the fuse recover and registers it to the controller manager.
 func Register(mgr manager.Manager, ctx config.RunningContext) error {
-	fuseRecover, err := NewFuseRecover(mgr.GetClient(), mgr.GetEventRecorderFor("FuseRecover"), mgr.GetAPIReader(), ctx.VolumeLocks)
+	fuseRecover, err := NewFuseRecover(mgr.GetClient(), mgr.GetEventRecorderFor("FuseRecover"), mgr.GetAPIReader(), ctx.VolumeLocks, ctx.NodeId)
 	if err != nil {
 		return err
 	}
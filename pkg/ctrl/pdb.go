@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// SyncPodDisruptionBudget makes the PodDisruptionBudget of the statefulset's pods consistent with the given spec.
+// The PodDisruptionBudget has the same name as the statefulset and is controlled by it, so that it's garbage collected
+// along with the statefulset. If spec is nil, the PodDisruptionBudget is deleted.
+func (e *Helper) SyncPodDisruptionBudget(stsNamespacedName types.NamespacedName, spec *datav1alpha1.PodDisruptionBudgetSpec) (err error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err = e.client.Get(context.TODO(), stsNamespacedName, pdb)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return err
+	}
+	found := err == nil
+
+	if spec == nil {
+		if found && isControlledByStatefulSet(pdb, stsNamespacedName.Name) {
+			e.log.Info("Deleting PodDisruptionBudget", "name", pdb.Name, "namespace", pdb.Namespace)
+			return client.IgnoreNotFound(e.client.Delete(context.TODO(), pdb))
+		}
+		return nil
+	}
+
+	sts, err := kubeclient.GetStatefulSet(e.client, stsNamespacedName.Name, stsNamespacedName.Namespace)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			e.log.V(1).Info("The statefulset is not found, skip syncing its PodDisruptionBudget", "statefulset", stsNamespacedName)
+			return nil
+		}
+		return err
+	}
+
+	desiredSpec := buildPodDisruptionBudgetSpec(sts, spec)
+	if !found {
+		pdb = &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            sts.Name,
+				Namespace:       sts.Namespace,
+				Labels:          sts.Labels,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(sts, appsv1.SchemeGroupVersion.WithKind("StatefulSet"))},
+			},
+			Spec: desiredSpec,
+		}
+		e.log.Info("Creating PodDisruptionBudget", "name", pdb.Name, "namespace", pdb.Namespace)
+		return e.client.Create(context.TODO(), pdb)
+	}
+
+	if !isControlledByStatefulSet(pdb, sts.Name) {
+		e.log.Info("The PodDisruptionBudget is not created by fluid, skip syncing it", "name", pdb.Name, "namespace", pdb.Namespace)
+		return nil
+	}
+
+	if reflect.DeepEqual(pdb.Spec, desiredSpec) {
+		return nil
+	}
+
+	pdbToUpdate := pdb.DeepCopy()
+	pdbToUpdate.Spec = desiredSpec
+	e.log.Info("Updating PodDisruptionBudget", "name", pdb.Name, "namespace", pdb.Namespace)
+	return e.client.Update(context.TODO(), pdbToUpdate)
+}
+
+func buildPodDisruptionBudgetSpec(sts *appsv1.StatefulSet, spec *datav1alpha1.PodDisruptionBudgetSpec) (pdbSpec policyv1.PodDisruptionBudgetSpec) {
+	pdbSpec.Selector = sts.Spec.Selector.DeepCopy()
+	switch {
+	case spec.MinAvailable != nil:
+		minAvailable := *spec.MinAvailable
+		pdbSpec.MinAvailable = &minAvailable
+	case spec.MaxUnavailable != nil:
+		maxUnavailable := *spec.MaxUnavailable
+		pdbSpec.MaxUnavailable = &maxUnavailable
+	default:
+		maxUnavailable := intstr.FromInt32(1)
+		pdbSpec.MaxUnavailable = &maxUnavailable
+	}
+	return
+}
+
+func isControlledByStatefulSet(pdb *policyv1.PodDisruptionBudget, stsName string) bool {
+	controllerRef := metav1.GetControllerOf(pdb)
+	return controllerRef != nil && controllerRef.Kind == "StatefulSet" && controllerRef.Name == stsName
+}
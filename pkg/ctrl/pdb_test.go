@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("Ctrl PodDisruptionBudget Tests", Label("pkg.ctrl.pdb_test.go"), func() {
+	var helper *Helper
+	var resources []runtime.Object
+	var workerSts *appsv1.StatefulSet
+	var k8sClient client.Client
+	var key types.NamespacedName
+
+	BeforeEach(func() {
+		workerSts = mockRuntimeStatefulset("hbase-worker", "fluid")
+		workerSts.Labels = map[string]string{"release": "hbase"}
+		workerSts.Spec.Selector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "alluxio", "role": "alluxio-worker", "release": "hbase"},
+		}
+		resources = []runtime.Object{workerSts}
+		key = types.NamespacedName{Namespace: "fluid", Name: "hbase-worker"}
+	})
+
+	JustBeforeEach(func() {
+		s := runtime.NewScheme()
+		_ = appsv1.AddToScheme(s)
+		_ = policyv1.AddToScheme(s)
+		_ = datav1alpha1.AddToScheme(s)
+		k8sClient = fake.NewFakeClientWithScheme(s, resources...)
+		helper = BuildHelper(nil, k8sClient, fake.NullLogger())
+	})
+
+	getPdb := func() (*policyv1.PodDisruptionBudget, error) {
+		pdb := &policyv1.PodDisruptionBudget{}
+		err := k8sClient.Get(context.TODO(), key, pdb)
+		return pdb, err
+	}
+
+	Describe("Test Helper.SyncPodDisruptionBudget()", func() {
+		When("the PodDisruptionBudget doesn't exist", func() {
+			It("should create it with the selector of the statefulset and default maxUnavailable", func() {
+				Expect(helper.SyncPodDisruptionBudget(key, &datav1alpha1.PodDisruptionBudgetSpec{})).To(Succeed())
+
+				pdb, err := getPdb()
+				Expect(err).To(BeNil())
+				Expect(pdb.Spec.Selector).To(Equal(workerSts.Spec.Selector))
+				Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(1))))
+				Expect(pdb.Spec.MinAvailable).To(BeNil())
+				Expect(pdb.Labels).To(Equal(workerSts.Labels))
+				Expect(metav1.GetControllerOf(pdb).Name).To(Equal(workerSts.Name))
+			})
+
+			It("should do nothing when spec is nil", func() {
+				Expect(helper.SyncPodDisruptionBudget(key, nil)).To(Succeed())
+
+				_, err := getPdb()
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should skip when the statefulset is not found", func() {
+				key.Name = "spark-worker"
+				Expect(helper.SyncPodDisruptionBudget(key, &datav1alpha1.PodDisruptionBudgetSpec{})).To(Succeed())
+
+				_, err := getPdb()
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("the PodDisruptionBudget is created by fluid", func() {
+			BeforeEach(func() {
+				resources = append(resources, &policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "hbase-worker",
+						Namespace:       "fluid",
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(workerSts, appsv1.SchemeGroupVersion.WithKind("StatefulSet"))},
+					},
+					Spec: policyv1.PodDisruptionBudgetSpec{
+						Selector:       workerSts.Spec.Selector,
+						MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+					},
+				})
+			})
+
+			It("should update it when spec changes", func() {
+				minAvailable := intstr.FromString("50%")
+				maxUnavailable := intstr.FromInt32(2)
+				Expect(helper.SyncPodDisruptionBudget(key, &datav1alpha1.PodDisruptionBudgetSpec{
+					MinAvailable:   &minAvailable,
+					MaxUnavailable: &maxUnavailable,
+				})).To(Succeed())
+
+				pdb, err := getPdb()
+				Expect(err).To(BeNil())
+				Expect(pdb.Spec.MinAvailable).To(Equal(&minAvailable))
+				Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+			})
+
+			It("should delete it when spec is nil", func() {
+				Expect(helper.SyncPodDisruptionBudget(key, nil)).To(Succeed())
+
+				_, err := getPdb()
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("the PodDisruptionBudget is not created by fluid", func() {
+			BeforeEach(func() {
+				resources = append(resources, &policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker", Namespace: "fluid"},
+					Spec: policyv1.PodDisruptionBudgetSpec{
+						MaxUnavailable: ptr.To(intstr.FromInt32(3)),
+					},
+				})
+			})
+
+			It("should leave it untouched", func() {
+				Expect(helper.SyncPodDisruptionBudget(key, &datav1alpha1.PodDisruptionBudgetSpec{})).To(Succeed())
+				Expect(helper.SyncPodDisruptionBudget(key, nil)).To(Succeed())
+
+				pdb, err := getPdb()
+				Expect(err).To(BeNil())
+				Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(3))))
+			})
+		})
+	})
+})
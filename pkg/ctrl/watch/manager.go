@@ -55,10 +55,15 @@ type Controller interface {
 }
 
 func SetupWatcherForReconcilerWithDataset(mgr ctrl.Manager, options controller.Options, r Controller, runtimeType string) (err error) {
+	_, err = setupWatcherForReconcilerWithDataset(mgr, options, r, runtimeType)
+	return
+}
+
+func setupWatcherForReconcilerWithDataset(mgr ctrl.Manager, options controller.Options, r Controller, runtimeType string) (c controller.Controller, err error) {
 	options.Reconciler = r
-	c, err := controller.New(r.ControllerName(), mgr, options)
+	c, err = controller.New(r.ControllerName(), mgr, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	runtimeEventHandler := &runtimeEventHandler{}
@@ -69,7 +74,7 @@ func SetupWatcherForReconcilerWithDataset(mgr ctrl.Manager, options controller.O
 	})
 	if err != nil {
 		log.Error(err, "Failed to watch JindoRuntime")
-		return err
+		return nil, err
 	}
 
 	statefulsetEventHandler := &statefulsetEventHandler{}
@@ -81,7 +86,7 @@ func SetupWatcherForReconcilerWithDataset(mgr ctrl.Manager, options controller.O
 			DeleteFunc: statefulsetEventHandler.onDeleteFunc(r),
 		})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	daemonsetEventHandler := &daemonsetEventHandler{}
@@ -93,7 +98,7 @@ func SetupWatcherForReconcilerWithDataset(mgr ctrl.Manager, options controller.O
 			DeleteFunc: daemonsetEventHandler.onDeleteFunc(r),
 		})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Watch update events on Datasets that have correlated runtime types.
@@ -109,7 +114,7 @@ func SetupWatcherForReconcilerWithDataset(mgr ctrl.Manager, options controller.O
 		})
 		if err != nil {
 			log.Error(err, "Failed to watch Dataset")
-			return err
+			return nil, err
 		}
 	}
 
@@ -186,9 +191,10 @@ func SetupWatcherForWebhook(mgr ctrl.Manager, certBuilder *webhook.CertificateBu
 	options := controller.Options{}
 	webhookName := common.WebhookName
 	options.Reconciler = &webhookReconcile.WebhookReconciler{
-		CertBuilder: certBuilder,
-		WebhookName: webhookName,
-		CaCert:      caCert,
+		CertBuilder:           certBuilder,
+		WebhookName:           webhookName,
+		ValidatingWebhookName: common.ValidatingWebhookName,
+		CaCert:                caCert,
 	}
 	webhookController, err := controller.New("webhook-controller", mgr, options)
 	if err != nil {
@@ -208,5 +214,18 @@ func SetupWatcherForWebhook(mgr ctrl.Manager, certBuilder *webhook.CertificateBu
 		return err
 	}
 
+	validatingWebhookConfigurationEventHandler := &validatingWebhookConfigurationEventHandler{}
+	err = webhookController.Watch(source.Kind(mgr.GetCache(), &admissionregistrationv1.ValidatingWebhookConfiguration{}),
+		&handler.EnqueueRequestForObject{},
+		predicate.Funcs{
+			CreateFunc: validatingWebhookConfigurationEventHandler.onCreateFunc(common.ValidatingWebhookName),
+			UpdateFunc: validatingWebhookConfigurationEventHandler.onUpdateFunc(common.ValidatingWebhookName),
+			DeleteFunc: validatingWebhookConfigurationEventHandler.onDeleteFunc(common.ValidatingWebhookName),
+		})
+	if err != nil {
+		log.Error(err, "Failed to watch validatingWebhookConfiguration")
+		return err
+	}
+
 	return
 }
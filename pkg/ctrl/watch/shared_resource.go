@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// SetupWatcherForReconcilerWithSharedResources sets up the watchers as SetupWatcherForReconcilerWithDataset does,
+// and watches the ConfigMaps and the Secrets which may be shared with the reference datasets, so that their copies
+// are updated once the sources change.
+func SetupWatcherForReconcilerWithSharedResources(mgr ctrl.Manager, options controller.Options, r Controller, runtimeType string) (err error) {
+	c, err := setupWatcherForReconcilerWithDataset(mgr, options, r, runtimeType)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range []client.Object{&corev1.ConfigMap{}, &corev1.Secret{}} {
+		// only the metadata is watched, the data is read when the copies are synced
+		metadata := &metav1.PartialObjectMetadata{}
+		gvk, err := mgr.GetClient().GroupVersionKindFor(obj)
+		if err != nil {
+			return err
+		}
+		metadata.SetGroupVersionKind(gvk)
+		err = c.Watch(source.Kind(mgr.GetCache(), metadata), EnqueueReferenceRuntimesForSharedResource(mgr.GetClient()), SharedResourcePredicate())
+		if err != nil {
+			log.Error(err, "Failed to watch the shared resources", "kind", gvk.Kind)
+			return err
+		}
+	}
+	return
+}
+
+// EnqueueReferenceRuntimesForSharedResource enqueues the runtimes of the reference datasets which reference the
+// datasets in the namespace of the changed ConfigMap or Secret. The runtime of a reference dataset has the same name
+// as the dataset.
+func EnqueueReferenceRuntimesForSharedResource(reader client.Reader) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) (requests []reconcile.Request) {
+		datasets := &datav1alpha1.DatasetList{}
+		if err := reader.List(ctx, datasets, client.InNamespace(obj.GetNamespace())); err != nil {
+			log.Error(err, "Failed to list the datasets sharing the resource", "namespace", obj.GetNamespace(), "name", obj.GetName())
+			return nil
+		}
+		for _, dataset := range datasets.Items {
+			for _, datasetRef := range dataset.Status.DatasetRef {
+				namespace, name, found := strings.Cut(datasetRef, "/")
+				if !found {
+					continue
+				}
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+			}
+		}
+		return requests
+	})
+}
+
+// SharedResourcePredicate filters out the copies of the shared resources and the events not changing the resources.
+func SharedResourcePredicate() predicate.Funcs {
+	isSource := func(obj client.Object) bool {
+		_, isCopy := obj.GetAnnotations()[common.AnnotationReferenceSharedFrom]
+		return !isCopy
+	}
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isSource(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectNew.GetResourceVersion() != e.ObjectOld.GetResourceVersion() && isSource(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+	}
+}
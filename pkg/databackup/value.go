@@ -42,6 +42,9 @@ type DataBackup struct {
 	PVCName        string `yaml:"pvcName,omitempty"`
 	Path           string `yaml:"path,omitempty"`
 	RuntimeType    string `yaml:"runtimeType,omitempty"`
+	// secret of the meta url, used by JuiceFS to dump the metadata
+	MetaUrlSecret    string `yaml:"metaurlSecret,omitempty"`
+	MetaUrlSecretKey string `yaml:"metaurlSecretKey,omitempty"`
 	// image pull secrets
 	ImagePullSecrets []corev1.LocalObjectReference `yaml:"imagePullSecrets,omitempty"`
 	Affinity         *corev1.Affinity              `yaml:"affinity,omitempty"`
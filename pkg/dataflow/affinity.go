@@ -43,8 +43,28 @@ func InjectAffinityAnnotation(opAnnotation map[string]string, podAnnotation map[
 	return copiedMap
 }
 
+// InjectAffinityByPrecedingOps injects the affinity based on all the preceding operations of the data operation,
+// the affinities generated from the preceding operations are merged. The preceding operations which didn't complete
+// are skipped, because they run on the OnFailure or Always condition or in the AnyOf mode.
+func InjectAffinityByPrecedingOps(c client.Client, operation client.Object, currentAffinity *v1.Affinity) (*v1.Affinity, error) {
+	runAfters, _ := utils.GetPrecedingOperations(operation)
+	affinity := currentAffinity
+	for i := range runAfters {
+		var err error
+		affinity, err = injectAffinityByRunAfterOp(c, &runAfters[i], operation.GetNamespace(), affinity, true)
+		if err != nil {
+			return currentAffinity, err
+		}
+	}
+	return affinity, nil
+}
+
 // InjectAffinityByRunAfterOp inject the affinity based on preceding operation
 func InjectAffinityByRunAfterOp(c client.Client, runAfter *datav1alpha1.OperationRef, opNamespace string, currentAffinity *v1.Affinity) (*v1.Affinity, error) {
+	return injectAffinityByRunAfterOp(c, runAfter, opNamespace, currentAffinity, false)
+}
+
+func injectAffinityByRunAfterOp(c client.Client, runAfter *datav1alpha1.OperationRef, opNamespace string, currentAffinity *v1.Affinity, skipIncomplete bool) (*v1.Affinity, error) {
 	// no previous operation or use default affinity strategy, no need to generate node affinity
 	if runAfter == nil || runAfter.AffinityStrategy.Policy == datav1alpha1.DefaultAffinityStrategy {
 		return currentAffinity, nil
@@ -68,6 +88,10 @@ func InjectAffinityByRunAfterOp(c client.Client, runAfter *datav1alpha1.Operatio
 
 	// ensure the dependent operation was completed, the outer caller will record the event.
 	if precedingOpStatus.Phase != common.PhaseComplete {
+		// the preceding operation itself is allowed to be incomplete, but the explicitly specified one is required
+		if skipIncomplete && runAfter.AffinityStrategy.DependOn == nil {
+			return currentAffinity, nil
+		}
 		return nil, errors.New(fmt.Sprintf("dependOn operation %s status is %s, not completed.", dependOnOp.Name, precedingOpStatus.Phase))
 	}
 
@@ -532,3 +532,92 @@ func TestInjectAffinityByRunAfterOp(t *testing.T) {
 		})
 	}
 }
+
+func TestInjectAffinityByPrecedingOps(t *testing.T) {
+	nodeAffinityOn := func(node string) *v1.NodeAffinity {
+		return &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{
+								Key:      common.K8sNodeNameLabelKey,
+								Operator: v1.NodeSelectorOpIn,
+								Values:   []string{node},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	runAfter := func(name string, policy datav1alpha1.AffinityPolicy, condition datav1alpha1.RunAfterCondition) datav1alpha1.OperationRef {
+		return datav1alpha1.OperationRef{
+			ObjectRef:        datav1alpha1.ObjectRef{Kind: "DataLoad", Name: name},
+			AffinityStrategy: datav1alpha1.AffinityStrategy{Policy: policy},
+			Condition:        condition,
+		}
+	}
+
+	objects := []runtime.Object{
+		&datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "load-a", Namespace: "default"},
+			Status:     datav1alpha1.OperationStatus{Phase: common.PhaseComplete, NodeAffinity: nodeAffinityOn("node-a")},
+		},
+		&datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "load-b", Namespace: "default"},
+			Status:     datav1alpha1.OperationStatus{Phase: common.PhaseComplete, NodeAffinity: nodeAffinityOn("node-b")},
+		},
+		&datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "load-c", Namespace: "default"},
+			Status:     datav1alpha1.OperationStatus{Phase: common.PhaseFailed},
+		},
+	}
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	c := fake.NewFakeClientWithScheme(testScheme, objects...)
+
+	dataProcess := &datav1alpha1.DataProcess{
+		ObjectMeta: metav1.ObjectMeta{Name: "process", Namespace: "default"},
+		Spec: datav1alpha1.DataProcessSpec{
+			RunAfterList: &datav1alpha1.OperationRefList{
+				Operations: []datav1alpha1.OperationRef{
+					runAfter("load-a", datav1alpha1.RequireAffinityStrategy, ""),
+					runAfter("load-b", datav1alpha1.RequireAffinityStrategy, ""),
+					runAfter("load-b", datav1alpha1.PreferAffinityStrategy, ""),
+					// the failed preceding operation is skipped
+					runAfter("load-c", datav1alpha1.RequireAffinityStrategy, datav1alpha1.OnFailureCondition),
+				},
+			},
+		},
+	}
+
+	got, err := InjectAffinityByPrecedingOps(c, dataProcess, nil)
+	if err != nil {
+		t.Fatalf("InjectAffinityByPrecedingOps() error = %v", err)
+	}
+	want := &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{
+						MatchExpressions: append(
+							nodeAffinityOn("node-a").RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions,
+							nodeAffinityOn("node-b").RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions...),
+					},
+				},
+			},
+			PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{
+				{
+					Weight: 100,
+					Preference: v1.NodeSelectorTerm{
+						MatchExpressions: nodeAffinityOn("node-b").RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions,
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("InjectAffinityByPrecedingOps() got = %v, want %v", got, want)
+	}
+}
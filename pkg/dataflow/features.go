@@ -25,12 +25,16 @@ import (
 const (
 	// DataflowAffinity Enable affinity inheritance for dataflow operations.
 	DataflowAffinity featuregate.Feature = "DataflowAffinity"
+
+	// CacheAwareAdmission Hold workloads until the cache of the dataset is prewarmed.
+	CacheAwareAdmission featuregate.Feature = "CacheAwareAdmission"
 )
 
 // defaultFeatureGates consists of all known fluid-specific feature keys.
 // To add a new feature, define a key for it above and add it here.
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-	DataflowAffinity: {Default: false, PreRelease: featuregate.Alpha},
+	DataflowAffinity:    {Default: false, PreRelease: featuregate.Alpha},
+	CacheAwareAdmission: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 func init() {
@@ -28,4 +28,7 @@ const (
 
 	DefaultSSHReadyTimeoutSeconds = 180
 	DefaultSSHPort                = 22
+
+	// EncryptOptionSecretMountPath is the directory where the secrets referred by encrypt options are mounted
+	EncryptOptionSecretMountPath = "/etc/fluid/secrets"
 )
@@ -0,0 +1,124 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// SetParallelOptions parses the parallel options of the DataMigrate, and falls back to the default ssh port
+// and ssh ready timeout if they are not specified.
+func SetParallelOptions(dataMigrateInfo *DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) error {
+	var err error
+	dataMigrateInfo.ParallelOptions = ParallelOptions{
+		SSHPort:                DefaultSSHPort,
+		SSHReadyTimeoutSeconds: DefaultSSHReadyTimeoutSeconds,
+		SSHSecretName:          dataMigrate.Spec.ParallelOptions[SSHSecretName],
+	}
+
+	sshPort, exist := dataMigrate.Spec.ParallelOptions[SSHPort]
+	if exist {
+		dataMigrateInfo.ParallelOptions.SSHPort, err = strconv.Atoi(sshPort)
+		if err != nil {
+			return errors.Wrap(err, "sshPort in the parallelOptions is not a int")
+		}
+	}
+
+	sshReadyTimeoutSeconds, exist := dataMigrate.Spec.ParallelOptions[SSHReadyTimeoutSeconds]
+	if exist {
+		dataMigrateInfo.ParallelOptions.SSHReadyTimeoutSeconds, err = strconv.Atoi(sshReadyTimeoutSeconds)
+		if err != nil {
+			return errors.Wrap(err, "sshReadyTimeoutSeconds in the parallelOptions is not a int")
+		}
+	}
+	return nil
+}
+
+// AddWorkerPodPreferredAntiAffinity makes the launcher of parallel tasks prefer to run on a different host with the workers.
+func AddWorkerPodPreferredAntiAffinity(dataMigrateInfo *DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) {
+	releaseName := utils.GetDataMigrateReleaseName(dataMigrate.Name)
+
+	podAffinityTerm := corev1.WeightedPodAffinityTerm{
+		Weight: 100,
+		PodAffinityTerm: corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", dataMigrate.Namespace, releaseName),
+				},
+			},
+			TopologyKey: common.K8sNodeNameLabelKey,
+		},
+	}
+
+	// Affinity is nil
+	if dataMigrateInfo.Affinity == nil {
+		dataMigrateInfo.Affinity = &corev1.Affinity{
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+					podAffinityTerm,
+				},
+			},
+		}
+		return
+	}
+	// Affinity not nil, PodAntiAffinity is nil
+	if dataMigrateInfo.Affinity.PodAntiAffinity == nil {
+		dataMigrateInfo.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+
+	dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution =
+		append(dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, podAffinityTerm)
+}
+
+// TransformEncryptOptionsToSecretVolumes mounts the secrets referred by the encrypt options into the DataMigrate pods
+// under /etc/fluid/secrets/<secret-name>, and returns the path of the mounted secret file for each encrypt option.
+// The values of the encrypt options never appear in the rendered values or pod specs in this way.
+func TransformEncryptOptionsToSecretVolumes(encryptOptions []datav1alpha1.EncryptOption, dataMigrateInfo *DataMigrateInfo) (secretFiles map[string]string) {
+	secretFiles = make(map[string]string, len(encryptOptions))
+	for _, encryptOpt := range encryptOptions {
+		secretName := encryptOpt.ValueFrom.SecretKeyRef.Name
+		secretMountPath := filepath.Join(EncryptOptionSecretMountPath, secretName)
+
+		volName := fmt.Sprintf("migrate-secret-%s", secretName)
+		dataMigrateInfo.SecretVolumes = utils.AppendOrOverrideVolume(dataMigrateInfo.SecretVolumes, corev1.Volume{
+			Name: volName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretName,
+				},
+			},
+		})
+		dataMigrateInfo.SecretVolumeMounts = utils.AppendOrOverrideVolumeMounts(dataMigrateInfo.SecretVolumeMounts, corev1.VolumeMount{
+			Name:      volName,
+			ReadOnly:  true,
+			MountPath: secretMountPath,
+		})
+		secretFiles[encryptOpt.Name] = filepath.Join(secretMountPath, encryptOpt.ValueFrom.SecretKeyRef.Key)
+	}
+	return secretFiles
+}
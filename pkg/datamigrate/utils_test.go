@@ -0,0 +1,366 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// TestSetParallelOptions tests the SetParallelOptions function.
+// This method is responsible for setting parallel migration options, including SSH port and SSH ready timeout.
+// The test cases cover:
+// 1. Normal scenario where parallel migration options are set correctly.
+// 2. Default values are used when no parallel options are provided.
+// 3. Error scenario where invalid parallel options are provided.
+func TestSetParallelOptions(t *testing.T) {
+	type args struct {
+		dataMigrateInfo *DataMigrateInfo
+		dataMigrate     *v1alpha1.DataMigrate
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    ParallelOptions
+		wanterr bool
+	}{
+		{
+			name: "test-parallel-migrate-options",
+			args: args{
+				dataMigrateInfo: &DataMigrateInfo{},
+				dataMigrate: &v1alpha1.DataMigrate{
+					Spec: v1alpha1.DataMigrateSpec{
+						Parallelism: 2,
+						ParallelOptions: map[string]string{
+							SSHPort:                "120",
+							SSHReadyTimeoutSeconds: "20",
+						},
+					},
+				},
+			},
+			want: ParallelOptions{
+				SSHPort:                120,
+				SSHReadyTimeoutSeconds: 20,
+			},
+			wanterr: false,
+		},
+		{
+			name: "test-parallel-migrate-options-default",
+			args: args{
+				dataMigrateInfo: &DataMigrateInfo{},
+				dataMigrate: &v1alpha1.DataMigrate{
+					Spec: v1alpha1.DataMigrateSpec{
+						Parallelism:     2,
+						ParallelOptions: map[string]string{},
+					},
+				},
+			},
+			want: ParallelOptions{
+				SSHPort:                DefaultSSHPort,
+				SSHReadyTimeoutSeconds: DefaultSSHReadyTimeoutSeconds,
+			},
+			wanterr: false,
+		},
+		{
+			name: "test-parallel-migrate-options-wrong",
+			args: args{
+				dataMigrateInfo: &DataMigrateInfo{},
+				dataMigrate: &v1alpha1.DataMigrate{
+					Spec: v1alpha1.DataMigrateSpec{
+						Parallelism: 2,
+						ParallelOptions: map[string]string{
+							SSHPort:                "120SS",
+							SSHReadyTimeoutSeconds: "20",
+						},
+					},
+				},
+			},
+			want:    ParallelOptions{},
+			wanterr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SetParallelOptions(tt.args.dataMigrateInfo, tt.args.dataMigrate)
+			if (err != nil) != tt.wanterr {
+				t.Errorf("SetParallelOptions() error = %v, wantErr %v", err, tt.wanterr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(tt.want, tt.args.dataMigrateInfo.ParallelOptions) {
+				t.Errorf("SetParallelOptions() got = %v, want %v", tt.args.dataMigrateInfo.ParallelOptions, tt.want)
+			}
+		})
+	}
+}
+
+// TestAddWorkerPodPreferredAntiAffinity tests the AddWorkerPodPreferredAntiAffinity function
+// which adds pod anti-affinity rules to DataMigrateInfo to ensure worker pods
+// are scheduled on different nodes for better availability.
+//
+// Test cases cover:
+// 1. When no affinity exists (initial case)
+// 2. When pod affinity exists but no pod anti-affinity
+// 3. When pod anti-affinity exists but no preferred scheduling terms
+// 4. When preferred anti-affinity terms already exist (should append new terms)
+//
+// Each test verifies that the function correctly adds the expected anti-affinity
+// rules while preserving any existing affinity configurations.
+// The anti-affinity rule uses the operation label and hostname topology key
+// to spread worker pods across different nodes.
+func TestAddWorkerPodPreferredAntiAffinity(t *testing.T) {
+	type args struct {
+		dataMigrateInfo *DataMigrateInfo
+		dataMigrate     *v1alpha1.DataMigrate
+	}
+	tests := []struct {
+		name string
+		args args
+		want *DataMigrateInfo
+	}{
+		{
+			name: "no affinity",
+			args: args{
+				dataMigrateInfo: &DataMigrateInfo{
+					Affinity: nil,
+				},
+				dataMigrate: &v1alpha1.DataMigrate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dataset-migrate",
+					},
+					Spec: v1alpha1.DataMigrateSpec{},
+				},
+			},
+			want: &DataMigrateInfo{
+				Affinity: &corev1.Affinity{
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{
+								Weight: 100,
+								PodAffinityTerm: corev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
+										},
+									},
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no pod anti affinity",
+			args: args{
+				dataMigrateInfo: &DataMigrateInfo{
+					Affinity: &corev1.Affinity{
+						PodAffinity: &corev1.PodAffinity{},
+					},
+				},
+				dataMigrate: &v1alpha1.DataMigrate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dataset-migrate",
+					},
+					Spec: v1alpha1.DataMigrateSpec{},
+				},
+			},
+			want: &DataMigrateInfo{
+				Affinity: &corev1.Affinity{
+					PodAffinity: &corev1.PodAffinity{},
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{
+								Weight: 100,
+								PodAffinityTerm: corev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
+										},
+									},
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no pod anti PreferredDuringSchedulingIgnoredDuringExecution ",
+			args: args{
+				dataMigrateInfo: &DataMigrateInfo{
+					Affinity: &corev1.Affinity{
+						PodAffinity:     &corev1.PodAffinity{},
+						PodAntiAffinity: &corev1.PodAntiAffinity{},
+					},
+				},
+				dataMigrate: &v1alpha1.DataMigrate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dataset-migrate",
+					},
+					Spec: v1alpha1.DataMigrateSpec{},
+				},
+			},
+			want: &DataMigrateInfo{
+				Affinity: &corev1.Affinity{
+					PodAffinity: &corev1.PodAffinity{},
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{
+								Weight: 100,
+								PodAffinityTerm: corev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
+										},
+									},
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pod anti PreferredDuringSchedulingIgnoredDuringExecution ",
+			args: args{
+				dataMigrateInfo: &DataMigrateInfo{
+					Affinity: &corev1.Affinity{
+						PodAffinity: &corev1.PodAffinity{},
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+								{
+									Weight: 100,
+									PodAffinityTerm: corev1.PodAffinityTerm{
+										LabelSelector: &metav1.LabelSelector{
+											MatchLabels: map[string]string{
+												"a": "b",
+											},
+										},
+										TopologyKey: "kubernetes.io/hostname",
+									},
+								},
+							},
+						},
+					},
+				},
+				dataMigrate: &v1alpha1.DataMigrate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dataset-migrate",
+					},
+					Spec: v1alpha1.DataMigrateSpec{},
+				},
+			},
+			want: &DataMigrateInfo{
+				Affinity: &corev1.Affinity{
+					PodAffinity: &corev1.PodAffinity{},
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{
+								Weight: 100,
+								PodAffinityTerm: corev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											"a": "b",
+										},
+									},
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+							{
+								Weight: 100,
+								PodAffinityTerm: corev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
+										},
+									},
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			AddWorkerPodPreferredAntiAffinity(tt.args.dataMigrateInfo, tt.args.dataMigrate)
+			if !reflect.DeepEqual(tt.args.dataMigrateInfo, tt.want) {
+				t.Errorf("AddWorkerPodPreferredAntiAffinity() got = %v, want %v", tt.args.dataMigrateInfo, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransformEncryptOptionsToSecretVolumes(t *testing.T) {
+	encryptOptions := []v1alpha1.EncryptOption{
+		{
+			Name: "fs.oss.accessKeyId",
+			ValueFrom: v1alpha1.EncryptOptionSource{
+				SecretKeyRef: v1alpha1.SecretKeySelector{Name: "oss-secret", Key: "ak"},
+			},
+		},
+		{
+			Name: "fs.oss.accessKeySecret",
+			ValueFrom: v1alpha1.EncryptOptionSource{
+				SecretKeyRef: v1alpha1.SecretKeySelector{Name: "oss-secret", Key: "sk"},
+			},
+		},
+	}
+
+	info := &DataMigrateInfo{}
+	secretFiles := TransformEncryptOptionsToSecretVolumes(encryptOptions, info)
+
+	wantSecretFiles := map[string]string{
+		"fs.oss.accessKeyId":     "/etc/fluid/secrets/oss-secret/ak",
+		"fs.oss.accessKeySecret": "/etc/fluid/secrets/oss-secret/sk",
+	}
+	if !reflect.DeepEqual(secretFiles, wantSecretFiles) {
+		t.Errorf("expect secret files %v, got %v", wantSecretFiles, secretFiles)
+	}
+
+	wantVolumes := []corev1.Volume{{
+		Name: "migrate-secret-oss-secret",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "oss-secret"},
+		},
+	}}
+	if !reflect.DeepEqual(info.SecretVolumes, wantVolumes) {
+		t.Errorf("expect secret volumes %v, got %v", wantVolumes, info.SecretVolumes)
+	}
+
+	wantVolumeMounts := []corev1.VolumeMount{{
+		Name:      "migrate-secret-oss-secret",
+		ReadOnly:  true,
+		MountPath: "/etc/fluid/secrets/oss-secret",
+	}}
+	if !reflect.DeepEqual(info.SecretVolumeMounts, wantVolumeMounts) {
+		t.Errorf("expect secret volume mounts %v, got %v", wantVolumeMounts, info.SecretVolumeMounts)
+	}
+}
@@ -73,6 +73,12 @@ type DataMigrateInfo struct {
 	// specifies local:// and pvc:// volume mount
 	NativeVolumeMounts []corev1.VolumeMount `json:"nativeVolumeMounts,omitempty"`
 
+	// specifies the secret volumes referred by the encrypt options of external storage
+	SecretVolumes []corev1.Volume `json:"secretVolumes,omitempty"`
+
+	// specifies the secret volume mounts referred by the encrypt options of external storage
+	SecretVolumeMounts []corev1.VolumeMount `json:"secretVolumeMounts,omitempty"`
+
 	// specifies pod affinity
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 
@@ -42,6 +42,10 @@ type OperationInterface interface {
 	// GetTargetDataset get the target dataset of the data operation, implementor should return the newest target dataset.
 	GetTargetDataset() (*datav1alpha1.Dataset, error)
 
+	// GetRelatedDatasetNamespacedNames returns the datasets involved in the data operation besides the target dataset,
+	// which are locked together with the target dataset while the data operation is executing.
+	GetRelatedDatasetNamespacedNames() []types.NamespacedName
+
 	// GetReleaseNameSpacedName get the installed helm chart name
 	GetReleaseNameSpacedName() types.NamespacedName
 
@@ -73,6 +77,9 @@ type OperationInterface interface {
 
 	// GetParallelTaskNumber get the parallel tasks for data operations.
 	GetParallelTaskNumber() int32
+
+	// GetThrottle get the throttle of the data operation, nil if the data operation is not throttled.
+	GetThrottle() *datav1alpha1.Throttle
 }
 
 type StatusHandler interface {
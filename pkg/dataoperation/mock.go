@@ -78,6 +78,10 @@ func (r *mockDataloadOperationReconciler) GetParallelTaskNumber() int32 {
 	return 1
 }
 
+func (r *mockDataloadOperationReconciler) GetThrottle() *datav1alpha1.Throttle {
+	return nil
+}
+
 // GetTargetDataset implements OperationInterface.
 func (m mockDataloadOperationReconciler) GetTargetDataset() (*datav1alpha1.Dataset, error) {
 	panic("unimplemented")
@@ -88,6 +92,11 @@ func (m mockDataloadOperationReconciler) GetPossibleTargetDatasetNamespacedNames
 	panic("unimplemented")
 }
 
+// GetRelatedDatasetNamespacedNames implements OperationInterface.
+func (m mockDataloadOperationReconciler) GetRelatedDatasetNamespacedNames() []types.NamespacedName {
+	return nil
+}
+
 // RemoveTargetDatasetStatusInProgress implements OperationInterface.
 func (mockDataloadOperationReconciler) RemoveTargetDatasetStatusInProgress(dataset *datav1alpha1.Dataset) {
 	panic("unimplemented")
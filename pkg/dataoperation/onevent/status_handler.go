@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onevent
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// StatusHandler implements the OnEvent policy shared by the data operations: it checks the job of the current run,
+// and starts a new run when any event happened after the last run finished. The state to observe and the events
+// to detect are provided by each kind of data operation.
+type StatusHandler struct {
+	client.Client
+	// Operation is the data operation object
+	Operation client.Object
+	// OperationType is the kind of the data operation, used in logs and events
+	OperationType dataoperation.OperationType
+	// ReleaseName is the name of the helm release of the data operation
+	ReleaseName string
+	// JobName is the name of the job installed by the helm release
+	JobName string
+
+	// ObserveEventState records the state of the objects watched by the data operation
+	ObserveEventState func(ctx cruntime.ReconcileRequestContext, eventStatus *datav1alpha1.EventStatus) error
+	// DetectEvents returns the events happened between the observed state and the current state
+	DetectEvents func(observed, current *datav1alpha1.EventStatus) []string
+	// SkipNodeAffinity skips generating the node affinity from the succeeded job, e.g. the job runs multiple pods
+	SkipNodeAffinity bool
+	// UpdateJobStatus records the status specific to the data operation from the job, it's optional
+	UpdateJobStatus func(result *datav1alpha1.OperationStatus, job *batchv1.Job)
+	// FinishedJobCondition returns the finished condition of the job, it's optional and defaults to the condition
+	// of the job itself
+	FinishedJobCondition func(job *batchv1.Job) (*batchv1.JobCondition, error)
+}
+
+var _ dataoperation.StatusHandler = &StatusHandler{}
+
+func (h *StatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	result = opStatus.DeepCopy()
+
+	// 1. The last run is finished, check if any event triggers a new run
+	if opStatus.Phase == common.PhaseComplete || opStatus.Phase == common.PhaseFailed {
+		return h.triggerOnEvents(ctx, result)
+	}
+
+	// 2. Check running status of the job
+	namespace := h.Operation.GetNamespace()
+	job, err := kubeclient.GetJob(h.Client, h.JobName, namespace)
+	if err != nil {
+		// helm release found but job missing, delete the helm release and requeue
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.Info("Related Job missing, will delete helm chart and retry", "namespace", namespace, "jobName", h.JobName)
+			if err = helm.DeleteReleaseIfExists(h.ReleaseName, namespace); err != nil {
+				ctx.Log.Error(err, "can't delete helm release", "operationType", h.OperationType, "namespace", namespace, "releaseName", h.ReleaseName)
+				return
+			}
+			return
+		}
+		// other error
+		ctx.Log.Error(err, "can't get job", "operationType", h.OperationType, "namespace", namespace, "jobName", h.JobName)
+		return
+	}
+
+	if result.EventStatus == nil {
+		// the first run, record the observed state for detecting events later
+		result.EventStatus = &datav1alpha1.EventStatus{}
+		if err = h.ObserveEventState(ctx, result.EventStatus); err != nil {
+			return nil, err
+		}
+		result.LastScheduleTime = job.CreationTimestamp.DeepCopy()
+	} else if result.LastScheduleTime != nil && job.CreationTimestamp.Before(result.LastScheduleTime) {
+		ctx.Log.Info("Job of the last run still exists, wait for it to be deleted", "operationType", h.OperationType, "namespace", namespace, "jobName", h.JobName)
+		return
+	}
+
+	if h.UpdateJobStatus != nil {
+		h.UpdateJobStatus(result, job)
+	}
+
+	finishedJobCondition := kubeclient.GetFinishedJobCondition(job)
+	if h.FinishedJobCondition != nil {
+		if finishedJobCondition, err = h.FinishedJobCondition(job); err != nil {
+			return nil, err
+		}
+	}
+	if finishedJobCondition == nil {
+		ctx.Log.V(1).Info("Job still running", "operationType", h.OperationType, "namespace", namespace, "jobName", h.JobName)
+		return
+	}
+	isJobSucceed := finishedJobCondition.Type == batchv1.JobComplete
+
+	// set the node labels in status when job succeed
+	if !h.SkipNodeAffinity && result.NodeAffinity == nil && isJobSucceed {
+		result.NodeAffinity, err = dataflow.GenerateNodeAffinity(job)
+		if err != nil {
+			return nil, errors.Wrap(err, "error to generate the node labels")
+		}
+	}
+
+	// job either failed or complete, update the phase status
+	result.Conditions = []datav1alpha1.Condition{
+		{
+			Type:               common.ConditionType(finishedJobCondition.Type),
+			Status:             finishedJobCondition.Status,
+			Reason:             finishedJobCondition.Reason,
+			Message:            finishedJobCondition.Message,
+			LastProbeTime:      finishedJobCondition.LastProbeTime,
+			LastTransitionTime: finishedJobCondition.LastTransitionTime,
+		},
+	}
+	if isJobSucceed {
+		result.Phase = common.PhaseComplete
+		result.LastSuccessfulTime = finishedJobCondition.LastTransitionTime.DeepCopy()
+	} else {
+		result.Phase = common.PhaseFailed
+	}
+	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
+	utils.AppendOperationRunRecord(result.EventStatus, datav1alpha1.OperationRunRecord{
+		TriggeredEvent: result.EventStatus.LastTriggeredEvent,
+		StartTime:      job.CreationTimestamp.DeepCopy(),
+		CompletionTime: finishedJobCondition.LastTransitionTime.DeepCopy(),
+		Phase:          result.Phase,
+		Duration:       result.Duration,
+	})
+
+	return
+}
+
+// triggerOnEvents compares the current state with the state observed by the last run. If any event happened,
+// the helm release of the last run is deleted and the data operation is set back to pending to start a new run.
+func (h *StatusHandler) triggerOnEvents(ctx cruntime.ReconcileRequestContext, result *datav1alpha1.OperationStatus) (*datav1alpha1.OperationStatus, error) {
+	current := &datav1alpha1.EventStatus{}
+	if err := h.ObserveEventState(ctx, current); err != nil {
+		return nil, err
+	}
+
+	if result.EventStatus == nil {
+		// the data operation never runs with OnEvent status handler, take current state as observed state
+		result.EventStatus = current
+		return result, nil
+	}
+
+	events := h.DetectEvents(result.EventStatus, current)
+	refreshObservedState(result.EventStatus, current)
+	if len(events) == 0 {
+		return result, nil
+	}
+
+	namespace := h.Operation.GetNamespace()
+	ctx.Log.Info("Events detected, will delete helm chart of the last run and start a new run", "events", events, "releaseName", h.ReleaseName)
+	if err := helm.DeleteReleaseIfExists(h.ReleaseName, namespace); err != nil {
+		ctx.Log.Error(err, "can't delete helm release", "operationType", h.OperationType, "namespace", namespace, "releaseName", h.ReleaseName)
+		return nil, err
+	}
+
+	triggeredEvent := strings.Join(events, ",")
+	result.EventStatus.LastTriggeredEvent = triggeredEvent
+	result.EventStatus.TriggeredCount++
+	result.LastScheduleTime = ptr.To(metav1.Now())
+	// the affinity and shard status will be generated again by the new run
+	result.NodeAffinity = nil
+	result.ShardStatus = nil
+	result.Phase = common.PhasePending
+	result.Duration = "-"
+
+	if ctx.Recorder != nil {
+		ctx.Recorder.Eventf(h.Operation, corev1.EventTypeNormal, common.DataOperationTriggered,
+			"%s %s is triggered by events: %s", h.OperationType, h.Operation.GetName(), triggeredEvent)
+	}
+
+	return result, nil
+}
+
+// refreshObservedState refreshes the observed state no matter whether any event happened, e.g. workers scaled in
+// or metadata sync finished. The state which is unknown currently is kept as the baseline of the next detection.
+func refreshObservedState(observed, current *datav1alpha1.EventStatus) {
+	utils.RefreshObservedDatasetState(&observed.ObservedDataset, current.ObservedDataset)
+	observed.ObservedWorkerNumberReady = current.ObservedWorkerNumberReady
+	if current.ObservedPrecedingCompletionTime != nil {
+		observed.ObservedPrecedingCompletionTime = current.ObservedPrecedingCompletionTime
+	}
+}
+
+// IsPrecedingOperationCompletedAgain checks if the preceding operation completed again after the observed completion.
+func IsPrecedingOperationCompletedAgain(observed, current *datav1alpha1.EventStatus) bool {
+	return current.ObservedPrecedingCompletionTime != nil &&
+		(observed.ObservedPrecedingCompletionTime == nil ||
+			current.ObservedPrecedingCompletionTime.After(observed.ObservedPrecedingCompletionTime.Time))
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onevent
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestRefreshObservedState(t *testing.T) {
+	completionTime := metav1.NewTime(time.Now())
+	observed := &datav1alpha1.EventStatus{
+		ObservedDataset: datav1alpha1.ObservedDatasetState{
+			UfsTotal: "20.00GiB",
+			FileNum:  "100",
+		},
+		ObservedWorkerNumberReady:       2,
+		ObservedPrecedingCompletionTime: &completionTime,
+	}
+
+	// metadata resync in progress, the ufs state and the preceding completion time are unknown
+	refreshObservedState(observed, &datav1alpha1.EventStatus{
+		ObservedDataset: datav1alpha1.ObservedDatasetState{
+			UfsTotal: "[Calculating]",
+		},
+		ObservedWorkerNumberReady: 1,
+	})
+
+	if observed.ObservedDataset.UfsTotal != "20.00GiB" || observed.ObservedDataset.FileNum != "100" {
+		t.Errorf("expect the last known ufs state kept, got %v", observed.ObservedDataset)
+	}
+	if observed.ObservedWorkerNumberReady != 1 {
+		t.Errorf("expect worker number refreshed to 1, got %d", observed.ObservedWorkerNumberReady)
+	}
+	if observed.ObservedPrecedingCompletionTime == nil || !observed.ObservedPrecedingCompletionTime.Equal(&completionTime) {
+		t.Errorf("expect the preceding completion time kept, got %v", observed.ObservedPrecedingCompletionTime)
+	}
+}
+
+func TestIsPrecedingOperationCompletedAgain(t *testing.T) {
+	earlier := metav1.NewTime(time.Now().Add(-time.Hour))
+	later := metav1.NewTime(time.Now())
+
+	testCases := map[string]struct {
+		observed *metav1.Time
+		current  *metav1.Time
+		want     bool
+	}{
+		"preceding not completed": {
+			observed: nil,
+			current:  nil,
+			want:     false,
+		},
+		"preceding completed for the first time": {
+			observed: nil,
+			current:  &later,
+			want:     true,
+		},
+		"preceding completed again": {
+			observed: &earlier,
+			current:  &later,
+			want:     true,
+		},
+		"preceding not completed again": {
+			observed: ptr.To(later),
+			current:  &later,
+			want:     false,
+		},
+	}
+
+	for name, tc := range testCases {
+		got := IsPrecedingOperationCompletedAgain(
+			&datav1alpha1.EventStatus{ObservedPrecedingCompletionTime: tc.observed},
+			&datav1alpha1.EventStatus{ObservedPrecedingCompletionTime: tc.current})
+		if got != tc.want {
+			t.Errorf("%s: expect %v, got %v", name, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataprocess
+
+import (
+	"fmt"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const datasetVolumeName = "fluid-dataset-vol"
+
+// GetDatasetRole returns the role of spec.datasets[i], which defaults to Input.
+func GetDatasetRole(dataset datav1alpha1.DataProcessDataset) datav1alpha1.DataProcessDatasetRole {
+	if len(dataset.Role) == 0 {
+		return datav1alpha1.DataProcessInputDataset
+	}
+	return dataset.Role
+}
+
+// GetRelatedDatasetNamespacedNames returns the namespaced names of spec.datasets
+func GetRelatedDatasetNamespacedNames(dataProcess *datav1alpha1.DataProcess) (namespacedNames []types.NamespacedName) {
+	for _, dataset := range dataProcess.Spec.Datasets {
+		namespacedNames = append(namespacedNames, types.NamespacedName{Namespace: dataset.Namespace, Name: dataset.Name})
+	}
+	return
+}
+
+// IsOutputDataset checks if the dataset is one of the output datasets in spec.datasets
+func IsOutputDataset(dataProcess *datav1alpha1.DataProcess, namespacedName types.NamespacedName) bool {
+	for _, dataset := range dataProcess.Spec.Datasets {
+		if dataset.Namespace == namespacedName.Namespace && dataset.Name == namespacedName.Name {
+			return GetDatasetRole(dataset) == datav1alpha1.DataProcessOutputDataset
+		}
+	}
+	return false
+}
+
+// IsTargetDatasetReadOnly checks if spec.dataset is mounted read-only. The DataProcess with OnEvent policy observes
+// the changes of spec.dataset, so it must not write spec.dataset, or each run triggers the next one.
+func IsTargetDatasetReadOnly(dataProcess *datav1alpha1.DataProcess) bool {
+	return dataProcess.Spec.Policy == datav1alpha1.OnEvent
+}
+
+// ValidateDatasets checks the datasets of the DataProcess. Each dataset in spec.datasets must be in the namespace of
+// the DataProcess, and must not be listed twice or mounted to the same path as another dataset or a volume of the processor.
+// The processor must not mount spec.dataset read-write by itself if spec.dataset is read-only.
+func ValidateDatasets(dataProcess *datav1alpha1.DataProcess) error {
+	if IsTargetDatasetReadOnly(dataProcess) {
+		for _, volume := range getProcessorVolumes(dataProcess) {
+			pvc := volume.PersistentVolumeClaim
+			if pvc != nil && pvc.ClaimName == dataProcess.Spec.Dataset.Name && !pvc.ReadOnly {
+				return fmt.Errorf("volume %s mounts spec.dataset %s read-write, which is read-only with policy %s, write the results into an output dataset in spec.datasets instead",
+					volume.Name, dataProcess.Spec.Dataset.Name, datav1alpha1.OnEvent)
+			}
+		}
+	}
+
+	if len(dataProcess.Spec.Datasets) == 0 {
+		return nil
+	}
+
+	names := map[string]bool{dataProcess.Spec.Dataset.Name: true}
+	mountPaths := map[string]bool{}
+	if len(dataProcess.Spec.Dataset.MountPath) != 0 {
+		mountPaths[filepath.Clean(dataProcess.Spec.Dataset.MountPath)] = true
+	}
+	processorImpl := GetProcessorImpl(dataProcess)
+	for i, dataset := range dataProcess.Spec.Datasets {
+		if dataset.Namespace != dataProcess.Namespace {
+			return fmt.Errorf("spec.datasets[%d] must be in the namespace %s of the DataProcess", i, dataProcess.Namespace)
+		}
+		if names[dataset.Name] {
+			return fmt.Errorf("dataset %s in spec.datasets[%d] is specified more than once", dataset.Name, i)
+		}
+		names[dataset.Name] = true
+
+		if role := GetDatasetRole(dataset); role != datav1alpha1.DataProcessInputDataset && role != datav1alpha1.DataProcessOutputDataset {
+			return fmt.Errorf("spec.datasets[%d] has unknown role %s", i, role)
+		}
+
+		if len(dataset.MountPath) == 0 {
+			return fmt.Errorf("spec.datasets[%d].mountPath must be set", i)
+		}
+		mountPath := filepath.Clean(dataset.MountPath)
+		if mountPaths[mountPath] {
+			return fmt.Errorf("spec.datasets[%d].mountPath %s is used by another dataset", i, dataset.MountPath)
+		}
+		mountPaths[mountPath] = true
+		if processorImpl != nil {
+			if ok, conflictVolName, conflictCtrName := processorImpl.ValidateDatasetMountPath(dataset.MountPath); !ok {
+				return fmt.Errorf("spec.datasets[%d].mountPath %s conflicts with volume %s mounted on container %s", i, dataset.MountPath, conflictVolName, conflictCtrName)
+			}
+		}
+	}
+	return nil
+}
+
+// genRelatedDatasetVolumes generates the volumes and the volume mounts of spec.datasets. The input datasets are mounted read-only.
+func genRelatedDatasetVolumes(dataProcess *datav1alpha1.DataProcess) (volumes []corev1.Volume, volumeMounts []corev1.VolumeMount) {
+	for i, dataset := range dataProcess.Spec.Datasets {
+		readOnly := GetDatasetRole(dataset) == datav1alpha1.DataProcessInputDataset
+		volumeName := fmt.Sprintf("%s-%d", datasetVolumeName, i+1)
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: dataset.Name,
+					ReadOnly:  readOnly,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: dataset.MountPath,
+			SubPath:   dataset.SubPath,
+			ReadOnly:  readOnly,
+		})
+	}
+	return
+}
+
+// getProcessorVolumes returns the volumes defined by the processor of the DataProcess
+func getProcessorVolumes(dataProcess *datav1alpha1.DataProcess) []corev1.Volume {
+	processor := dataProcess.Spec.Processor
+	if processor.Script != nil {
+		return processor.Script.Volumes
+	}
+	if processor.Job != nil && processor.Job.PodSpec != nil {
+		return processor.Job.PodSpec.Volumes
+	}
+	return nil
+}
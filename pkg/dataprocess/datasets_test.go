@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataprocess
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func newMultiDatasetDataProcess() *datav1alpha1.DataProcess {
+	return &datav1alpha1.DataProcess{
+		ObjectMeta: metav1.ObjectMeta{Name: "curate", Namespace: "default"},
+		Spec: datav1alpha1.DataProcessSpec{
+			Dataset: datav1alpha1.TargetDatasetWithMountPath{
+				TargetDataset: datav1alpha1.TargetDataset{Name: "raw", Namespace: "default"},
+				MountPath:     "/data/raw",
+			},
+			Datasets: []datav1alpha1.DataProcessDataset{
+				{
+					TargetDatasetWithMountPath: datav1alpha1.TargetDatasetWithMountPath{
+						TargetDataset: datav1alpha1.TargetDataset{Name: "labels", Namespace: "default"},
+						MountPath:     "/data/labels",
+					},
+				},
+				{
+					TargetDatasetWithMountPath: datav1alpha1.TargetDatasetWithMountPath{
+						TargetDataset: datav1alpha1.TargetDataset{Name: "curated", Namespace: "default"},
+						MountPath:     "/data/curated",
+						SubPath:       "v1",
+					},
+					Role: datav1alpha1.DataProcessOutputDataset,
+				},
+			},
+			Processor: datav1alpha1.Processor{Script: &datav1alpha1.ScriptProcessor{
+				VersionSpec:  datav1alpha1.VersionSpec{Image: "python", ImageTag: "3.11"},
+				Source:       "ls",
+				VolumeMounts: []corev1.VolumeMount{{Name: "cache", MountPath: "/cache"}},
+			}},
+		},
+	}
+}
+
+func TestValidateDatasets(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*datav1alpha1.DataProcess)
+		wantErr string
+	}{
+		{name: "valid", mutate: func(*datav1alpha1.DataProcess) {}},
+		{name: "no datasets", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Datasets = nil }},
+		{name: "other namespace", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Datasets[0].Namespace = "kube-system" }, wantErr: "namespace"},
+		{name: "duplicated with spec.dataset", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Datasets[1].Name = "raw" }, wantErr: "more than once"},
+		{name: "unknown role", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Datasets[1].Role = "Temp" }, wantErr: "unknown role"},
+		{name: "no mount path", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Datasets[0].MountPath = "" }, wantErr: "must be set"},
+		{name: "same mount path", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Datasets[1].MountPath = "/data/raw/" }, wantErr: "used by another dataset"},
+		{name: "conflict with processor", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Datasets[0].MountPath = "/cache" }, wantErr: "conflicts with volume cache"},
+		{name: "OnEvent", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Policy = datav1alpha1.OnEvent }},
+		{name: "OnEvent writes spec.dataset", mutate: func(p *datav1alpha1.DataProcess) {
+			p.Spec.Policy = datav1alpha1.OnEvent
+			p.Spec.Datasets = nil
+			p.Spec.Processor.Script.Volumes = []corev1.Volume{{Name: "raw", VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "raw"},
+			}}}
+		}, wantErr: "mounts spec.dataset raw read-write"},
+		{name: "OnEvent reads spec.dataset", mutate: func(p *datav1alpha1.DataProcess) {
+			p.Spec.Policy = datav1alpha1.OnEvent
+			p.Spec.Processor.Script.Volumes = []corev1.Volume{{Name: "raw", VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "raw", ReadOnly: true},
+			}}}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataProcess := newMultiDatasetDataProcess()
+			tt.mutate(dataProcess)
+			err := ValidateDatasets(dataProcess)
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("ValidateDatasets() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsOutputDataset(t *testing.T) {
+	dataProcess := newMultiDatasetDataProcess()
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "raw", want: false},
+		{name: "labels", want: false},
+		{name: "curated", want: true},
+		{name: "unknown", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsOutputDataset(dataProcess, types.NamespacedName{Namespace: "default", Name: tt.name}); got != tt.want {
+			t.Errorf("IsOutputDataset(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	want := []types.NamespacedName{{Namespace: "default", Name: "labels"}, {Namespace: "default", Name: "curated"}}
+	if got := GetRelatedDatasetNamespacedNames(dataProcess); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRelatedDatasetNamespacedNames() = %v, want %v", got, want)
+	}
+}
+
+func TestGenDataProcessValueWithDatasets(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "raw", Namespace: "default"}}
+	value := GenDataProcessValue(dataset, newMultiDatasetDataProcess())
+	script := value.DataProcessInfo.ScriptProcessor
+
+	wantVolumes := []corev1.Volume{
+		{Name: "fluid-dataset-vol", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "raw"}}},
+		{Name: "fluid-dataset-vol-1", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "labels", ReadOnly: true}}},
+		{Name: "fluid-dataset-vol-2", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "curated"}}},
+	}
+	if !reflect.DeepEqual(script.Volumes, wantVolumes) {
+		t.Errorf("expect volumes %v, got %v", wantVolumes, script.Volumes)
+	}
+	wantVolumeMounts := []corev1.VolumeMount{
+		{Name: "cache", MountPath: "/cache"},
+		{Name: "fluid-dataset-vol", MountPath: "/data/raw"},
+		{Name: "fluid-dataset-vol-1", MountPath: "/data/labels", ReadOnly: true},
+		{Name: "fluid-dataset-vol-2", MountPath: "/data/curated", SubPath: "v1"},
+	}
+	if !reflect.DeepEqual(script.VolumeMounts, wantVolumeMounts) {
+		t.Errorf("expect volume mounts %v, got %v", wantVolumeMounts, script.VolumeMounts)
+	}
+}
+
+func TestGenDataProcessValueWithOnEventPolicy(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "raw", Namespace: "default"}}
+	dataProcess := newMultiDatasetDataProcess()
+	dataProcess.Spec.Policy = datav1alpha1.OnEvent
+	script := GenDataProcessValue(dataset, dataProcess).DataProcessInfo.ScriptProcessor
+
+	if pvc := script.Volumes[0].PersistentVolumeClaim; pvc.ClaimName != "raw" || !pvc.ReadOnly {
+		t.Errorf("expect spec.dataset mounted read-only, got volume %v", script.Volumes[0])
+	}
+	if mount := script.VolumeMounts[1]; mount.Name != "fluid-dataset-vol" || !mount.ReadOnly {
+		t.Errorf("expect spec.dataset mounted read-only, got volume mount %v", mount)
+	}
+	if pvc := script.Volumes[2].PersistentVolumeClaim; pvc.ClaimName != "curated" || pvc.ReadOnly {
+		t.Errorf("expect the output dataset mounted read-write, got volume %v", script.Volumes[2])
+	}
+}
@@ -35,13 +35,13 @@ func GenDataProcessValueFile(client client.Client, dataset *datav1alpha1.Dataset
 
 	// generate the node affinity by previous operation pod.
 	if dataProcess.Spec.Processor.Job != nil {
-		affinity, err := dataflow.InjectAffinityByRunAfterOp(client, dataProcess.Spec.RunAfter, dataProcess.Namespace, dataProcess.Spec.Processor.Job.PodSpec.Affinity)
+		affinity, err := dataflow.InjectAffinityByPrecedingOps(client, dataProcess, dataProcess.Spec.Processor.Job.PodSpec.Affinity)
 		if err != nil {
 			return "", errors.Wrapf(err, "failed to inject affinity by runAfterOp")
 		}
 		dataProcessValue.DataProcessInfo.JobProcessor.PodSpec.Affinity = affinity
 	} else {
-		affinity, err := dataflow.InjectAffinityByRunAfterOp(client, dataProcess.Spec.RunAfter, dataProcess.Namespace, nil)
+		affinity, err := dataflow.InjectAffinityByPrecedingOps(client, dataProcess, nil)
 		if err != nil {
 			return "", errors.Wrapf(err, "failed to inject affinity by runAfterOp")
 		}
@@ -76,12 +76,14 @@ func GenDataProcessValue(dataset *datav1alpha1.Dataset, dataProcess *datav1alpha
 	var volumes []corev1.Volume
 	var volumeMounts []corev1.VolumeMount
 	if len(dataProcess.Spec.Dataset.MountPath) != 0 {
+		readOnly := IsTargetDatasetReadOnly(dataProcess)
 		volumes = []corev1.Volume{
 			{
-				Name: "fluid-dataset-vol",
+				Name: datasetVolumeName,
 				VolumeSource: corev1.VolumeSource{
 					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
 						ClaimName: dataset.Name,
+						ReadOnly:  readOnly,
 					},
 				},
 			},
@@ -89,18 +91,24 @@ func GenDataProcessValue(dataset *datav1alpha1.Dataset, dataProcess *datav1alpha
 
 		volumeMounts = []corev1.VolumeMount{
 			{
-				Name:      "fluid-dataset-vol",
+				Name:      datasetVolumeName,
 				MountPath: dataProcess.Spec.Dataset.MountPath,
 				SubPath:   dataProcess.Spec.Dataset.SubPath,
+				ReadOnly:  readOnly,
 			},
 		}
 	}
 
+	relatedVolumes, relatedVolumeMounts := genRelatedDatasetVolumes(dataProcess)
+	volumes = append(volumes, relatedVolumes...)
+	volumeMounts = append(volumeMounts, relatedVolumeMounts...)
+
 	transformCommonPart(value, dataProcess)
 
 	processorImpl := GetProcessorImpl(dataProcess)
 	if processorImpl != nil { // processorImpl should always be non-nil
 		processorImpl.TransformDataProcessValues(value, volumes, volumeMounts)
+		transformSharding(value, dataProcess, volumes, volumeMounts)
 		return value
 	}
 
@@ -110,6 +118,8 @@ func GenDataProcessValue(dataset *datav1alpha1.Dataset, dataProcess *datav1alpha
 
 func transformCommonPart(value *DataProcessValue, dataProcess *datav1alpha1.DataProcess) {
 	value.Name = dataProcess.Name
+	value.DataProcessInfo.Policy = string(dataProcess.Spec.Policy)
+	value.DataProcessInfo.Schedule = dataProcess.Spec.Schedule
 	value.DataProcessInfo.Labels = dataProcess.Spec.Processor.PodMetadata.Labels
 	value.DataProcessInfo.Annotations = dataflow.InjectAffinityAnnotation(dataProcess.Annotations, dataProcess.Spec.Processor.PodMetadata.Annotations)
 	value.Owner = transformer.GenerateOwnerReferenceFromObject(dataProcess)
@@ -0,0 +1,271 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataprocess
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	DefaultBackoffLimitPerShard int32 = 3
+
+	EnvShardIndex    = "FLUID_SHARD_INDEX"
+	EnvShardCount    = "FLUID_SHARD_COUNT"
+	EnvShardFileList = "FLUID_SHARD_FILE_LIST"
+
+	shardInitContainerName = "fluid-shard-init"
+	shardVolumeName        = "fluid-shard-vol"
+	shardVolumeMountPath   = "/fluid-shard"
+	shardFileList          = shardVolumeMountPath + "/files.txt"
+
+	fileListerContainerName = "fluid-shard-list"
+
+	envShardDatasetPath  = "FLUID_SHARD_DATASET_PATH"
+	envShardGlob         = "FLUID_SHARD_GLOB"
+	envShardManifest     = "FLUID_SHARD_MANIFEST"
+	envShardFileListPath = "FLUID_SHARD_FILE_LIST_PATH"
+
+	// jobCompletionIndexAnnotation is set by the Indexed Job on its pods
+	jobCompletionIndexAnnotation = "batch.kubernetes.io/job-completion-index"
+	// releaseLabel is the label of the release name set on the jobs by the chart
+	releaseLabel = "release"
+)
+
+// fileListScript lists the files of the dataset matched by the glob once for all the shards. The sorted list is
+// written into the file list path on a writable dataset, which is read by the shards as the manifest file.
+const fileListScript = `set -e
+cd "$FLUID_SHARD_DATASET_PATH"
+list="$FLUID_SHARD_FILE_LIST_PATH"
+rel="./${list#"$FLUID_SHARD_DATASET_PATH"/}"
+mkdir -p "$(dirname "$list")"
+find . -type f -path "./$FLUID_SHARD_GLOB" ! -path "$rel" ! -path "$rel.tmp" | sed 's|^\./||' | LC_ALL=C sort > "$list.tmp"
+mv "$list.tmp" "$list"
+echo "listed $(wc -l < "$list") files into $list"
+`
+
+// manifestShardScript sorts the files in the manifest file, and writes the contiguous slice of the shard into the
+// file list.
+const manifestShardScript = `set -e
+cd "$FLUID_SHARD_DATASET_PATH"
+all="$(dirname "$FLUID_SHARD_FILE_LIST")/all-files.txt"
+grep -v '^[[:space:]]*$' "$FLUID_SHARD_MANIFEST" | LC_ALL=C sort > "$all"
+total=$(wc -l < "$all")
+size=$(( (total + FLUID_SHARD_COUNT - 1) / FLUID_SHARD_COUNT ))
+start=$(( FLUID_SHARD_INDEX * size + 1 ))
+end=$(( start + size - 1 ))
+if [ "$size" -gt 0 ]; then
+  sed -n "${start},${end}p" "$all" > "$FLUID_SHARD_FILE_LIST"
+else
+  : > "$FLUID_SHARD_FILE_LIST"
+fi
+rm -f "$all"
+echo "shard $FLUID_SHARD_INDEX/$FLUID_SHARD_COUNT has $(wc -l < "$FLUID_SHARD_FILE_LIST") of $total files"
+`
+
+// ValidateSharding checks the sharding and the parallelism of the DataProcess
+func ValidateSharding(dataProcess *datav1alpha1.DataProcess) error {
+	sharding := dataProcess.Spec.Sharding
+	if sharding == nil {
+		if dataProcess.Spec.Parallelism != nil {
+			return fmt.Errorf("spec.parallelism only works with spec.sharding")
+		}
+		return nil
+	}
+	if len(dataProcess.Spec.Dataset.MountPath) == 0 {
+		return fmt.Errorf("spec.sharding requires spec.dataset.mountPath to list the files of the dataset")
+	}
+	if (len(sharding.Glob) == 0) == (len(sharding.ManifestFile) == 0) {
+		return fmt.Errorf("exactly one of spec.sharding.glob and spec.sharding.manifestFile must be set")
+	}
+	if len(sharding.Glob) != 0 && dataProcess.Spec.Policy == datav1alpha1.Cron {
+		return fmt.Errorf("spec.sharding.glob doesn't work with the Cron policy, list the files in spec.sharding.manifestFile instead")
+	}
+	if err := validateFileListPath(dataProcess); err != nil {
+		return err
+	}
+	if job := dataProcess.Spec.Processor.Job; job != nil && (job.PodSpec == nil || len(job.PodSpec.Containers) == 0) {
+		return fmt.Errorf("spec.sharding requires at least one container in spec.processor.job.podSpec")
+	}
+	return nil
+}
+
+// validateFileListPath checks that the file list path is set with the glob, and is on a dataset mounted read-write
+// so that the file lister job can write it.
+func validateFileListPath(dataProcess *datav1alpha1.DataProcess) error {
+	fileListPath := dataProcess.Spec.Sharding.FileListPath
+	if len(dataProcess.Spec.Sharding.Glob) == 0 {
+		if len(fileListPath) != 0 {
+			return fmt.Errorf("spec.sharding.fileListPath only works with spec.sharding.glob")
+		}
+		return nil
+	}
+	if len(fileListPath) == 0 {
+		return fmt.Errorf("spec.sharding.glob requires spec.sharding.fileListPath to write the file list")
+	}
+	if !filepath.IsAbs(fileListPath) {
+		return fmt.Errorf("spec.sharding.fileListPath %s must be an absolute path", fileListPath)
+	}
+
+	var writableMountPaths []string
+	if !IsTargetDatasetReadOnly(dataProcess) {
+		writableMountPaths = append(writableMountPaths, dataProcess.Spec.Dataset.MountPath)
+	}
+	for _, dataset := range dataProcess.Spec.Datasets {
+		if GetDatasetRole(dataset) == datav1alpha1.DataProcessOutputDataset {
+			writableMountPaths = append(writableMountPaths, dataset.MountPath)
+		}
+	}
+	for _, mountPath := range writableMountPaths {
+		if rel, err := filepath.Rel(filepath.Clean(mountPath), filepath.Clean(fileListPath)); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			return nil
+		}
+	}
+	return fmt.Errorf("spec.sharding.fileListPath %s must be under the mount path of a dataset mounted read-write, i.e. spec.dataset or an output dataset in spec.datasets", fileListPath)
+}
+
+// transformSharding runs the processor as an Indexed Job. An init container is injected to prepare the file list of
+// each shard, which is shared with the processor containers through an emptyDir volume. With the glob, the files are
+// listed once into the file list path by the file lister job, and the Indexed Job is suspended until the file lister
+// job completes.
+func transformSharding(value *DataProcessValue, dataProcess *datav1alpha1.DataProcess, datasetVolumes []corev1.Volume, datasetVolumeMounts []corev1.VolumeMount) {
+	sharding := dataProcess.Spec.Sharding
+	if sharding == nil {
+		return
+	}
+
+	value.DataProcessInfo.Sharding = &Sharding{
+		Shards:               sharding.Shards,
+		BackoffLimitPerIndex: DefaultBackoffLimitPerShard,
+	}
+	if sharding.BackoffLimitPerShard != nil {
+		value.DataProcessInfo.Sharding.BackoffLimitPerIndex = *sharding.BackoffLimitPerShard
+	}
+	value.DataProcessInfo.Parallelism = dataProcess.Spec.Parallelism
+
+	shardEnvs := []corev1.EnvVar{
+		{
+			Name: EnvShardIndex,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations['" + jobCompletionIndexAnnotation + "']"},
+			},
+		},
+		{Name: EnvShardCount, Value: strconv.Itoa(int(sharding.Shards))},
+		{Name: EnvShardFileList, Value: shardFileList},
+	}
+	shardVolumes := []corev1.Volume{{
+		Name:         shardVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}}
+	shardVolumeMount := corev1.VolumeMount{Name: shardVolumeName, MountPath: shardVolumeMountPath}
+	datasetPathEnv := corev1.EnvVar{Name: envShardDatasetPath, Value: filepath.Clean(dataProcess.Spec.Dataset.MountPath)}
+
+	// with the glob, the shards read the file list written by the file lister job as the manifest file
+	manifestFile := sharding.ManifestFile
+	if len(sharding.Glob) != 0 {
+		manifestFile = sharding.FileListPath
+	}
+	initContainer := corev1.Container{
+		Name:         shardInitContainerName,
+		Command:      []string{"sh", "-c", manifestShardScript},
+		Env:          append([]corev1.EnvVar{datasetPathEnv, {Name: envShardManifest, Value: manifestFile}}, shardEnvs...),
+		VolumeMounts: append([]corev1.VolumeMount{shardVolumeMount}, datasetVolumeMounts...),
+	}
+
+	if script := value.DataProcessInfo.ScriptProcessor; script != nil {
+		initContainer.Image = script.Image
+		initContainer.ImagePullPolicy = script.ImagePullPolicy
+		script.InitContainers = append([]corev1.Container{initContainer}, script.InitContainers...)
+		script.Envs = append(script.Envs, shardEnvs...)
+		script.Volumes = append(script.Volumes, shardVolumes...)
+		script.VolumeMounts = append(script.VolumeMounts, shardVolumeMount)
+	}
+
+	var imagePullSecrets []corev1.LocalObjectReference
+	if job := value.DataProcessInfo.JobProcessor; job != nil && job.PodSpec != nil && len(job.PodSpec.Containers) != 0 {
+		podSpec := job.PodSpec
+		// the file list is computed with the image of the processor, which should have a shell
+		initContainer.Image = podSpec.Containers[0].Image
+		initContainer.ImagePullPolicy = podSpec.Containers[0].ImagePullPolicy
+		imagePullSecrets = podSpec.ImagePullSecrets
+		podSpec.InitContainers = append([]corev1.Container{initContainer}, podSpec.InitContainers...)
+		for idx := range podSpec.Containers {
+			podSpec.Containers[idx].Env = append(podSpec.Containers[idx].Env, shardEnvs...)
+			podSpec.Containers[idx].VolumeMounts = append(podSpec.Containers[idx].VolumeMounts, shardVolumeMount)
+		}
+		podSpec.Volumes = append(podSpec.Volumes, shardVolumes...)
+	}
+
+	if len(sharding.Glob) != 0 {
+		value.DataProcessInfo.Sharding.FileLister = &corev1.PodSpec{
+			RestartPolicy:    corev1.RestartPolicyNever,
+			ImagePullSecrets: imagePullSecrets,
+			Containers: []corev1.Container{{
+				Name:            fileListerContainerName,
+				Image:           initContainer.Image,
+				ImagePullPolicy: initContainer.ImagePullPolicy,
+				Command:         []string{"sh", "-c", fileListScript},
+				Env: []corev1.EnvVar{
+					datasetPathEnv,
+					{Name: envShardGlob, Value: sharding.Glob},
+					{Name: envShardFileListPath, Value: filepath.Clean(sharding.FileListPath)},
+				},
+				VolumeMounts: datasetVolumeMounts,
+			}},
+			Volumes: datasetVolumes,
+		}
+	}
+}
+
+// GetFinishedJobCondition returns the finished condition of the job of the DataProcess. The suspended Indexed Job of
+// the DataProcess sharding with the glob is resumed after the file lister job completes, and the DataProcess fails
+// if the file lister job fails.
+func GetFinishedJobCondition(c client.Client, job *batchv1.Job) (*batchv1.JobCondition, error) {
+	if !ptr.Deref(job.Spec.Suspend, false) {
+		return kubeclient.GetFinishedJobCondition(job), nil
+	}
+
+	listJob, err := kubeclient.GetJob(c, utils.GetDataProcessShardListJobName(job.Labels[releaseLabel]), job.Namespace)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			// the job isn't suspended by the sharding
+			return kubeclient.GetFinishedJobCondition(job), nil
+		}
+		return nil, err
+	}
+	listCondition := kubeclient.GetFinishedJobCondition(listJob)
+	if listCondition == nil || listCondition.Type == batchv1.JobFailed {
+		return listCondition, nil
+	}
+
+	// the file list is written, all the shards start with the same list
+	resumed := job.DeepCopy()
+	resumed.Spec.Suspend = ptr.To(false)
+	return nil, kubeclient.UpdateJob(c, resumed)
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataprocess
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+func newShardingDataProcess(processor datav1alpha1.Processor) *datav1alpha1.DataProcess {
+	return &datav1alpha1.DataProcess{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-process", Namespace: "default"},
+		Spec: datav1alpha1.DataProcessSpec{
+			Dataset: datav1alpha1.TargetDatasetWithMountPath{
+				TargetDataset: datav1alpha1.TargetDataset{Name: "demo-dataset", Namespace: "default"},
+				MountPath:     "/data/",
+			},
+			Processor:   processor,
+			Sharding:    &datav1alpha1.DataProcessSharding{Shards: 4, Glob: "*.jpg", FileListPath: "/data/.fluid/files.txt"},
+			Parallelism: ptr.To[int32](2),
+		},
+	}
+}
+
+func TestValidateSharding(t *testing.T) {
+	script := datav1alpha1.Processor{Script: &datav1alpha1.ScriptProcessor{Source: "ls"}}
+	tests := []struct {
+		name    string
+		mutate  func(*datav1alpha1.DataProcess)
+		wantErr string
+	}{
+		{name: "valid", mutate: func(*datav1alpha1.DataProcess) {}},
+		{name: "no sharding", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding, p.Spec.Parallelism = nil, nil }},
+		{name: "parallelism without sharding", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding = nil }, wantErr: "only works with"},
+		{name: "no mount path", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Dataset.MountPath = "" }, wantErr: "mountPath"},
+		{name: "glob and manifest", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding.ManifestFile = "files.txt" }, wantErr: "exactly one"},
+		{name: "neither glob nor manifest", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding.Glob = "" }, wantErr: "exactly one"},
+		{name: "glob with cron", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Policy = datav1alpha1.Cron }, wantErr: "Cron policy"},
+		{name: "manifest with cron", mutate: func(p *datav1alpha1.DataProcess) {
+			p.Spec.Policy = datav1alpha1.Cron
+			p.Spec.Sharding.Glob, p.Spec.Sharding.ManifestFile, p.Spec.Sharding.FileListPath = "", "files.txt", ""
+		}},
+		{name: "manifest with file list path", mutate: func(p *datav1alpha1.DataProcess) {
+			p.Spec.Sharding.Glob, p.Spec.Sharding.ManifestFile = "", "files.txt"
+		}, wantErr: "only works with"},
+		{name: "glob without file list path", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding.FileListPath = "" }, wantErr: "requires spec.sharding.fileListPath"},
+		{name: "relative file list path", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding.FileListPath = "data/files.txt" }, wantErr: "absolute"},
+		{name: "file list path out of the datasets", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding.FileListPath = "/tmp/files.txt" }, wantErr: "read-write"},
+		{name: "file list path of the mount path", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Sharding.FileListPath = "/data" }, wantErr: "read-write"},
+		{name: "file list path on read-only dataset", mutate: func(p *datav1alpha1.DataProcess) { p.Spec.Policy = datav1alpha1.OnEvent }, wantErr: "read-write"},
+		{name: "file list path on output dataset", mutate: func(p *datav1alpha1.DataProcess) {
+			p.Spec.Policy = datav1alpha1.OnEvent
+			p.Spec.Datasets = []datav1alpha1.DataProcessDataset{
+				{TargetDatasetWithMountPath: datav1alpha1.TargetDatasetWithMountPath{TargetDataset: datav1alpha1.TargetDataset{Name: "input", Namespace: "default"}, MountPath: "/input"}},
+				{TargetDatasetWithMountPath: datav1alpha1.TargetDatasetWithMountPath{TargetDataset: datav1alpha1.TargetDataset{Name: "output", Namespace: "default"}, MountPath: "/output"}, Role: datav1alpha1.DataProcessOutputDataset},
+			}
+			p.Spec.Sharding.FileListPath = "/output/files.txt"
+		}},
+		{name: "file list path on input dataset", mutate: func(p *datav1alpha1.DataProcess) {
+			p.Spec.Datasets = []datav1alpha1.DataProcessDataset{{TargetDatasetWithMountPath: datav1alpha1.TargetDatasetWithMountPath{TargetDataset: datav1alpha1.TargetDataset{Name: "input", Namespace: "default"}, MountPath: "/input"}}}
+			p.Spec.Sharding.FileListPath = "/input/files.txt"
+		}, wantErr: "read-write"},
+		{name: "job without containers", mutate: func(p *datav1alpha1.DataProcess) {
+			p.Spec.Processor = datav1alpha1.Processor{Job: &datav1alpha1.JobProcessor{PodSpec: &corev1.PodSpec{}}}
+		}, wantErr: "at least one container"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataProcess := newShardingDataProcess(script)
+			tt.mutate(dataProcess)
+			err := ValidateSharding(dataProcess)
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("ValidateSharding() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenDataProcessValueWithSharding(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "demo-dataset", Namespace: "default"}}
+
+	checkShardEnvs := func(t *testing.T, envs []corev1.EnvVar) {
+		found := map[string]bool{}
+		for _, env := range envs {
+			found[env.Name] = true
+		}
+		for _, name := range []string{EnvShardIndex, EnvShardCount, EnvShardFileList} {
+			if !found[name] {
+				t.Errorf("expect env %s in %v", name, envs)
+			}
+		}
+	}
+	checkInitContainer := func(t *testing.T, initContainers []corev1.Container, image string, wantManifest string) {
+		if len(initContainers) == 0 || initContainers[0].Name != shardInitContainerName || initContainers[0].Image != image {
+			t.Fatalf("expect the shard init container with image %s, got %v", image, initContainers)
+		}
+		checkShardEnvs(t, initContainers[0].Env)
+		mounts := map[string]string{}
+		for _, mount := range initContainers[0].VolumeMounts {
+			mounts[mount.Name] = mount.MountPath
+		}
+		if wantMounts := map[string]string{shardVolumeName: shardVolumeMountPath, "fluid-dataset-vol": "/data/"}; !reflect.DeepEqual(mounts, wantMounts) {
+			t.Errorf("expect volume mounts %v of the init container, got %v", wantMounts, mounts)
+		}
+		for _, env := range initContainers[0].Env {
+			if env.Name == envShardDatasetPath && env.Value != "/data" || env.Name == envShardManifest && env.Value != wantManifest {
+				t.Errorf("unexpected env %v", env)
+			}
+		}
+	}
+	checkFileLister := func(t *testing.T, fileLister *corev1.PodSpec, image string) {
+		if fileLister == nil || len(fileLister.Containers) != 1 || fileLister.Containers[0].Image != image {
+			t.Fatalf("expect the file lister with image %s, got %v", image, fileLister)
+		}
+		if len(fileLister.Volumes) != 1 || fileLister.Volumes[0].PersistentVolumeClaim.ClaimName != "demo-dataset" {
+			t.Errorf("expect the file lister to mount the dataset, got %v", fileLister.Volumes)
+		}
+		for _, env := range fileLister.Containers[0].Env {
+			if env.Name == envShardDatasetPath && env.Value != "/data" || env.Name == envShardGlob && env.Value != "*.jpg" ||
+				env.Name == envShardFileListPath && env.Value != "/data/.fluid/files.txt" {
+				t.Errorf("unexpected env %v", env)
+			}
+		}
+	}
+
+	t.Run("script processor", func(t *testing.T) {
+		dataProcess := newShardingDataProcess(datav1alpha1.Processor{Script: &datav1alpha1.ScriptProcessor{
+			VersionSpec: datav1alpha1.VersionSpec{Image: "python", ImageTag: "3.11"},
+			Source:      "ls",
+		}})
+		value := GenDataProcessValue(dataset, dataProcess)
+		if value.DataProcessInfo.Sharding == nil || value.DataProcessInfo.Sharding.Shards != 4 ||
+			value.DataProcessInfo.Sharding.BackoffLimitPerIndex != DefaultBackoffLimitPerShard || *value.DataProcessInfo.Parallelism != 2 {
+			t.Errorf("unexpected sharding values %v, parallelism %v", value.DataProcessInfo.Sharding, value.DataProcessInfo.Parallelism)
+		}
+		script := value.DataProcessInfo.ScriptProcessor
+		checkInitContainer(t, script.InitContainers, "python:3.11", "/data/.fluid/files.txt")
+		checkShardEnvs(t, script.Envs)
+		if len(script.Volumes) != 2 || script.Volumes[1].Name != shardVolumeName {
+			t.Errorf("expect the shard volumes, got %v", script.Volumes)
+		}
+		checkFileLister(t, value.DataProcessInfo.Sharding.FileLister, "python:3.11")
+	})
+
+	t.Run("job processor", func(t *testing.T) {
+		dataProcess := newShardingDataProcess(datav1alpha1.Processor{Job: &datav1alpha1.JobProcessor{PodSpec: &corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main", Image: "busybox"}, {Name: "sidecar", Image: "envoy"}},
+		}}})
+		dataProcess.Spec.Sharding.BackoffLimitPerShard = ptr.To[int32](0)
+		value := GenDataProcessValue(dataset, dataProcess)
+		if value.DataProcessInfo.Sharding.BackoffLimitPerIndex != 0 {
+			t.Errorf("expect backoffLimitPerIndex 0, got %v", value.DataProcessInfo.Sharding)
+		}
+		podSpec := value.DataProcessInfo.JobProcessor.PodSpec
+		checkInitContainer(t, podSpec.InitContainers, "busybox", "/data/.fluid/files.txt")
+		for _, container := range podSpec.Containers {
+			checkShardEnvs(t, container.Env)
+		}
+		checkFileLister(t, value.DataProcessInfo.Sharding.FileLister, "busybox")
+	})
+
+	t.Run("manifest file", func(t *testing.T) {
+		dataProcess := newShardingDataProcess(datav1alpha1.Processor{Script: &datav1alpha1.ScriptProcessor{
+			VersionSpec: datav1alpha1.VersionSpec{Image: "python", ImageTag: "3.11"},
+			Source:      "ls",
+		}})
+		dataProcess.Spec.Sharding = &datav1alpha1.DataProcessSharding{Shards: 4, ManifestFile: "files.txt"}
+		value := GenDataProcessValue(dataset, dataProcess)
+		checkInitContainer(t, value.DataProcessInfo.ScriptProcessor.InitContainers, "python:3.11", "files.txt")
+		if value.DataProcessInfo.Sharding.FileLister != nil {
+			t.Errorf("expect no file lister with the manifest file, got %v", value.DataProcessInfo.Sharding.FileLister)
+		}
+	})
+}
+
+func TestGetFinishedJobCondition(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = batchv1.AddToScheme(s)
+
+	newJobs := func(listCondition *batchv1.JobCondition) (*batchv1.Job, *batchv1.Job) {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-process-processor-job", Namespace: "default", Labels: map[string]string{releaseLabel: "demo-process-processor"}},
+			Spec:       batchv1.JobSpec{Completions: ptr.To[int32](2), Suspend: ptr.To(true)},
+		}
+		listJob := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-process-processor-shard-list", Namespace: "default"},
+		}
+		if listCondition != nil {
+			listJob.Status.Conditions = []batchv1.JobCondition{*listCondition}
+		}
+		return job, listJob
+	}
+
+	t.Run("files listed", func(t *testing.T) {
+		job, listJob := newJobs(&batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue})
+		c := fake.NewFakeClientWithScheme(s, job, listJob)
+
+		condition, err := GetFinishedJobCondition(c, job)
+		if err != nil || condition != nil {
+			t.Fatalf("expect the job resumed and not finished, got %v, %v", condition, err)
+		}
+		resumed, err := kubeclient.GetJob(c, job.Name, "default")
+		if err != nil || ptr.Deref(resumed.Spec.Suspend, true) {
+			t.Errorf("expect the job resumed, got %v, %v", resumed.Spec.Suspend, err)
+		}
+	})
+
+	t.Run("files listing", func(t *testing.T) {
+		job, listJob := newJobs(nil)
+		c := fake.NewFakeClientWithScheme(s, job, listJob)
+
+		condition, err := GetFinishedJobCondition(c, job)
+		if err != nil || condition != nil {
+			t.Fatalf("expect the job not finished, got %v, %v", condition, err)
+		}
+		if suspended, err := kubeclient.GetJob(c, job.Name, "default"); err != nil || !ptr.Deref(suspended.Spec.Suspend, false) {
+			t.Errorf("expect the job suspended before the files are listed, got %v, %v", suspended.Spec.Suspend, err)
+		}
+	})
+
+	t.Run("file lister failed", func(t *testing.T) {
+		job, listJob := newJobs(&batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"})
+		c := fake.NewFakeClientWithScheme(s, job, listJob)
+
+		condition, err := GetFinishedJobCondition(c, job)
+		if err != nil || condition == nil || condition.Type != batchv1.JobFailed || condition.Reason != "BackoffLimitExceeded" {
+			t.Errorf("expect the failed condition of the file lister job, got %v, %v", condition, err)
+		}
+	})
+
+	t.Run("job not suspended", func(t *testing.T) {
+		job, _ := newJobs(nil)
+		job.Spec.Suspend = nil
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+
+		condition, err := GetFinishedJobCondition(fake.NewFakeClientWithScheme(s, job), job)
+		if err != nil || condition == nil || condition.Type != batchv1.JobComplete {
+			t.Errorf("expect the condition of the job, got %v, %v", condition, err)
+		}
+	})
+}
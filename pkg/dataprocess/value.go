@@ -32,6 +32,12 @@ type DataProcessValue struct {
 type DataProcessInfo struct {
 	TargetDataset string `json:"targetDataset,omitempty"`
 
+	// Policy for process, including Once, Cron, OnEvent
+	Policy string `json:"policy"`
+
+	// Schedule The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
+	Schedule string `json:"schedule,omitempty"`
+
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 
 	Labels map[string]string `json:"labels,omitempty"`
@@ -41,6 +47,21 @@ type DataProcessInfo struct {
 	JobProcessor *JobProcessor `json:"jobProcessor,omitempty"`
 
 	ScriptProcessor *ScriptProcessor `json:"scriptProcessor,omitempty"`
+
+	// Parallelism is the maximum number of shards processed at the same time
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
+	// Sharding runs the processor as an Indexed Job with one index per shard
+	Sharding *Sharding `json:"sharding,omitempty"`
+}
+
+type Sharding struct {
+	Shards int32 `json:"shards"`
+
+	BackoffLimitPerIndex int32 `json:"backoffLimitPerIndex"`
+
+	// FileLister is the pod of the job listing the files matched by the glob once for all the shards
+	FileLister *corev1.PodSpec `json:"fileLister,omitempty"`
 }
 
 type ScriptProcessor struct {
@@ -63,6 +84,8 @@ type ScriptProcessor struct {
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
 }
 
 type JobProcessor struct {
@@ -59,4 +59,10 @@ const (
 
 	MountConfigStorage   = "ALLUXIO_MOUNT_CONFIG_STORAGE"
 	ConfigmapStorageName = "configmap"
+
+	// defaultDataMigrateTimeout is the timeout of the distributed copy if it's not set in the options of DataMigrate
+	defaultDataMigrateTimeout = "30m"
+
+	// dataMigrateExternalMountRoot is the alluxio path under which the external storage of DataMigrate is mounted temporarily
+	dataMigrateExternalMountRoot = "/.fluid-migrate"
 )
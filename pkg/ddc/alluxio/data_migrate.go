@@ -0,0 +1,237 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// generateDataMigrateValueFile builds a DataMigrateValue by extracted specifications from the given DataMigrate, and
+// marshals the DataMigrateValue to a temporary yaml file where stores values that'll be used by fluid datamigrate helm chart.
+// The data is copied by `alluxio fs distributedCp`, and the external storage is mounted into the alluxio namespace
+// temporarily during the migration.
+func (e *AlluxioEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataMigrate, ok := object.(*datav1alpha1.DataMigrate)
+	if !ok {
+		return "", fmt.Errorf("object %v is not a DataMigrate", object)
+	}
+
+	targetDataset, err := utils.GetTargetDatasetOfMigrate(r.Client, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+	e.Log.Info("target dataset", "dataset", targetDataset)
+
+	dataMigrateInfo, err := e.genDataMigrateInfo(r, targetDataset, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	e.Log.Info("dataMigrateInfo", "info", dataMigrateInfo)
+	dataMigrateValue := cdatamigrate.DataMigrateValue{
+		Name:            dataMigrate.Name,
+		OwnerDatasetId:  utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		Owner:           transformer.GenerateOwnerReferenceFromObject(dataMigrate),
+		DataMigrateInfo: dataMigrateInfo,
+	}
+
+	data, err := yaml.Marshal(dataMigrateValue)
+	if err != nil {
+		return
+	}
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-migrate-values.yaml", dataMigrate.Namespace, dataMigrate.Name))
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return
+	}
+	return valueFile.Name(), nil
+}
+
+func (e *AlluxioEngine) genDataMigrateInfo(r cruntime.ReconcileRequestContext, targetDataset *datav1alpha1.Dataset, dataMigrate *datav1alpha1.DataMigrate) (dataMigrateInfo cdatamigrate.DataMigrateInfo, err error) {
+	if (dataMigrate.Spec.From.DataSet == nil) == (dataMigrate.Spec.To.DataSet == nil) {
+		err = fmt.Errorf("exactly one of from and to of DataMigrate %s/%s must be a dataset for AlluxioRuntime", dataMigrate.Namespace, dataMigrate.Name)
+		return
+	}
+
+	dataMigrateInfo = cdatamigrate.DataMigrateInfo{
+		BackoffLimit:     3,
+		TargetDataset:    targetDataset.Name,
+		EncryptOptions:   []datav1alpha1.EncryptOption{},
+		Image:            e.getDataMigrateImage(r, targetDataset, dataMigrate),
+		Options:          map[string]string{},
+		Labels:           dataMigrate.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataMigrate.Annotations, dataMigrate.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+		Policy:           string(dataMigrate.Spec.Policy),
+		Schedule:         dataMigrate.Spec.Schedule,
+		Resources:        dataMigrate.Spec.Resources,
+		Parallelism:      dataMigrate.Spec.Parallelism,
+		NodeSelector:     dataMigrate.Spec.NodeSelector,
+		Tolerations:      dataMigrate.Spec.Tolerations,
+		SchedulerName:    dataMigrate.Spec.SchedulerName,
+		Affinity:         dataMigrate.Spec.Affinity,
+	}
+
+	// generate ssh config for parallel tasks when using parallel tasks
+	if dataMigrateInfo.Parallelism > 1 {
+		err = cdatamigrate.SetParallelOptions(&dataMigrateInfo, dataMigrate)
+		if err != nil {
+			e.Log.Error(err, "failed to set parallel options")
+			return
+		}
+		// the launcher prefers to run on different host with the workers
+		cdatamigrate.AddWorkerPodPreferredAntiAffinity(&dataMigrateInfo, dataMigrate)
+	}
+
+	// inject the node affinity by previous operation pod.
+	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(e.Client, dataMigrate, dataMigrateInfo.Affinity)
+	if err != nil {
+		return
+	}
+
+	timeout := dataMigrate.Spec.Options["timeout"]
+	if timeout == "" {
+		timeout = defaultDataMigrateTimeout
+	}
+	dataMigrateInfo.Options["timeout"] = timeout
+	throttleOptions, err := e.genThrottleOptions(dataMigrate.Spec.Throttle)
+	if err != nil {
+		return
+	}
+	if _, found := throttleOptions[throttleBandwidth]; found {
+		e.Log.Info("distributedCp doesn't support limiting the bandwidth, ignore it", "dataMigrate", dataMigrate.Name)
+		delete(throttleOptions, throttleBandwidth)
+	}
+	// the options set explicitly take precedence over the throttle
+	dataMigrateInfo.Options["option"] = genDataMigrateCmdOptions(utils.UnionMapsWithOverride(throttleOptions, dataMigrate.Spec.Options))
+
+	dataMigrateInfo.MigrateFrom, err = e.genDataMigratePath(dataMigrate.Spec.From, dataMigrate, &dataMigrateInfo)
+	if err != nil {
+		return
+	}
+	dataMigrateInfo.MigrateTo, err = e.genDataMigratePath(dataMigrate.Spec.To, dataMigrate, &dataMigrateInfo)
+	if err != nil {
+		return
+	}
+
+	return dataMigrateInfo, nil
+}
+
+// getDataMigrateImage returns the image specified in the DataMigrate, or falls back to the image of the alluxio workers
+func (e *AlluxioEngine) getDataMigrateImage(r cruntime.ReconcileRequestContext, targetDataset *datav1alpha1.Dataset, dataMigrate *datav1alpha1.DataMigrate) string {
+	imageName, imageTag := dataMigrate.Spec.Image, dataMigrate.Spec.ImageTag
+	if len(imageName) > 0 && len(imageTag) > 0 {
+		return fmt.Sprintf("%s:%s", imageName, imageTag)
+	}
+
+	workerImageName, workerImageTag := docker.GetWorkerImage(r.Client, targetDataset.Name, "alluxio", targetDataset.Namespace)
+	if len(imageName) == 0 {
+		imageName = workerImageName
+	}
+	if len(imageTag) == 0 {
+		imageTag = workerImageTag
+	}
+
+	defaultImageInfo := strings.Split(common.DefaultAlluxioRuntimeImage, ":")
+	if len(imageName) == 0 {
+		imageName = docker.GetImageRepoFromEnv(common.AlluxioRuntimeImageEnv)
+		if len(imageName) == 0 {
+			imageName = defaultImageInfo[0]
+		}
+	}
+	if len(imageTag) == 0 {
+		imageTag = docker.GetImageTagFromEnv(common.AlluxioRuntimeImageEnv)
+		if len(imageTag) == 0 && len(defaultImageInfo) > 1 {
+			imageTag = defaultImageInfo[1]
+		}
+	}
+
+	return fmt.Sprintf("%s:%s", imageName, imageTag)
+}
+
+// genDataMigratePath converts the data to migrate to the path in the alluxio namespace. The external storage is mounted
+// under /.fluid-migrate/<datamigrate-name> by the DataMigrate job before copying, and the encrypt options are read from
+// the mounted secret files when mounting.
+func (e *AlluxioEngine) genDataMigratePath(data datav1alpha1.DataToMigrate, dataMigrate *datav1alpha1.DataMigrate, info *cdatamigrate.DataMigrateInfo) (string, error) {
+	if data.DataSet != nil {
+		if data.DataSet.Name != e.name {
+			return "", fmt.Errorf("dataset %s is not served by the AlluxioRuntime %s/%s", data.DataSet.Name, e.namespace, e.name)
+		}
+		return path.Join("/", data.DataSet.Path), nil
+	}
+
+	if data.ExternalStorage == nil {
+		return "", fmt.Errorf("neither dataset nor externalStorage is set in DataMigrate %s/%s", dataMigrate.Namespace, dataMigrate.Name)
+	}
+	if common.IsFluidNativeScheme(data.ExternalStorage.URI) {
+		return "", fmt.Errorf("the external storage %s is not supported by the DataMigrate of AlluxioRuntime", data.ExternalStorage.URI)
+	}
+
+	mountPath := path.Join(dataMigrateExternalMountRoot, dataMigrate.Name)
+	secretFiles := cdatamigrate.TransformEncryptOptionsToSecretVolumes(data.ExternalStorage.EncryptOptions, info)
+	mountOptions := make([]string, 0, len(secretFiles))
+	for key, file := range secretFiles {
+		mountOptions = append(mountOptions, fmt.Sprintf("--option %s=$(cat %s)", key, file))
+	}
+	sort.Strings(mountOptions)
+
+	info.Options["externalStorage"] = data.ExternalStorage.URI
+	info.Options["externalMountPath"] = mountPath
+	info.Options["externalMountOptions"] = strings.Join(mountOptions, " ")
+	return mountPath, nil
+}
+
+// genDataMigrateCmdOptions converts the options of DataMigrate to the flags of the copy command, except the timeout
+func genDataMigrateCmdOptions(options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		if key == "timeout" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	flags := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if options[key] != "" {
+			flags = append(flags, fmt.Sprintf("--%s=%s", key, options[key]))
+		} else {
+			flags = append(flags, fmt.Sprintf("--%s", key))
+		}
+	}
+	return strings.Join(flags, " ")
+}
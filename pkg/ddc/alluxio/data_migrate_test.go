@@ -0,0 +1,221 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestAlluxioEngine_generateDataMigrateValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataset",
+			Namespace: "fluid",
+		},
+	}
+	encryptOptions := []datav1alpha1.EncryptOption{
+		{
+			Name: "fs.oss.accessKeyId",
+			ValueFrom: datav1alpha1.EncryptOptionSource{
+				SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "oss-secret", Key: "ak"},
+			},
+		},
+		{
+			Name: "fs.oss.accessKeySecret",
+			ValueFrom: datav1alpha1.EncryptOptionSource{
+				SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "oss-secret", Key: "sk"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		spec            datav1alpha1.DataMigrateSpec
+		wantFrom        string
+		wantTo          string
+		wantOptions     map[string]string
+		wantParallel    cdatamigrate.ParallelOptions
+		wantVolumeCount int
+		wantErr         bool
+	}{
+		{
+			name: "dataset to external storage",
+			spec: datav1alpha1.DataMigrateSpec{
+				VersionSpec: datav1alpha1.VersionSpec{
+					Image:    "test-image",
+					ImageTag: "v1",
+				},
+				From: datav1alpha1.DataToMigrate{
+					DataSet: &datav1alpha1.DatasetToMigrate{Name: "test-dataset", Namespace: "fluid", Path: "/spark"},
+				},
+				To: datav1alpha1.DataToMigrate{
+					ExternalStorage: &datav1alpha1.ExternalStorage{URI: "oss://bucket/backup", EncryptOptions: encryptOptions},
+				},
+				Options: map[string]string{"active-jobs": "100", "timeout": "1h"},
+			},
+			wantFrom: "/spark",
+			wantTo:   "/.fluid-migrate/test-migrate",
+			wantOptions: map[string]string{
+				"timeout":              "1h",
+				"option":               "--active-jobs=100",
+				"externalStorage":      "oss://bucket/backup",
+				"externalMountPath":    "/.fluid-migrate/test-migrate",
+				"externalMountOptions": "--option fs.oss.accessKeyId=$(cat /etc/fluid/secrets/oss-secret/ak) --option fs.oss.accessKeySecret=$(cat /etc/fluid/secrets/oss-secret/sk)",
+			},
+			wantVolumeCount: 1,
+		},
+		{
+			name: "external storage to dataset in parallel",
+			spec: datav1alpha1.DataMigrateSpec{
+				VersionSpec: datav1alpha1.VersionSpec{
+					Image:    "test-image",
+					ImageTag: "v1",
+				},
+				From: datav1alpha1.DataToMigrate{
+					ExternalStorage: &datav1alpha1.ExternalStorage{URI: "s3://bucket/"},
+				},
+				To: datav1alpha1.DataToMigrate{
+					DataSet: &datav1alpha1.DatasetToMigrate{Name: "test-dataset", Namespace: "fluid"},
+				},
+				Parallelism: 3,
+				ParallelOptions: map[string]string{
+					cdatamigrate.SSHSecretName: "ssh-secret",
+					cdatamigrate.SSHPort:       "2222",
+				},
+			},
+			wantFrom: "/.fluid-migrate/test-migrate",
+			wantTo:   "/",
+			wantOptions: map[string]string{
+				"timeout":              defaultDataMigrateTimeout,
+				"option":               "",
+				"externalStorage":      "s3://bucket/",
+				"externalMountPath":    "/.fluid-migrate/test-migrate",
+				"externalMountOptions": "",
+			},
+			wantParallel: cdatamigrate.ParallelOptions{
+				SSHPort:                2222,
+				SSHReadyTimeoutSeconds: cdatamigrate.DefaultSSHReadyTimeoutSeconds,
+				SSHSecretName:          "ssh-secret",
+			},
+		},
+		{
+			name: "both external storage",
+			spec: datav1alpha1.DataMigrateSpec{
+				From: datav1alpha1.DataToMigrate{
+					ExternalStorage: &datav1alpha1.ExternalStorage{URI: "s3://bucket/"},
+				},
+				To: datav1alpha1.DataToMigrate{
+					ExternalStorage: &datav1alpha1.ExternalStorage{URI: "oss://bucket/"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "native external storage",
+			spec: datav1alpha1.DataMigrateSpec{
+				From: datav1alpha1.DataToMigrate{
+					DataSet: &datav1alpha1.DatasetToMigrate{Name: "test-dataset", Namespace: "fluid"},
+				},
+				To: datav1alpha1.DataToMigrate{
+					ExternalStorage: &datav1alpha1.ExternalStorage{URI: "pvc://my-pvc/path"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewFakeClientWithScheme(testScheme, dataset.DeepCopy())
+			engine := AlluxioEngine{
+				name:      "test-dataset",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+			}
+			dataMigrate := &datav1alpha1.DataMigrate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-migrate",
+					Namespace: "fluid",
+				},
+				Spec: tt.spec,
+			}
+
+			valueFileName, err := engine.generateDataMigrateValueFile(cruntime.ReconcileRequestContext{Client: client}, dataMigrate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generateDataMigrateValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value cdatamigrate.DataMigrateValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+
+			info := value.DataMigrateInfo
+			if info.Image != "test-image:v1" {
+				t.Errorf("expect image test-image:v1, got %s", info.Image)
+			}
+			if info.MigrateFrom != tt.wantFrom || info.MigrateTo != tt.wantTo {
+				t.Errorf("expect from %s to %s, got from %s to %s", tt.wantFrom, tt.wantTo, info.MigrateFrom, info.MigrateTo)
+			}
+			if !reflect.DeepEqual(info.Options, tt.wantOptions) {
+				t.Errorf("expect options %v, got %v", tt.wantOptions, info.Options)
+			}
+			if !reflect.DeepEqual(info.ParallelOptions, tt.wantParallel) {
+				t.Errorf("expect parallel options %v, got %v", tt.wantParallel, info.ParallelOptions)
+			}
+			if len(info.SecretVolumes) != tt.wantVolumeCount || len(info.SecretVolumeMounts) != tt.wantVolumeCount {
+				t.Errorf("expect %d secret volumes, got %v and %v", tt.wantVolumeCount, info.SecretVolumes, info.SecretVolumeMounts)
+			}
+			if info.Parallelism > 1 && (info.Affinity == nil || info.Affinity.PodAntiAffinity == nil) {
+				t.Errorf("expect pod anti affinity for parallel tasks, got %v", info.Affinity)
+			}
+		})
+	}
+}
+
+func TestGenDataMigrateCmdOptions(t *testing.T) {
+	options := map[string]string{
+		"timeout":     "1h",
+		"overwrite":   "",
+		"active-jobs": "100",
+	}
+	want := "--active-jobs=100 --overwrite"
+	if got := genDataMigrateCmdOptions(options); got != want {
+		t.Errorf("expect %q, got %q", want, got)
+	}
+	if got := genDataMigrateCmdOptions(nil); got != "" {
+		t.Errorf("expect empty options, got %q", got)
+	}
+}
@@ -125,7 +125,7 @@ func (e *AlluxioEngine) genDataLoadValue(image string, targetDataset *datav1alph
 
 	// inject the node affinity by previous operation pod.
 	var err error
-	dataloadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, dataload.Spec.RunAfter, dataload.Namespace, dataloadInfo.Affinity)
+	dataloadInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(e.Client, dataload, dataloadInfo.Affinity)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +151,14 @@ func (e *AlluxioEngine) genDataLoadValue(image string, targetDataset *datav1alph
 		dataloadInfo.SchedulerName = dataload.Spec.SchedulerName
 	}
 
+	// throttle
+	if dataload.Spec.Throttle != nil {
+		dataloadInfo.Options, err = e.genThrottleOptions(dataload.Spec.Throttle)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	targetPaths := []cdataload.TargetPath{}
 	for _, target := range dataload.Spec.Target {
 		fluidNative := utils.IsTargetPathUnderFluidNativeMounts(target.Path, *targetDataset)
@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetNodeCache returns the cached bytes on each node running the alluxio worker. It parses the result of
+// `alluxio fsadmin report capacity`, in which the workers are named by their IPs or hostnames.
+func (e *AlluxioEngine) GetNodeCache() (map[string]int64, error) {
+	worker2UsedCapacityMap, err := e.GetWorkerUsedCapacity()
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := labels.Parse(fmt.Sprintf("%s=true", e.runtimeInfo.GetCommonLabelName()))
+	if err != nil {
+		return nil, err
+	}
+	nodeList := &corev1.NodeList{}
+	if err = e.List(context.TODO(), nodeList, &client.ListOptions{LabelSelector: selector}); err != nil {
+		return nil, err
+	}
+
+	nodeCache := make(map[string]int64, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		nodeCache[node.Name] = lookUpUsedCapacity(node, worker2UsedCapacityMap)
+	}
+	return nodeCache, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	. "github.com/agiledragon/gomonkey/v2"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+func TestGetNodeCache(t *testing.T) {
+	runtimeInfo, err := base.BuildRuntimeInfo("spark", "default", common.AlluxioRuntime)
+	if err != nil {
+		t.Fatalf("failed to build runtime info: %v", err)
+	}
+	newNode := func(name, ip string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: v1.ObjectMeta{Name: name, Labels: labels},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}},
+			},
+		}
+	}
+	workerLabels := map[string]string{runtimeInfo.GetCommonLabelName(): "true"}
+	objects := []runtime.Object{
+		newNode("node-1", "192.168.1.147", workerLabels),
+		newNode("node-2", "192.168.1.146", workerLabels),
+		newNode("node-3", "192.168.1.148", workerLabels),
+		newNode("node-4", "192.168.1.149", nil),
+	}
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+
+	e := &AlluxioEngine{
+		name:        "spark",
+		namespace:   "default",
+		runtimeInfo: runtimeInfo,
+		Client:      fake.NewFakeClientWithScheme(s, objects...),
+		Log:         fake.NullLogger(),
+	}
+
+	patch := ApplyFunc(kubeclient.ExecCommandInContainerWithFullOutput, func(ctx context.Context, podName string, containerName string, namespace string, cmd []string) (string, string, error) {
+		return mockExecCommandInContainerForWorkerUsedCapacity()
+	})
+	defer patch.Reset()
+
+	got, err := e.GetNodeCache()
+	if err != nil {
+		t.Fatalf("AlluxioEngine.GetNodeCache() error = %v", err)
+	}
+	want := map[string]int64{"node-1": 465452400, "node-2": 0, "node-3": 0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AlluxioEngine.GetNodeCache() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import "github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+
+// GetShareableResources returns the configmap of the alluxio configurations, which the fuse depends on
+func (e *AlluxioEngine) GetShareableResources() ([]base.ShareableResource, error) {
+	return []base.ShareableResource{
+		{Kind: base.ShareableConfigMap, Name: e.name + "-config"},
+	}, nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"strconv"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const (
+	// throttleBandwidth is the --bandwidth of `alluxio fs load` in bytes per second
+	throttleBandwidth = "bandwidth"
+	// throttleActiveJobs is the --active-jobs of `alluxio fs distributedLoad` and `alluxio fs distributedCp`
+	throttleActiveJobs = "active-jobs"
+)
+
+// genThrottleOptions translates the throttle of the data operation into the options of the Alluxio commands.
+// The active jobs limit the concurrent files of all the workers, so it's the concurrent files per worker multiplied
+// by the number of workers.
+func (e *AlluxioEngine) genThrottleOptions(throttle *datav1alpha1.Throttle) (options map[string]string, err error) {
+	options = map[string]string{}
+	if throttle == nil {
+		return
+	}
+
+	if throttle.MaxBandwidth != nil {
+		options[throttleBandwidth] = strconv.FormatInt(throttle.MaxBandwidth.Value(), 10)
+	}
+
+	if throttle.MaxConcurrentFilesPerWorker > 0 {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return nil, err
+		}
+		workers := runtime.Replicas()
+		if workers < 1 {
+			workers = 1
+		}
+		options[throttleActiveJobs] = strconv.Itoa(int(throttle.MaxConcurrentFilesPerWorker * workers))
+	}
+	return
+}
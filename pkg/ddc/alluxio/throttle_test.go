@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestGenThrottleOptions(t *testing.T) {
+	runtime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+		Spec:       datav1alpha1.AlluxioRuntimeSpec{Replicas: 3},
+	}
+	engine := &AlluxioEngine{
+		name:      "hbase",
+		namespace: "fluid",
+		Client:    fake.NewFakeClientWithScheme(testScheme, runtime),
+		Log:       fake.NullLogger(),
+	}
+	bandwidth := resource.MustParse("10Mi")
+
+	tests := []struct {
+		name     string
+		throttle *datav1alpha1.Throttle
+		want     map[string]string
+	}{
+		{
+			name: "no throttle",
+			want: map[string]string{},
+		},
+		{
+			name: "bandwidth and concurrency",
+			throttle: &datav1alpha1.Throttle{
+				MaxBandwidth:                &bandwidth,
+				MaxConcurrentFilesPerWorker: 4,
+			},
+			want: map[string]string{
+				throttleBandwidth:  "10485760",
+				throttleActiveJobs: "12",
+			},
+		},
+		{
+			name:     "time window only",
+			throttle: &datav1alpha1.Throttle{Window: &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00"}},
+			want:     map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.genThrottleOptions(tt.throttle)
+			if err != nil {
+				t.Fatalf("failed to generate throttle options: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expect %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
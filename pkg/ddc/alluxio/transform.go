@@ -81,6 +81,11 @@ func (e *AlluxioEngine) transform(runtime *datav1alpha1.AlluxioRuntime) (value *
 		return
 	}
 
+	transformer.TransformSchedulingPolicies(runtime.Spec.Master.PriorityClassName, runtime.Spec.Master.TopologySpreadConstraints,
+		&value.Master.PriorityClassName, &value.Master.TopologySpreadConstraints)
+	transformer.TransformSchedulingPolicies(runtime.Spec.Worker.PriorityClassName, runtime.Spec.Worker.TopologySpreadConstraints,
+		&value.Worker.PriorityClassName, &value.Worker.TopologySpreadConstraints)
+
 	// 5.transform the hadoop non-default configurations
 	err = e.transformHadoopConfig(runtime, value)
 	if err != nil {
@@ -149,6 +149,9 @@ type Worker struct {
 	Labels           map[string]string             `json:"labels,omitempty"`
 	Annotations      map[string]string             `json:"annotations,omitempty"`
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Master struct {
@@ -172,6 +175,9 @@ type Master struct {
 	// non native mount infos when using configmap as mount storage.
 	NonNativeMounts  []string                      `json:"nonNativeMounts,omitempty"`
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Restore struct {
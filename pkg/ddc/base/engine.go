@@ -69,6 +69,8 @@ type Implement interface {
 
 	DataOperatorYamlGenerator
 
+	ShareableResourcesDeclarer
+
 	// CheckMasterReady checks if the master ready
 	CheckMasterReady() (ready bool, err error)
 
@@ -383,6 +383,21 @@ func (m *MockImplement) GetDataOperationValueFile(ctx runtime.ReconcileRequestCo
 	return ret0, ret1
 }
 
+// GetShareableResources mocks base method.
+func (m *MockImplement) GetShareableResources() ([]base.ShareableResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareableResources")
+	ret0, _ := ret[0].([]base.ShareableResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareableResources indicates an expected call of GetShareableResources.
+func (mr *MockImplementMockRecorder) GetShareableResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareableResources", reflect.TypeOf((*MockImplement)(nil).GetShareableResources))
+}
+
 // CreateVolume mocks base method.
 func (m *MockImplement) CreateVolume() error {
 	m.ctrl.T.Helper()
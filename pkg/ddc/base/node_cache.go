@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// NodeCacheReporter is implemented by the engines which can report the cached bytes of each worker node.
+// The report is published into the node cache ConfigMap of the runtime, which is used by the scheduler to
+// prefer the nodes caching more data of the datasets mounted by the pod.
+type NodeCacheReporter interface {
+	// GetNodeCache returns the cached bytes keyed by the node names
+	GetNodeCache() (map[string]int64, error)
+}
+
+// syncNodeCache publishes the cached bytes of each worker node if the engine supports reporting them.
+// It's only a hint for scheduling, so the errors are logged and ignored.
+func (t *TemplateEngine) syncNodeCache(ctx cruntime.ReconcileRequestContext) {
+	reporter, ok := t.Implement.(NodeCacheReporter)
+	if !ok || ctx.Runtime == nil {
+		return
+	}
+
+	nodeCache, err := reporter.GetNodeCache()
+	if err != nil {
+		t.Log.Info("Failed to get the node cache, skip publishing it", "err", err)
+		return
+	}
+
+	gvk, err := apiutil.GVKForObject(ctx.Runtime, t.Client.Scheme())
+	if err != nil {
+		t.Log.Info("Failed to get the kind of the runtime, skip publishing the node cache", "err", err)
+		return
+	}
+	var ownerDatasetUID string
+	if ctx.Dataset != nil {
+		ownerDatasetUID = string(ctx.Dataset.UID)
+	}
+
+	name := kubeclient.GetNodeCacheConfigMapName(ctx.Name, ctx.RuntimeType)
+	err = kubeclient.UpdateNodeCache(t.Client, name, ctx.Namespace,
+		utils.GetDatasetId(ctx.Namespace, ctx.Name, ownerDatasetUID),
+		*metav1.NewControllerRef(ctx.Runtime, gvk),
+		nodeCache)
+	if err != nil {
+		t.Log.Info("Failed to publish the node cache", "configmap", name, "err", err)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// nodeCacheReporterImpl only implements GetNodeCache, which is the only method called by syncNodeCache
+type nodeCacheReporterImpl struct {
+	Implement
+	nodeCache map[string]int64
+	err       error
+}
+
+func (r *nodeCacheReporterImpl) GetNodeCache() (map[string]int64, error) {
+	return r.nodeCache, r.err
+}
+
+func TestSyncNodeCache(t *testing.T) {
+	tests := []struct {
+		name      string
+		nodeCache map[string]int64
+		err       error
+		want      map[string]string
+	}{
+		{
+			name:      "publish node cache",
+			nodeCache: map[string]int64{"node-1": 1024, "node-2": 0},
+			want:      map[string]string{"node-1": "1024", "node-2": "0"},
+		},
+		{
+			name: "failed to get node cache",
+			err:  errors.New("failed to query workers"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runtimeObj := &datav1alpha1.AlluxioRuntime{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid", UID: "runtime-uid"},
+			}
+			dataset := &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid", UID: "dataset-uid"},
+			}
+			s := apimachineryRuntime.NewScheme()
+			_ = corev1.AddToScheme(s)
+			_ = datav1alpha1.AddToScheme(s)
+			client := fake.NewFakeClientWithScheme(s, runtimeObj, dataset)
+
+			ctx := runtime.ReconcileRequestContext{
+				Context:        context.Background(),
+				NamespacedName: types.NamespacedName{Namespace: "fluid", Name: "hbase"},
+				Client:         client,
+				Log:            fake.NullLogger(),
+				RuntimeType:    common.AlluxioRuntime,
+				Runtime:        runtimeObj,
+				Dataset:        dataset,
+			}
+			engine := NewTemplateEngine(&nodeCacheReporterImpl{nodeCache: tt.nodeCache, err: tt.err}, "test", ctx)
+			engine.syncNodeCache(ctx)
+
+			configMap := &corev1.ConfigMap{}
+			err := client.Get(context.TODO(), types.NamespacedName{
+				Namespace: "fluid",
+				Name:      kubeclient.GetNodeCacheConfigMapName("hbase", common.AlluxioRuntime),
+			}, configMap)
+			if tt.want == nil {
+				if err == nil {
+					t.Errorf("expect the node cache not to be published, got %v", configMap.Data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get node cache: %v", err)
+			}
+			if !reflect.DeepEqual(configMap.Data, tt.want) {
+				t.Errorf("expect node cache %v, got %v", tt.want, configMap.Data)
+			}
+			if owner := metav1.GetControllerOf(configMap); owner == nil || owner.UID != runtimeObj.UID || owner.Kind != "AlluxioRuntime" {
+				t.Errorf("expect the node cache to be owned by the runtime, got %v", configMap.OwnerReferences)
+			}
+			if configMap.Labels[common.LabelAnnotationDatasetId] == "" {
+				t.Errorf("expect the dataset id label, got %v", configMap.Labels)
+			}
+		})
+	}
+}
@@ -109,7 +109,33 @@ func (t *TemplateEngine) reconcilePending(ctx cruntime.ReconcileRequestContext,
 		return utils.NoRequeue()
 	}
 
-	// 2. set current data operation to dataset
+	// 2. wait for the time window of the throttle to open
+	if throttle := operation.GetThrottle(); throttle != nil && throttle.Window != nil {
+		wait, err := utils.TimeUntilWindowOpens(throttle.Window, time.Now())
+		if err != nil {
+			log.Error(err, "failed to get the time to open the window")
+			return utils.RequeueIfError(err)
+		}
+		waiting := wait > 0
+		if opStatus.WaitingFor.TimeWindow == nil || *opStatus.WaitingFor.TimeWindow != waiting {
+			opStatus.WaitingFor.TimeWindow = ptr.To(waiting)
+			if waiting {
+				object := operation.GetOperationObject()
+				ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.WaitingForTimeWindow,
+					"Waiting %v for the time window from %s to %s to open", wait.Round(time.Second), throttle.Window.Start, throttle.Window.End)
+				if err = operation.UpdateOperationApiStatus(opStatus); err != nil {
+					log.Error(err, fmt.Sprintf("failed to update the %s status", operation.GetOperationType()))
+					return utils.RequeueIfError(err)
+				}
+			}
+		}
+		if waiting {
+			log.V(1).Info("requeue after the time window opens", "wait", wait)
+			return utils.RequeueAfterInterval(wait)
+		}
+	}
+
+	// 3. set current data operation to dataset
 	err := SetDataOperationInTargetDataset(ctx, operation, t)
 	if err != nil {
 		return utils.RequeueAfterInterval(20 * time.Second)
@@ -303,6 +329,14 @@ func (t *TemplateEngine) reconcileFailed(ctx cruntime.ReconcileRequestContext, o
 		return utils.RequeueIfError(err)
 	}
 
+	// the operation skipped in the dataflow never runs, so there is no status to check
+	if utils.IsDataOperationSkipped(opStatus) {
+		if ttl != nil && *ttl > 0 {
+			return utils.RequeueAfterInterval(*ttl)
+		}
+		return utils.NoRequeue()
+	}
+
 	// 2. check and update data operation's status by helm status
 	statusHandler := operation.GetStatusHandler()
 	if statusHandler == nil {
@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -52,17 +54,34 @@ func SetDataOperationInTargetDataset(ctx cruntime.ReconcileRequestContext, opera
 		return fmt.Errorf("bounded accelerate runtime not ready")
 	}
 
+	err := setDataOperationInDataset(ctx, operation, types.NamespacedName{Namespace: targetDataset.Namespace, Name: targetDataset.Name})
+	if err != nil {
+		ctx.Log.Error(err, "can't set lock on target dataset", "targetDataset", targetDataset.Name)
+		return err
+	}
+
+	// set current data operation in the other datasets involved in the data operation
+	for _, namespacedName := range operation.GetRelatedDatasetNamespacedNames() {
+		if err = setDataOperationInDataset(ctx, operation, namespacedName); err != nil {
+			ctx.Log.Error(err, "can't set lock on related dataset", "dataset", namespacedName)
+			return err
+		}
+	}
+	return nil
+}
+
+// setDataOperationInDataset set status of the dataset to mark the data operation being performed.
+func setDataOperationInDataset(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface, namespacedName types.NamespacedName) error {
 	operationTypeName := string(operation.GetOperationType())
-	dataOpKey := getDataOperationKey(object)
+	dataOpKey := getDataOperationKey(operation.GetOperationObject())
 
-	// set current data operation in target dataset
-	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		dataset, err := utils.GetDataset(ctx.Client, targetDataset.Name, targetDataset.Namespace)
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		dataset, err := utils.GetDataset(ctx.Client, namespacedName.Name, namespacedName.Namespace)
 		if err != nil {
 			return err
 		}
 
-		// set current data operation in the target dataset
+		// set current data operation in the dataset
 		datasetToUpdate := dataset.DeepCopy()
 		datasetToUpdate.SetDataOperationInProgress(operationTypeName, dataOpKey)
 		// different operation may set other fields
@@ -70,16 +89,12 @@ func SetDataOperationInTargetDataset(ctx cruntime.ReconcileRequestContext, opera
 
 		if !reflect.DeepEqual(dataset.Status, datasetToUpdate.Status) {
 			if err := ctx.Client.Status().Update(context.TODO(), datasetToUpdate); err != nil {
-				ctx.Log.Info("fail to update target dataset's lock, will requeue", "targetDatasetName", targetDataset.Name)
+				ctx.Log.Info("fail to update dataset's lock, will requeue", "datasetName", namespacedName.Name)
 				return err
 			}
 		}
 		return nil
 	})
-	if err != nil {
-		ctx.Log.Error(err, "can't set lock on target dataset", "targetDataset", targetDataset.Name)
-	}
-	return err
 }
 
 // ReleaseTargetDataset release target dataset OperationRef field which marks the data operation being performed.
@@ -116,6 +131,47 @@ func ReleaseTargetDataset(ctx cruntime.ReconcileRequestContext, operation dataop
 	})
 	if err != nil {
 		ctx.Log.Error(err, "can't release lock on target dataset")
+		return err
+	}
+
+	for _, namespacedName := range operation.GetRelatedDatasetNamespacedNames() {
+		if err = releaseRelatedDataset(ctx, operation, namespacedName); err != nil {
+			ctx.Log.Error(err, "can't release lock on related dataset", "dataset", namespacedName)
+			return err
+		}
 	}
-	return err
+	return nil
+}
+
+// releaseRelatedDataset release the lock of the data operation on the dataset involved besides the target dataset.
+// Unlike the target dataset, the status fields are only removed when the lock is held by the data operation, so that
+// they are not removed repeatedly.
+func releaseRelatedDataset(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface, namespacedName types.NamespacedName) error {
+	dataOpKey := getDataOperationKey(operation.GetOperationObject())
+	operationTypeName := string(operation.GetOperationType())
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		dataset, err := utils.GetDataset(ctx.Client, namespacedName.Name, namespacedName.Namespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				ctx.Log.Info("can't find related dataset, won't release lock", "dataset", namespacedName)
+				return nil
+			}
+			return err
+		}
+
+		if !utils.ContainsString(strings.Split(dataset.GetDataOperationInProgress(operationTypeName), ","), dataOpKey) {
+			return nil
+		}
+
+		datasetToUpdate := dataset.DeepCopy()
+		datasetToUpdate.RemoveDataOperationInProgress(operationTypeName, dataOpKey)
+		// different operation may set other fields
+		operation.RemoveTargetDatasetStatusInProgress(datasetToUpdate)
+
+		if !reflect.DeepEqual(datasetToUpdate.Status, dataset.Status) {
+			return ctx.Client.Status().Update(context.TODO(), datasetToUpdate)
+		}
+		return nil
+	})
 }
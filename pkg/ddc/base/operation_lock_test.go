@@ -17,11 +17,19 @@ limitations under the License.
 package base
 
 import (
+	"context"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
 func TestGetDataBackupRef(t *testing.T) {
@@ -52,3 +60,105 @@ func TestGetDataBackupRef(t *testing.T) {
 		})
 	}
 }
+
+// relatedDatasetsOperation locks the related datasets besides the target dataset, and clears the ufs total
+// of the related datasets when released.
+type relatedDatasetsOperation struct {
+	dataoperation.OperationInterface
+	client  client.Client
+	object  *v1alpha1.DataProcess
+	related []types.NamespacedName
+}
+
+func (o *relatedDatasetsOperation) GetOperationObject() client.Object {
+	return o.object
+}
+
+func (o *relatedDatasetsOperation) GetOperationType() dataoperation.OperationType {
+	return dataoperation.DataProcessType
+}
+
+func (o *relatedDatasetsOperation) GetTargetDataset() (*v1alpha1.Dataset, error) {
+	return utils.GetDataset(o.client, "raw", "default")
+}
+
+func (o *relatedDatasetsOperation) GetRelatedDatasetNamespacedNames() []types.NamespacedName {
+	return o.related
+}
+
+func (o *relatedDatasetsOperation) SetTargetDatasetStatusInProgress(dataset *v1alpha1.Dataset) {}
+
+func (o *relatedDatasetsOperation) RemoveTargetDatasetStatusInProgress(dataset *v1alpha1.Dataset) {
+	dataset.Status.UfsTotal = ""
+}
+
+func TestLockRelatedDatasets(t *testing.T) {
+	var objects []runtime.Object
+	for _, name := range []string{"raw", "labels", "curated"} {
+		objects = append(objects, &v1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status: v1alpha1.DatasetStatus{
+				UfsTotal:     "1.00GiB",
+				OperationRef: map[string]string{"DataProcess": "other"},
+			},
+		})
+	}
+	s := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s, objects...)
+	ctx := cruntime.ReconcileRequestContext{Context: context.TODO(), Client: c, Log: fake.NullLogger()}
+	operation := &relatedDatasetsOperation{
+		client: c,
+		object: &v1alpha1.DataProcess{ObjectMeta: metav1.ObjectMeta{Name: "curate", Namespace: "default"}},
+		related: []types.NamespacedName{
+			{Namespace: "default", Name: "labels"},
+			{Namespace: "default", Name: "curated"},
+			{Namespace: "default", Name: "deleted"},
+		},
+	}
+
+	getDataset := func(name string) *v1alpha1.Dataset {
+		dataset, err := utils.GetDataset(c, name, "default")
+		if err != nil {
+			t.Fatalf("failed to get dataset %s: %v", name, err)
+		}
+		return dataset
+	}
+
+	for _, name := range []string{"raw", "labels", "curated"} {
+		if err := setDataOperationInDataset(ctx, operation, types.NamespacedName{Namespace: "default", Name: name}); err != nil {
+			t.Fatalf("failed to lock dataset %s: %v", name, err)
+		}
+		if got := getDataset(name).GetDataOperationInProgress("DataProcess"); got != "other,curate" {
+			t.Errorf("expect dataset %s locked by other,curate, got %s", name, got)
+		}
+	}
+
+	// the related dataset which is not found is skipped
+	if err := ReleaseTargetDataset(ctx, operation); err != nil {
+		t.Fatalf("failed to release datasets: %v", err)
+	}
+	for _, name := range []string{"raw", "labels", "curated"} {
+		dataset := getDataset(name)
+		if got := dataset.GetDataOperationInProgress("DataProcess"); got != "other" {
+			t.Errorf("expect dataset %s locked by other, got %s", name, got)
+		}
+		// the target dataset is still locked by other DataProcess, so its status is kept
+		if wantUfsTotal := map[string]string{"raw": "1.00GiB"}[name]; dataset.Status.UfsTotal != wantUfsTotal {
+			t.Errorf("expect ufsTotal %q of dataset %s, got %q", wantUfsTotal, name, dataset.Status.UfsTotal)
+		}
+	}
+
+	// the status of the related datasets is not changed again once the lock is released
+	dataset := getDataset("curated")
+	dataset.Status.UfsTotal = "2.00GiB"
+	if err := c.Status().Update(context.TODO(), dataset); err != nil {
+		t.Fatalf("failed to update dataset: %v", err)
+	}
+	if err := ReleaseTargetDataset(ctx, operation); err != nil {
+		t.Fatalf("failed to release datasets: %v", err)
+	}
+	if got := getDataset("curated").Status.UfsTotal; got != "2.00GiB" {
+		t.Errorf("expect ufsTotal of the released dataset unchanged, got %q", got)
+	}
+}
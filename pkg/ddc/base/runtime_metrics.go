@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// checkAndUpdateRuntimeStatus checks and updates the runtime status, and then exposes the cache states and
+// the ready workers and fuses in the updated status as the metrics of the runtime.
+func (t *TemplateEngine) checkAndUpdateRuntimeStatus(ctx cruntime.ReconcileRequestContext) (ready bool, err error) {
+	ready, err = t.Implement.CheckAndUpdateRuntimeStatus()
+	if err != nil {
+		return
+	}
+
+	if ctx.Runtime == nil {
+		return
+	}
+	runtime, ok := ctx.Runtime.DeepCopyObject().(RuntimeInterface)
+	if !ok {
+		return
+	}
+	// the runtime in the context is not updated by the engine, get the latest one
+	if getErr := t.Client.Get(context.TODO(), types.NamespacedName{Namespace: ctx.Namespace, Name: ctx.Name}, runtime); getErr != nil {
+		t.Log.V(1).Info("failed to get the runtime to update metrics", "error", getErr)
+		return
+	}
+	updateRuntimeMetrics(ctx.Runtime, runtime.GetStatus())
+	return
+}
+
+func updateRuntimeMetrics(runtime client.Object, status *datav1alpha1.RuntimeStatus) {
+	if status == nil {
+		return
+	}
+
+	m := metrics.GetOrCreateRuntimeMetrics(runtime.GetObjectKind().GroupVersionKind().Kind, runtime.GetNamespace(), runtime.GetName())
+	m.SetWorkersReady(float64(status.WorkerNumberReady))
+	m.SetFusesReady(float64(status.FuseNumberReady))
+
+	// the cache states are human readable strings, skip the ones not reported by the runtime
+	if cached, err := utils.FromHumanSize(status.CacheStates[common.Cached]); err == nil {
+		m.SetCachedBytes(float64(cached))
+	}
+	if capacity, err := utils.FromHumanSize(status.CacheStates[common.CacheCapacity]); err == nil {
+		m.SetCacheCapacityBytes(float64(capacity))
+	}
+	if percentage, err := parsePercentage(status.CacheStates[common.CachedPercentage]); err == nil {
+		m.SetCachedPercentage(percentage)
+	}
+	if hitRatio, err := parsePercentage(status.CacheStates[common.CacheHitRatio]); err == nil {
+		m.SetCacheHitRatio(hitRatio)
+	}
+}
+
+// parsePercentage parses percentages like "12.5%" in the cache states
+func parsePercentage(percentage string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(percentage, "%")), 64)
+}
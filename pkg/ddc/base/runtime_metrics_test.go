@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func gatherRuntimeGauge(t *testing.T, name, runtime string) (value float64, found bool) {
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "runtime" && label.GetValue() == runtime {
+					return metric.GetGauge().GetValue(), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+func TestUpdateRuntimeMetrics(t *testing.T) {
+	runtime := &datav1alpha1.AlluxioRuntime{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "AlluxioRuntime",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+	}
+	status := &datav1alpha1.RuntimeStatus{
+		WorkerNumberReady: 2,
+		FuseNumberReady:   3,
+		CacheStates: common.CacheStateList{
+			common.Cached:           "1.00GiB",
+			common.CacheCapacity:    "4.00GiB",
+			common.CachedPercentage: "25.0%",
+			common.CacheHitRatio:    "N/A",
+		},
+	}
+
+	updateRuntimeMetrics(runtime, status)
+
+	wants := map[string]float64{
+		"runtime_workers_ready":        2,
+		"runtime_fuses_ready":          3,
+		"runtime_cached_bytes":         1 << 30,
+		"runtime_cache_capacity_bytes": 4 << 30,
+		"runtime_cached_percentage":    25,
+	}
+	for name, want := range wants {
+		got, found := gatherRuntimeGauge(t, name, "fluid/hbase")
+		if !found || got != want {
+			t.Errorf("expect metric %s to be %v, got %v (found: %v)", name, want, got, found)
+		}
+	}
+	if _, found := gatherRuntimeGauge(t, "runtime_cache_hit_ratio_percentage", "fluid/hbase"); found {
+		t.Errorf("expect unknown cache hit ratio not to be reported")
+	}
+}
+
+func TestParsePercentage(t *testing.T) {
+	tests := []struct {
+		percentage string
+		want       float64
+		wantErr    bool
+	}{
+		{percentage: "12.5%", want: 12.5},
+		{percentage: "0%", want: 0},
+		{percentage: "100.0 %", want: 100},
+		{percentage: "", wantErr: true},
+		{percentage: "N/A", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePercentage(tt.percentage)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parsePercentage(%q) error = %v, wantErr %v", tt.percentage, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("parsePercentage(%q) = %v, want %v", tt.percentage, got, tt.want)
+		}
+	}
+}
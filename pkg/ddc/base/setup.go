@@ -104,7 +104,7 @@ func (b *TemplateEngine) Setup(ctx cruntime.ReconcileRequestContext) (ready bool
 	}
 
 	// 5.Check if the runtime is ready
-	runtimeReady, err := b.Implement.CheckAndUpdateRuntimeStatus()
+	runtimeReady, err := b.checkAndUpdateRuntimeStatus(ctx)
 	if err != nil {
 		// b.Log.Error(err, "Check if the runtime is ready")
 		_ = b.loggingErrorExceptConflict(err, "Failed to check if the runtime is ready")
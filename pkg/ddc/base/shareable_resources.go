@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+// ShareableResourceKind is the kind of the resource shared with the reference datasets.
+type ShareableResourceKind string
+
+const (
+	ShareableConfigMap ShareableResourceKind = "ConfigMap"
+	ShareableSecret    ShareableResourceKind = "Secret"
+)
+
+// ShareableResource is a resource in the namespace of the runtime which the fuse of the runtime depends on.
+// It's copied to the namespaces of the reference datasets mounting the dataset of the runtime, so that the fuse
+// can also run in these namespaces.
+type ShareableResource struct {
+	Kind ShareableResourceKind
+	Name string
+}
+
+// ShareableResourcesDeclarer declares the resources of the runtime shared with the reference datasets.
+type ShareableResourcesDeclarer interface {
+	// GetShareableResources returns the resources to share. The Secrets referenced by the fuse pods are always shared,
+	// so they don't have to be declared. An error is returned if the runtime doesn't support reference datasets.
+	GetShareableResources() ([]ShareableResource, error)
+}
@@ -69,7 +69,7 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 
 	// 4. Update runtime status
 	if permitSyncEngineStatus {
-		_, err = t.Implement.CheckAndUpdateRuntimeStatus()
+		_, err = t.checkAndUpdateRuntimeStatus(ctx)
 		if err != nil {
 			return
 		}
@@ -80,6 +80,9 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 	if err != nil {
 		return
 	}
+	if permitSyncEngineStatus {
+		t.syncNodeCache(ctx)
+	}
 
 	// 6. Update dataset mount point
 	if permitSyncEngineStatus {
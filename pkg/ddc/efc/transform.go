@@ -78,6 +78,17 @@ func (e *EFCEngine) transform(runtime *datav1alpha1.EFCRuntime) (value *EFC, err
 		return
 	}
 
+	err = transformer.TransformUnstructuredSchedulingPolicies(runtime.Spec.Master.PriorityClassName, runtime.Spec.Master.TopologySpreadConstraints,
+		&value.Master.PriorityClassName, &value.Master.TopologySpreadConstraints)
+	if err != nil {
+		return
+	}
+	err = transformer.TransformUnstructuredSchedulingPolicies(runtime.Spec.Worker.PriorityClassName, runtime.Spec.Worker.TopologySpreadConstraints,
+		&value.Worker.PriorityClassName, &value.Worker.TopologySpreadConstraints)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -56,6 +56,9 @@ type Master struct {
 	TieredStore      TieredStore       `yaml:"tieredstore,omitempty"`
 	Labels           map[string]string `yaml:"labels,omitempty"`
 	Annotations      map[string]string `yaml:"annotations,omitempty"`
+
+	PriorityClassName         string                   `yaml:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []map[string]interface{} `yaml:"topologySpreadConstraints,omitempty"`
 }
 
 type Worker struct {
@@ -69,6 +72,9 @@ type Worker struct {
 	TieredStore      TieredStore       `yaml:"tieredstore,omitempty"`
 	Labels           map[string]string `yaml:"labels,omitempty"`
 	Annotations      map[string]string `yaml:"annotations,omitempty"`
+
+	PriorityClassName         string                   `yaml:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []map[string]interface{} `yaml:"topologySpreadConstraints,omitempty"`
 }
 
 type Fuse struct {
@@ -26,9 +26,13 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindofsx"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/thin"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/thin/referencedataset"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/vineyard"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	jindoutils "github.com/fluid-cloudnative/fluid/pkg/utils/jindo"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"fmt"
 )
@@ -49,6 +53,9 @@ func init() {
 		common.EFCEngineImpl:        efc.Build,
 		common.VineyardEngineImpl:   vineyard.Build,
 	}
+
+	// data operations on the reference dataset are forwarded to the engine of the physical dataset
+	referencedataset.RegisterPhysicalEngineFactory(GetRuntimeObjectAndEngineImpl, CreateEngine)
 }
 
 // CreateEngine chooses one engine implementation according to `ctx.EngineImpl` and builds a concrete engine.
@@ -90,3 +97,55 @@ func InferEngineImpl(runtimeStatus fluidv1alpha1.RuntimeStatus, defaultImpl stri
 
 	return defaultImpl
 }
+
+// GetRuntimeObjectAndEngineImpl firstly gets a runtime object given its name, namespace and runtimeType, and then infer its engine implementation
+// from the retrieved runtime object.
+func GetRuntimeObjectAndEngineImpl(client client.Client, runtimeType, name, namespace string) (obj client.Object, engineImpl string, err error) {
+	// support all runtime
+	var runtime base.RuntimeInterface
+	switch runtimeType {
+	case common.AlluxioRuntime:
+		runtime, err = utils.GetAlluxioRuntime(client, name, namespace)
+	case common.JindoRuntime:
+		runtime, err = utils.GetJindoRuntime(client, name, namespace)
+	case common.GooseFSRuntime:
+		runtime, err = utils.GetGooseFSRuntime(client, name, namespace)
+	case common.JuiceFSRuntime:
+		runtime, err = utils.GetJuiceFSRuntime(client, name, namespace)
+	case common.EFCRuntime:
+		runtime, err = utils.GetEFCRuntime(client, name, namespace)
+	case common.ThinRuntime:
+		runtime, err = utils.GetThinRuntime(client, name, namespace)
+	case common.VineyardRuntime:
+		runtime, err = utils.GetVineyardRuntime(client, name, namespace)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if runtimeType == common.ThinRuntime {
+		// ThinRuntime cannot use InferEngineImpl because ReferenceDatasetEngine inherits valueFile property
+		// from its physical runtime.
+		// TODO: We should determine whether ReferenceDatasetEngine is an engineImpl of ThinRuntime.
+		return runtime, common.ThinEngineImpl, nil
+	}
+
+	switch runtimeType {
+	case common.AlluxioRuntime:
+		return runtime, InferEngineImpl(*runtime.GetStatus(), common.AlluxioEngineImpl), nil
+	case common.JindoRuntime:
+		return runtime, InferEngineImpl(*runtime.GetStatus(), jindoutils.GetDefaultEngineImpl()), nil
+	case common.GooseFSRuntime:
+		return runtime, InferEngineImpl(*runtime.GetStatus(), common.GooseFSEngineImpl), nil
+	case common.JuiceFSRuntime:
+		return runtime, InferEngineImpl(*runtime.GetStatus(), common.JuiceFSEngineImpl), nil
+	case common.EFCRuntime:
+		return runtime, InferEngineImpl(*runtime.GetStatus(), common.EFCEngineImpl), nil
+	case common.VineyardRuntime:
+		return runtime, InferEngineImpl(*runtime.GetStatus(), common.VineyardEngineImpl), nil
+	}
+
+	err = fmt.Errorf("runtimeType %s is not supported", runtimeType)
+	return
+}
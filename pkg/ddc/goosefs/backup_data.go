@@ -134,7 +134,7 @@ func (e *GooseFSEngine) generateDataBackupValueFile(ctx cruntime.ReconcileReques
 	dataBackup.Path = path
 
 	// inject the node affinity by previous operation pod.
-	dataBackup.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, databackup.Spec.RunAfter, databackup.Namespace, nil)
+	dataBackup.Affinity, err = dataflow.InjectAffinityByPrecedingOps(e.Client, databackup, nil)
 	if err != nil {
 		return "", err
 	}
@@ -120,7 +120,7 @@ func (e *GooseFSEngine) genDataLoadValue(image string, targetDataset *datav1alph
 
 	// inject the node affinity by previous operation pod.
 	var err error
-	dataloadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, dataload.Spec.RunAfter, dataload.Namespace, dataloadInfo.Affinity)
+	dataloadInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(e.Client, dataload, dataloadInfo.Affinity)
 	if err != nil {
 		return nil, err
 	}
@@ -16,9 +16,24 @@ limitations under the License.
 
 package goosefs
 
-import cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+import (
+	"k8s.io/apimachinery/pkg/types"
+
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
 
 // SyncRuntime syncs the runtime spec
 func (e *GooseFSEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (changed bool, err error) {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+
+	err = e.Helper.SyncPodDisruptionBudget(types.NamespacedName{Namespace: e.namespace, Name: e.getMasterName()}, runtime.Spec.Master.PodDisruptionBudget)
+	if err != nil {
+		return
+	}
+
+	err = e.Helper.SyncPodDisruptionBudget(types.NamespacedName{Namespace: e.namespace, Name: e.getWorkerName()}, runtime.Spec.Worker.PodDisruptionBudget)
 	return
 }
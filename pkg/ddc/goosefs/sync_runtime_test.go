@@ -17,72 +17,79 @@ limitations under the License.
 package goosefs
 
 import (
+	"context"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
 func TestGooseFSEngine_SyncRuntime(t *testing.T) {
-	type fields struct {
-		// runtime                *datav1alpha1.GooseFSRuntime
-		// name                   string
-		// namespace              string
-		// runtimeType            string
-		// Log                    logr.Logger
-		// Client                 client.Client
-		// gracefulShutdownLimits int32
-		// retryShutdown          int32
-		// initImage              string
-		// MetadataSyncDoneCh     chan MetadataSyncResult
-		// runtimeInfo            base.RuntimeInfoInterface
-		// UnitTest               bool
-		// lastCacheHitStates     *cacheHitStates
-		// Helper                 *ctrl.Helper
-		// Recorder               record.EventRecorder
-	}
-	type args struct {
-		ctx cruntime.ReconcileRequestContext
+	minAvailable := intstr.FromInt32(1)
+	runtimeObj := &datav1alpha1.GooseFSRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.GooseFSRuntimeSpec{
+			Master: datav1alpha1.GooseFSCompTemplateSpec{
+				PodDisruptionBudget: &datav1alpha1.PodDisruptionBudgetSpec{MinAvailable: &minAvailable},
+			},
+			Worker: datav1alpha1.GooseFSCompTemplateSpec{
+				PodDisruptionBudget: &datav1alpha1.PodDisruptionBudgetSpec{},
+			},
+		},
 	}
-	tests := []struct {
-		name        string
-		fields      fields
-		args        args
-		wantChanged bool
-		wantErr     bool
-	}{
-		{
-			name:        "default",
-			wantChanged: false,
-			wantErr:     false,
+	workerSts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-worker",
+			Namespace: "fluid",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"release": "hbase"}},
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			e := &GooseFSEngine{
-				// runtime:                tt.fields.runtime,
-				// name:                   tt.fields.name,
-				// namespace:              tt.fields.namespace,
-				// runtimeType:            tt.fields.runtimeType,
-				// Log:                    tt.fields.Log,
-				// Client:                 tt.fields.Client,
-				// gracefulShutdownLimits: tt.fields.gracefulShutdownLimits,
-				// retryShutdown:          tt.fields.retryShutdown,
-				// initImage:              tt.fields.initImage,
-				// MetadataSyncDoneCh:     tt.fields.MetadataSyncDoneCh,
-				// runtimeInfo:            tt.fields.runtimeInfo,
-				// UnitTest:               tt.fields.UnitTest,
-				// lastCacheHitStates:     tt.fields.lastCacheHitStates,
-				// Helper:                 tt.fields.Helper,
-				// Recorder:               tt.fields.Recorder,
-			}
-			gotChanged, err := e.SyncRuntime(tt.args.ctx)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GooseFSEngine.SyncRuntime() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if gotChanged != tt.wantChanged {
-				t.Errorf("GooseFSEngine.SyncRuntime() = %v, want %v", gotChanged, tt.wantChanged)
-			}
-		})
+
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	_ = policyv1.AddToScheme(s)
+	client := fake.NewFakeClientWithScheme(s, runtimeObj, workerSts)
+
+	engine := &GooseFSEngine{
+		name:      "hbase",
+		namespace: "fluid",
+		Client:    client,
+		Log:       fake.NullLogger(),
+		Helper:    ctrl.BuildHelper(nil, client, fake.NullLogger()),
+	}
+	changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{})
+	if err != nil || changed {
+		t.Fatalf("GooseFSEngine.SyncRuntime() = %v, %v, want false, nil", changed, err)
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-worker"}, pdb)
+	if err != nil {
+		t.Fatalf("expect PodDisruptionBudget of the workers created, got %v", err)
+	}
+	if pdb.Spec.MaxUnavailable == nil || pdb.Spec.MaxUnavailable.IntValue() != 1 {
+		t.Errorf("expect default maxUnavailable 1, got %v", pdb.Spec.MaxUnavailable)
+	}
+
+	// the master statefulset doesn't exist, so its PodDisruptionBudget is skipped
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-master"}, pdb)
+	if !apierrs.IsNotFound(err) {
+		t.Errorf("expect PodDisruptionBudget of the master not found, got %v", err)
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/tieredstore"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
 )
 
 func (e *GooseFSEngine) transform(runtime *datav1alpha1.GooseFSRuntime) (value *GooseFS, err error) {
@@ -100,9 +101,21 @@ func (e *GooseFSEngine) transform(runtime *datav1alpha1.GooseFSRuntime) (value *
 
 	// 12.set API Gateway
 	err = e.transformAPIGateway(runtime, value)
+	if err != nil {
+		return
+	}
 
 	// 13.set the placementMode
 	e.transformPlacementMode(dataset, value)
+
+	// 14.transform the scheduling policies
+	err = transformer.TransformUnstructuredSchedulingPolicies(runtime.Spec.Master.PriorityClassName, runtime.Spec.Master.TopologySpreadConstraints,
+		&value.Master.PriorityClassName, &value.Master.TopologySpreadConstraints)
+	if err != nil {
+		return
+	}
+	err = transformer.TransformUnstructuredSchedulingPolicies(runtime.Spec.Worker.PriorityClassName, runtime.Spec.Worker.TopologySpreadConstraints,
+		&value.Worker.PriorityClassName, &value.Worker.TopologySpreadConstraints)
 	return
 }
 
@@ -138,6 +138,9 @@ type Worker struct {
 	Resources    common.Resources  `yaml:"resources,omitempty"`
 	Ports        Ports             `yaml:"ports,omitempty"`
 	Annotations  map[string]string `json:"annotations,omitempty"`
+
+	PriorityClassName         string                   `yaml:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []map[string]interface{} `yaml:"topologySpreadConstraints,omitempty"`
 }
 
 type Master struct {
@@ -153,6 +156,9 @@ type Master struct {
 	BackupPath   string            `yaml:"backupPath,omitempty"`
 	Restore      Restore           `yaml:"restore,omitempty"`
 	Annotations  map[string]string `json:"annotations,omitempty"`
+
+	PriorityClassName         string                   `yaml:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []map[string]interface{} `yaml:"topologySpreadConstraints,omitempty"`
 }
 
 type Restore struct {
@@ -17,69 +17,79 @@ limitations under the License.
 package jindo
 
 import (
+	"context"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
 func TestJindoEngine_SyncRuntime(t *testing.T) {
-	type fields struct {
-		// runtime                *datav1alpha1.JindoRuntime
-		// name                   string
-		// namespace              string
-		// runtimeType            string
-		// Log                    logr.Logger
-		// Client                 client.Client
-		// gracefulShutdownLimits int32
-		// retryShutdown          int32
-		// runtimeInfo            base.RuntimeInfoInterface
-		// MetadataSyncDoneCh     chan MetadataSyncResult
-		// cacheNodeNames         []string
-		// Recorder               record.EventRecorder
-		// Helper                 *ctrl.Helper
-	}
-	type args struct {
-		ctx cruntime.ReconcileRequestContext
+	minAvailable := intstr.FromInt32(1)
+	runtimeObj := &datav1alpha1.JindoRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.JindoRuntimeSpec{
+			Master: datav1alpha1.JindoCompTemplateSpec{
+				PodDisruptionBudget: &datav1alpha1.PodDisruptionBudgetSpec{MinAvailable: &minAvailable},
+			},
+			Worker: datav1alpha1.JindoCompTemplateSpec{
+				PodDisruptionBudget: &datav1alpha1.PodDisruptionBudgetSpec{},
+			},
+		},
 	}
-	tests := []struct {
-		name        string
-		fields      fields
-		args        args
-		wantChanged bool
-		wantErr     bool
-	}{
-		// TODO: Add test cases.
-		{
-			name:        "default",
-			wantChanged: false,
-			wantErr:     false,
+	workerSts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-jindofs-worker",
+			Namespace: "fluid",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"release": "hbase"}},
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			e := &JindoEngine{
-				// runtime:                tt.fields.runtime,
-				// name:                   tt.fields.name,
-				// namespace:              tt.fields.namespace,
-				// runtimeType:            tt.fields.runtimeType,
-				// Log:                    tt.fields.Log,
-				// Client:                 tt.fields.Client,
-				// gracefulShutdownLimits: tt.fields.gracefulShutdownLimits,
-				// retryShutdown:          tt.fields.retryShutdown,
-				// runtimeInfo:            tt.fields.runtimeInfo,
-				// MetadataSyncDoneCh:     tt.fields.MetadataSyncDoneCh,
-				// cacheNodeNames:         tt.fields.cacheNodeNames,
-				// Recorder:               tt.fields.Recorder,
-				// Helper:                 tt.fields.Helper,
-			}
-			gotChanged, err := e.SyncRuntime(tt.args.ctx)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("JindoEngine.SyncRuntime() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if gotChanged != tt.wantChanged {
-				t.Errorf("JindoEngine.SyncRuntime() = %v, want %v", gotChanged, tt.wantChanged)
-			}
-		})
+
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	_ = policyv1.AddToScheme(s)
+	client := fake.NewFakeClientWithScheme(s, runtimeObj, workerSts)
+
+	engine := &JindoEngine{
+		name:      "hbase",
+		namespace: "fluid",
+		Client:    client,
+		Log:       fake.NullLogger(),
+		Helper:    ctrl.BuildHelper(nil, client, fake.NullLogger()),
+	}
+	changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{})
+	if err != nil || changed {
+		t.Fatalf("JindoEngine.SyncRuntime() = %v, %v, want false, nil", changed, err)
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-jindofs-worker"}, pdb)
+	if err != nil {
+		t.Fatalf("expect PodDisruptionBudget of the workers created, got %v", err)
+	}
+	if pdb.Spec.MaxUnavailable == nil || pdb.Spec.MaxUnavailable.IntValue() != 1 {
+		t.Errorf("expect default maxUnavailable 1, got %v", pdb.Spec.MaxUnavailable)
+	}
+
+	// the master statefulset doesn't exist, so its PodDisruptionBudget is skipped
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-jindofs-master"}, pdb)
+	if !apierrs.IsNotFound(err) {
+		t.Errorf("expect PodDisruptionBudget of the master not found, got %v", err)
 	}
 }
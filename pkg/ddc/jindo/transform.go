@@ -170,6 +170,10 @@ func (e *JindoEngine) transform(runtime *datav1alpha1.JindoRuntime) (value *Jind
 		return
 	}
 	e.transformTolerations(dataset, runtime, value)
+	transformer.TransformSchedulingPolicies(runtime.Spec.Master.PriorityClassName, runtime.Spec.Master.TopologySpreadConstraints,
+		&value.Master.PriorityClassName, &value.Master.TopologySpreadConstraints)
+	transformer.TransformSchedulingPolicies(runtime.Spec.Worker.PriorityClassName, runtime.Spec.Worker.TopologySpreadConstraints,
+		&value.Worker.PriorityClassName, &value.Worker.TopologySpreadConstraints)
 	e.transformResources(runtime, value)
 	e.transformLogConfig(runtime, value)
 	value.Master.DnsServer = dnsServer
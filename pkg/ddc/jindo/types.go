@@ -70,6 +70,9 @@ type Master struct {
 	NameSpace        string                    `yaml:"namespace,omitempty"`
 	Labels           map[string]string         `yaml:"labels,omitempty"`
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	PriorityClassName         string                        `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Worker struct {
@@ -81,6 +84,9 @@ type Worker struct {
 	// Affinity         v1.Affinity       `yaml:"affinity,omitempty"`
 	Labels           map[string]string         `yaml:"labels,omitempty"`
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	PriorityClassName         string                        `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Ports struct {
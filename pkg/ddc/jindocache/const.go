@@ -51,6 +51,9 @@ const (
 
 	defaultJindofsxRuntimeImage = "registry.cn-shanghai.aliyuncs.com/jindofs/smartdata:6.2.0"
 
+	// defaultDataMigrateTimeout is the timeout of the distributed copy if it's not set in the options of DataMigrate
+	defaultDataMigrateTimeout = "30m"
+
 	FuseOnly = "fuseOnly"
 
 	defaultMemLimit = 100
@@ -132,7 +132,7 @@ func (e *JindoCacheEngine) genDataLoadValue(image string, runtime *datav1alpha1.
 
 	// inject the node affinity by previous operation pod.
 	var err error
-	dataloadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, dataload.Spec.RunAfter, dataload.Namespace, dataloadInfo.Affinity)
+	dataloadInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(e.Client, dataload, dataloadInfo.Affinity)
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +177,10 @@ func (e *JindoCacheEngine) genDataLoadValue(image string, runtime *datav1alpha1.
 	if hadoopConfig != "" {
 		options["hdfsConfig"] = hadoopConfig
 	}
+	// the options set explicitly take precedence over the throttle
+	for key, value := range e.genDataLoadThrottleOptions(dataload.Spec.Throttle) {
+		options[key] = value
+	}
 	// resolve spec options
 	if dataload.Spec.Options != nil {
 		for key, value := range dataload.Spec.Options {
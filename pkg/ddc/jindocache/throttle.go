@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindocache
+
+import (
+	"strconv"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// genDataLoadThrottleOptions translates the throttle of the DataLoad into the options of the dataloader chart.
+// The concurrency is the -thread of `jindocache -load`, and the bandwidth is not supported.
+func (e *JindoCacheEngine) genDataLoadThrottleOptions(throttle *datav1alpha1.Throttle) map[string]string {
+	options := map[string]string{}
+	if throttle == nil {
+		return options
+	}
+	if throttle.MaxBandwidth != nil {
+		e.Log.Info("jindocache -load doesn't support limiting the bandwidth, ignore it")
+	}
+	if throttle.MaxConcurrentFilesPerWorker > 0 {
+		options["threadNum"] = strconv.Itoa(int(throttle.MaxConcurrentFilesPerWorker))
+	}
+	return options
+}
+
+// genDataMigrateThrottleOptions translates the throttle of the DataMigrate into the options of `jindo distcp`, i.e.
+// --bandWidth in MB per second and --parallelism.
+func genDataMigrateThrottleOptions(throttle *datav1alpha1.Throttle) map[string]string {
+	options := map[string]string{}
+	if throttle == nil {
+		return options
+	}
+	if throttle.MaxBandwidth != nil {
+		bandwidth := throttle.MaxBandwidth.Value() >> 20
+		if bandwidth < 1 {
+			bandwidth = 1
+		}
+		options["bandWidth"] = strconv.FormatInt(bandwidth, 10)
+	}
+	if throttle.MaxConcurrentFilesPerWorker > 0 {
+		options["parallelism"] = strconv.Itoa(int(throttle.MaxConcurrentFilesPerWorker))
+	}
+	return options
+}
@@ -186,6 +186,10 @@ func (e *JindoCacheEngine) transform(runtime *datav1alpha1.JindoRuntime) (value
 	e.transformPlacementMode(dataset, value)
 	e.transformRunAsUser(runtime, value)
 	e.transformTolerations(dataset, runtime, value)
+	transformer.TransformSchedulingPolicies(runtime.Spec.Master.PriorityClassName, runtime.Spec.Master.TopologySpreadConstraints,
+		&value.Master.PriorityClassName, &value.Master.TopologySpreadConstraints)
+	transformer.TransformSchedulingPolicies(runtime.Spec.Worker.PriorityClassName, runtime.Spec.Worker.TopologySpreadConstraints,
+		&value.Worker.PriorityClassName, &value.Worker.TopologySpreadConstraints)
 	err = e.transformResources(runtime, value, userQuotas)
 	if err != nil {
 		return
@@ -83,6 +83,9 @@ type Master struct {
 	VolumeMounts        []corev1.VolumeMount          `json:"volumeMounts,omitempty"`
 	Volumes             []corev1.Volume               `json:"volumes,omitempty"`
 	ImagePullSecrets    []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Worker struct {
@@ -98,6 +101,9 @@ type Worker struct {
 	Path             string                        `json:"dataPath"`
 	Env              map[string]string             `json:"env,omitempty"`
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Ports struct {
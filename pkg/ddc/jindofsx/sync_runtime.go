@@ -43,6 +43,16 @@ func (e *JindoFSxEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (chan
 		return changed, err
 	}
 
+	// 0. sync PodDisruptionBudgets
+	err = e.Helper.SyncPodDisruptionBudget(types.NamespacedName{Namespace: e.namespace, Name: e.getMasterName()}, runtime.Spec.Master.PodDisruptionBudget)
+	if err != nil {
+		return
+	}
+	err = e.Helper.SyncPodDisruptionBudget(types.NamespacedName{Namespace: e.namespace, Name: e.getWorkerName()}, runtime.Spec.Worker.PodDisruptionBudget)
+	if err != nil {
+		return
+	}
+
 	// 1. sync master
 	masterChanged, err := e.syncMasterSpec(ctx, runtime)
 	if err != nil {
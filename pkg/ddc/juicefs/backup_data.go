@@ -0,0 +1,192 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+)
+
+// generateDataBackupValueFile builds a DataBackupValue by extracted specifications from the given DataBackup, and
+// marshals the DataBackupValue to a temporary yaml file where stores values that'll be used by fluid dataBackup helm chart.
+// The metadata of the JuiceFS community edition is dumped from the metadata engine, and the enterprise edition is not supported
+// because its metadata is managed by the JuiceFS console.
+func (j *JuiceFSEngine) generateDataBackupValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	databackup, ok := object.(*datav1alpha1.DataBackup)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataBackup", object)
+		return "", err
+	}
+
+	dataset, err := utils.GetDataset(j.Client, databackup.Spec.Dataset, databackup.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	fsInfo, err := GetFSInfoFromConfigMap(j.Client, databackup.Spec.Dataset, databackup.Namespace)
+	if err != nil {
+		return "", err
+	}
+	if fsInfo[Edition] != CommunityEdition {
+		err = fmt.Errorf("DataBackup is only supported by the community edition of JuiceFS, but the edition of dataset %s/%s is %s",
+			databackup.Namespace, databackup.Spec.Dataset, fsInfo[Edition])
+		return "", err
+	}
+
+	runtime, err := j.getRuntime()
+	if err != nil {
+		return "", err
+	}
+
+	imageName, imageTag, _, err := j.parseJuiceFSImage(CommunityEdition, runtime.Spec.JuiceFSVersion.Image, runtime.Spec.JuiceFSVersion.ImageTag, runtime.Spec.JuiceFSVersion.ImagePullPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	pvcName, path, err := utils.ParseBackupRestorePath(databackup.Spec.BackupPath)
+	if err != nil {
+		return "", err
+	}
+
+	dataBackup := cdatabackup.DataBackup{
+		Namespace:        databackup.Namespace,
+		Dataset:          databackup.Spec.Dataset,
+		OwnerDatasetId:   utils.GetDatasetId(dataset.Namespace, dataset.Name, string(dataset.UID)),
+		Name:             databackup.Name,
+		Image:            fmt.Sprintf("%s:%s", imageName, imageTag),
+		PVCName:          pvcName,
+		Path:             path,
+		RuntimeType:      common.JuiceFSRuntime,
+		MetaUrlSecret:    fsInfo[MetaurlSecret],
+		MetaUrlSecretKey: fsInfo[MetaurlSecretKey],
+		ImagePullSecrets: docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+	}
+
+	// inject the node affinity by previous operation pod.
+	dataBackup.Affinity, err = dataflow.InjectAffinityByPrecedingOps(j.Client, databackup, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// the metadata engine is accessed by root user
+	dataBackupValue := cdatabackup.DataBackupValue{
+		DataBackup: dataBackup,
+		UserInfo: common.UserInfo{
+			User:  0,
+			Group: 0,
+		},
+	}
+
+	data, err := yaml.Marshal(dataBackupValue)
+	if err != nil {
+		return
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-%s-backuper-values.yaml", databackup.Namespace, databackup.Name, dataBackup.RuntimeType))
+	if err != nil {
+		return
+	}
+
+	err = os.WriteFile(valueFile.Name(), data, 0400)
+	if err != nil {
+		return
+	}
+
+	return valueFile.Name(), nil
+}
+
+// transformRestore loads the metadata dumped by DataBackup when the worker starts if the dataset indicates a restore location.
+// The dump is mounted into the workers. The first worker loads it into the metadata engine if the volume is not formatted yet,
+// and the other workers wait for it before formatting.
+func (j *JuiceFSEngine) transformRestore(dataset *datav1alpha1.Dataset, value *JuiceFS) {
+	if dataset.Spec.DataRestoreLocation == nil || dataset.Spec.DataRestoreLocation.Path == "" {
+		return
+	}
+
+	if value.Edition != CommunityEdition {
+		j.Log.Info("restoring metadata is only supported by the community edition of JuiceFS, will not restore",
+			"Location", dataset.Spec.DataRestoreLocation)
+		return
+	}
+
+	pvcName, path, err := utils.ParseBackupRestorePath(dataset.Spec.DataRestoreLocation.Path)
+	if err != nil {
+		j.Log.Error(err, "restore path cannot analyse", "Path", dataset.Spec.DataRestoreLocation.Path)
+		return
+	}
+
+	var volume corev1.Volume
+	var restoreFile string
+	if pvcName != "" {
+		// RestorePath is in the form of pvc://<pvcName>/subpath
+		volume = corev1.Volume{
+			Name: MetadataRestoreVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvcName,
+					ReadOnly:  true,
+				},
+			},
+		}
+		restoreFile = MetadataRestoreMountPath + path + j.GetMetadataFileName()
+	} else if dataset.Spec.DataRestoreLocation.NodeName != "" {
+		// RestorePath is in the form of local://subpath
+		hostPathType := corev1.HostPathDirectory
+		volume = corev1.Volume{
+			Name: MetadataRestoreVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: path,
+					Type: &hostPathType,
+				},
+			},
+		}
+		restoreFile = MetadataRestoreMountPath + "/" + j.GetMetadataFileName()
+		if len(value.Worker.NodeSelector) == 0 {
+			value.Worker.NodeSelector = map[string]string{}
+		}
+		value.Worker.NodeSelector[common.K8sNodeNameLabelKey] = dataset.Spec.DataRestoreLocation.NodeName
+	} else {
+		// RestorePath in Dataset cannot analyse
+		err := fmt.Errorf("DataRestoreLocation in Dataset cannot analyse, will not restore")
+		j.Log.Error(err, "restore path cannot analyse", "Location", dataset.Spec.DataRestoreLocation)
+		return
+	}
+
+	value.Worker.Volumes = append(value.Worker.Volumes, volume)
+	value.Worker.VolumeMounts = append(value.Worker.VolumeMounts, corev1.VolumeMount{
+		Name:      MetadataRestoreVolumeName,
+		MountPath: MetadataRestoreMountPath,
+		ReadOnly:  true,
+	})
+	value.Worker.Envs = append(value.Worker.Envs, corev1.EnvVar{
+		Name:  MetadataRestoreFileEnv,
+		Value: restoreFile,
+	})
+}
@@ -0,0 +1,266 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestJuiceFSEngine_generateDataBackupValueFile(t *testing.T) {
+	communityValue := `
+edition: community
+configs:
+  metaurlSecret: jfs-secret
+  metaurlSecretKey: metaurl
+`
+	enterpriseValue := `
+edition: enterprise
+configs:
+  tokenSecret: jfs-secret
+  tokenSecretKey: token
+`
+	dataset := &v1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataset",
+			Namespace: "fluid",
+		},
+	}
+	runtimeObj := &v1alpha1.JuiceFSRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataset",
+			Namespace: "fluid",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		value      string
+		backupPath string
+		wantValue  cdatabackup.DataBackup
+		wantErr    bool
+	}{
+		{
+			name:       "pvc",
+			value:      communityValue,
+			backupPath: "pvc://backup-pvc/subpath",
+			wantValue: cdatabackup.DataBackup{
+				Namespace:        "fluid",
+				Dataset:          "test-dataset",
+				OwnerDatasetId:   "fluid-test-dataset",
+				Name:             "test-databackup",
+				Image:            common.DefaultCEImage,
+				PVCName:          "backup-pvc",
+				Path:             "/subpath/",
+				RuntimeType:      common.JuiceFSRuntime,
+				MetaUrlSecret:    "jfs-secret",
+				MetaUrlSecretKey: "metaurl",
+			},
+		},
+		{
+			name:       "local",
+			value:      communityValue,
+			backupPath: "local:///tmp/backup",
+			wantValue: cdatabackup.DataBackup{
+				Namespace:        "fluid",
+				Dataset:          "test-dataset",
+				OwnerDatasetId:   "fluid-test-dataset",
+				Name:             "test-databackup",
+				Image:            common.DefaultCEImage,
+				Path:             "/tmp/backup/",
+				RuntimeType:      common.JuiceFSRuntime,
+				MetaUrlSecret:    "jfs-secret",
+				MetaUrlSecretKey: "metaurl",
+			},
+		},
+		{
+			name:       "enterprise",
+			value:      enterpriseValue,
+			backupPath: "pvc://backup-pvc/subpath",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dataset-juicefs-values",
+					Namespace: "fluid",
+				},
+				Data: map[string]string{
+					"data": tt.value,
+				},
+			}
+			testObjs := []runtime.Object{configMap, dataset.DeepCopy(), runtimeObj.DeepCopy()}
+			client := fake.NewFakeClientWithScheme(testScheme, testObjs...)
+
+			engine := JuiceFSEngine{
+				name:      "test-dataset",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+			}
+			dataBackup := &v1alpha1.DataBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-databackup",
+					Namespace: "fluid",
+				},
+				Spec: v1alpha1.DataBackupSpec{
+					Dataset:    "test-dataset",
+					BackupPath: tt.backupPath,
+				},
+			}
+
+			valueFileName, err := engine.generateDataBackupValueFile(cruntime.ReconcileRequestContext{Client: client}, dataBackup)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generateDataBackupValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value cdatabackup.DataBackupValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+			// image pull secrets is an empty slice when the env is not set
+			value.DataBackup.ImagePullSecrets = nil
+			if !reflect.DeepEqual(value.DataBackup, tt.wantValue) {
+				t.Errorf("expect value %v, got %v", tt.wantValue, value.DataBackup)
+			}
+		})
+	}
+}
+
+func TestJuiceFSEngine_transformRestore(t *testing.T) {
+	hostPathType := corev1.HostPathDirectory
+
+	tests := []struct {
+		name             string
+		edition          string
+		restoreLocation  *v1alpha1.DataRestoreLocation
+		wantVolumes      []corev1.Volume
+		wantEnvs         []corev1.EnvVar
+		wantNodeSelector map[string]string
+	}{
+		{
+			name:    "no restore location",
+			edition: CommunityEdition,
+		},
+		{
+			name:    "pvc",
+			edition: CommunityEdition,
+			restoreLocation: &v1alpha1.DataRestoreLocation{
+				Path: "pvc://backup-pvc/subpath",
+			},
+			wantVolumes: []corev1.Volume{{
+				Name: MetadataRestoreVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "backup-pvc",
+						ReadOnly:  true,
+					},
+				},
+			}},
+			wantEnvs: []corev1.EnvVar{{
+				Name:  MetadataRestoreFileEnv,
+				Value: "/fluid-restore/subpath/metadata-backup-test-fluid.json.gz",
+			}},
+		},
+		{
+			name:    "local",
+			edition: CommunityEdition,
+			restoreLocation: &v1alpha1.DataRestoreLocation{
+				Path:     "local:///tmp/backup",
+				NodeName: "node1",
+			},
+			wantVolumes: []corev1.Volume{{
+				Name: MetadataRestoreVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{
+						Path: "/tmp/backup/",
+						Type: &hostPathType,
+					},
+				},
+			}},
+			wantEnvs: []corev1.EnvVar{{
+				Name:  MetadataRestoreFileEnv,
+				Value: "/fluid-restore/metadata-backup-test-fluid.json.gz",
+			}},
+			wantNodeSelector: map[string]string{common.K8sNodeNameLabelKey: "node1"},
+		},
+		{
+			name:    "local without node name",
+			edition: CommunityEdition,
+			restoreLocation: &v1alpha1.DataRestoreLocation{
+				Path: "local:///tmp/backup",
+			},
+		},
+		{
+			name:    "enterprise",
+			edition: EnterpriseEdition,
+			restoreLocation: &v1alpha1.DataRestoreLocation{
+				Path: "pvc://backup-pvc/subpath",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := JuiceFSEngine{
+				name:      "test",
+				namespace: "fluid",
+				Log:       fake.NullLogger(),
+			}
+			dataset := &v1alpha1.Dataset{
+				Spec: v1alpha1.DatasetSpec{
+					DataRestoreLocation: tt.restoreLocation,
+				},
+			}
+			value := &JuiceFS{Edition: tt.edition}
+
+			engine.transformRestore(dataset, value)
+
+			if !reflect.DeepEqual(value.Worker.Volumes, tt.wantVolumes) {
+				t.Errorf("expect volumes %v, got %v", tt.wantVolumes, value.Worker.Volumes)
+			}
+			if !reflect.DeepEqual(value.Worker.Envs, tt.wantEnvs) {
+				t.Errorf("expect envs %v, got %v", tt.wantEnvs, value.Worker.Envs)
+			}
+			if !reflect.DeepEqual(value.Worker.NodeSelector, tt.wantNodeSelector) {
+				t.Errorf("expect nodeSelector %v, got %v", tt.wantNodeSelector, value.Worker.NodeSelector)
+			}
+		})
+	}
+}
@@ -65,6 +65,10 @@ const (
 	DefaultDataMigrateTimeout = "30m"
 
 	NativeVolumeMigratePath = "/mnt/fluid-native/"
+
+	MetadataRestoreFileEnv    = "FLUID_METADATA_RESTORE_FILE"
+	MetadataRestoreVolumeName = "fluid-metadata-restore"
+	MetadataRestoreMountPath  = "/fluid-restore"
 )
 
 const (
@@ -140,7 +140,7 @@ func (j *JuiceFSEngine) genDataLoadValue(image string, cacheinfo map[string]stri
 
 	// generate the node affinity by previous operation pod.
 	var err error
-	dataloadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(j.Client, dataload.Spec.RunAfter, dataload.Namespace, dataloadInfo.Affinity)
+	dataloadInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(j.Client, dataload, dataloadInfo.Affinity)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +201,8 @@ func (j *JuiceFSEngine) genDataLoadValue(image string, cacheinfo map[string]stri
 		timeout = DefaultDataLoadTimeout
 	}
 	warmupOtions := []string{}
-	for k, v := range dataload.Spec.Options {
+	// the options set explicitly take precedence over the throttle
+	for k, v := range utils.UnionMapsWithOverride(j.genDataLoadThrottleOptions(dataload.Spec.Throttle), dataload.Spec.Options) {
 		if v != "" {
 			warmupOtions = append(warmupOtions, fmt.Sprintf("--%s=%s", k, v))
 		} else {
@@ -21,13 +21,9 @@ import (
 	"net/url"
 	"os"
 	"path"
-	"strconv"
 	"strings"
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
-	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
-	"github.com/pkg/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -113,16 +109,17 @@ func (j *JuiceFSEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequest
 	}
 	// generate ssh config for parallel tasks when using parallel tasks
 	if dataMigrateInfo.Parallelism > 1 {
-		err = j.setParallelMigrateOptions(&dataMigrateInfo, dataMigrate)
+		err = cdatamigrate.SetParallelOptions(&dataMigrateInfo, dataMigrate)
 		if err != nil {
+			j.Log.Error(err, "failed to set parallel options")
 			return "", err
 		}
 		// the launcher prefers to run on different host with the workers
-		addWorkerPodPreferredAntiAffinity(&dataMigrateInfo, dataMigrate)
+		cdatamigrate.AddWorkerPodPreferredAntiAffinity(&dataMigrateInfo, dataMigrate)
 	}
 
 	// inject the node affinity by previous operation pod.
-	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(j.Client, dataMigrate.Spec.RunAfter, dataMigrate.Namespace, dataMigrateInfo.Affinity)
+	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(j.Client, dataMigrate, dataMigrateInfo.Affinity)
 	if err != nil {
 		return "", err
 	}
@@ -146,7 +143,8 @@ func (j *JuiceFSEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequest
 		timeout = DefaultDataMigrateTimeout
 	}
 	options := []string{}
-	for k, v := range dataMigrate.Spec.Options {
+	// the options set explicitly take precedence over the throttle
+	for k, v := range utils.UnionMapsWithOverride(genDataMigrateThrottleOptions(dataMigrate.Spec.Throttle), dataMigrate.Spec.Options) {
 		if v != "" {
 			options = append(options, fmt.Sprintf("--%s=%s", k, v))
 		} else {
@@ -193,69 +191,6 @@ func (j *JuiceFSEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequest
 	return valueFile.Name(), nil
 }
 
-func addWorkerPodPreferredAntiAffinity(dataMigrateInfo *cdatamigrate.DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) {
-	releaseName := utils.GetDataMigrateReleaseName(dataMigrate.Name)
-
-	podAffinityTerm := corev1.WeightedPodAffinityTerm{
-		Weight: 100,
-		PodAffinityTerm: corev1.PodAffinityTerm{
-			LabelSelector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", dataMigrate.Namespace, releaseName),
-				},
-			},
-			TopologyKey: common.K8sNodeNameLabelKey,
-		},
-	}
-
-	// Affinity is nil
-	if dataMigrateInfo.Affinity == nil {
-		dataMigrateInfo.Affinity = &corev1.Affinity{
-			PodAntiAffinity: &corev1.PodAntiAffinity{
-				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-					podAffinityTerm,
-				},
-			},
-		}
-		return
-	}
-	// Affinity not nil, PodAntiAffinity is nil
-	if dataMigrateInfo.Affinity.PodAntiAffinity == nil {
-		dataMigrateInfo.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
-	}
-
-	dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution =
-		append(dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, podAffinityTerm)
-}
-
-func (j *JuiceFSEngine) setParallelMigrateOptions(dataMigrateInfo *cdatamigrate.DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) error {
-	var err error
-	dataMigrateInfo.ParallelOptions = cdatamigrate.ParallelOptions{
-		SSHPort:                cdatamigrate.DefaultSSHPort,
-		SSHReadyTimeoutSeconds: cdatamigrate.DefaultSSHReadyTimeoutSeconds,
-		SSHSecretName:          dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHSecretName],
-	}
-
-	sshPort, exist := dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHPort]
-	if exist {
-		dataMigrateInfo.ParallelOptions.SSHPort, err = strconv.Atoi(sshPort)
-		if err != nil {
-			j.Log.Error(err, "sshPort in the parallelOptions is not a int")
-			return errors.Wrap(err, "sshPort in the parallelOptions is not a int")
-		}
-	}
-
-	sshReadyTimeoutSeconds, exist := dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHReadyTimeoutSeconds]
-	if exist {
-		dataMigrateInfo.ParallelOptions.SSHReadyTimeoutSeconds, err = strconv.Atoi(sshReadyTimeoutSeconds)
-		if err != nil {
-			j.Log.Error(err, "sshReadyTimeoutSeconds in the parallelOptions is not a int")
-			return errors.Wrap(err, "sshReadyTimeoutSeconds in the parallelOptions is not a int")
-		}
-	}
-	return nil
-}
-
 func (j *JuiceFSEngine) genDataUrl(data datav1alpha1.DataToMigrate, targetDataset *datav1alpha1.Dataset, info *cdatamigrate.DataMigrateInfo) (dataUrl string, err error) {
 	if data.DataSet != nil {
 		fsInfo, err := GetFSInfoFromConfigMap(j.Client, data.DataSet.Name, data.DataSet.Namespace)
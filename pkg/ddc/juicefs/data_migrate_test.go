@@ -18,10 +18,7 @@ package juicefs
 
 import (
 	"encoding/base64"
-	"fmt"
-	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
-	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -628,300 +625,3 @@ func TestJuiceFSEngine_genDataUrl(t *testing.T) {
 		})
 	}
 }
-
-// TestJuiceFSEngine_setParallelMigrateOptions tests the setParallelMigrateOptions method of JuiceFSEngine.
-// This method is responsible for setting parallel migration options, including SSH port and SSH ready timeout.
-// The test cases cover:
-// 1. Normal scenario where parallel migration options are set correctly.
-// 2. Default values are used when no parallel options are provided.
-// 3. Error scenario where invalid parallel options are provided.
-func TestJuiceFSEngine_setParallelMigrateOptions(t *testing.T) {
-	type args struct {
-		dataMigrateInfo *cdatamigrate.DataMigrateInfo
-		dataMigrate     *v1alpha1.DataMigrate
-	}
-	tests := []struct {
-		name    string
-		args    args
-		want    cdatamigrate.ParallelOptions
-		wanterr bool
-	}{
-		{
-			name: "test-parallel-migrate-options",
-			args: args{
-				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{},
-				dataMigrate: &v1alpha1.DataMigrate{
-					Spec: v1alpha1.DataMigrateSpec{
-						Parallelism: 2,
-						ParallelOptions: map[string]string{
-							cdatamigrate.SSHPort:                "120",
-							cdatamigrate.SSHReadyTimeoutSeconds: "20",
-						},
-					},
-				},
-			},
-			want: cdatamigrate.ParallelOptions{
-				SSHPort:                120,
-				SSHReadyTimeoutSeconds: 20,
-			},
-			wanterr: false,
-		},
-		{
-			name: "test-parallel-migrate-options-default",
-			args: args{
-				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{},
-				dataMigrate: &v1alpha1.DataMigrate{
-					Spec: v1alpha1.DataMigrateSpec{
-						Parallelism:     2,
-						ParallelOptions: map[string]string{},
-					},
-				},
-			},
-			want: cdatamigrate.ParallelOptions{
-				SSHPort:                cdatamigrate.DefaultSSHPort,
-				SSHReadyTimeoutSeconds: cdatamigrate.DefaultSSHReadyTimeoutSeconds,
-			},
-			wanterr: false,
-		},
-		{
-			name: "test-parallel-migrate-options-wrong",
-			args: args{
-				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{},
-				dataMigrate: &v1alpha1.DataMigrate{
-					Spec: v1alpha1.DataMigrateSpec{
-						Parallelism: 2,
-						ParallelOptions: map[string]string{
-							cdatamigrate.SSHPort:                "120SS",
-							cdatamigrate.SSHReadyTimeoutSeconds: "20",
-						},
-					},
-				},
-			},
-			want:    cdatamigrate.ParallelOptions{},
-			wanterr: true,
-		},
-	}
-	client := fake.NewFakeClientWithScheme(testScheme)
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			j := &JuiceFSEngine{
-				name:      "juicefs",
-				namespace: "fluid",
-				Client:    client,
-				Log:       fake.NullLogger(),
-			}
-			err := j.setParallelMigrateOptions(tt.args.dataMigrateInfo, tt.args.dataMigrate)
-			if (err != nil) != tt.wanterr {
-				t.Errorf("setParallelMigrateOptions() error = %v, wantErr %v", err, tt.wanterr)
-				return
-			}
-			if err == nil && !reflect.DeepEqual(tt.want, tt.args.dataMigrateInfo.ParallelOptions) {
-				t.Errorf("setParallelMigrateOptions() got = %v, want %v", tt.args.dataMigrateInfo.ParallelOptions, tt.want)
-			}
-		})
-	}
-}
-
-// Test_addWorkerPodAntiAffinity tests the addWorkerPodPreferredAntiAffinity function
-// which adds pod anti-affinity rules to DataMigrateInfo to ensure worker pods
-// are scheduled on different nodes for better availability.
-//
-// Test cases cover:
-// 1. When no affinity exists (initial case)
-// 2. When pod affinity exists but no pod anti-affinity
-// 3. When pod anti-affinity exists but no preferred scheduling terms
-// 4. When preferred anti-affinity terms already exist (should append new terms)
-//
-// Each test verifies that the function correctly adds the expected anti-affinity
-// rules while preserving any existing affinity configurations.
-// The anti-affinity rule uses the operation label and hostname topology key
-// to spread worker pods across different nodes.
-func Test_addWorkerPodAntiAffinity(t *testing.T) {
-	type args struct {
-		dataMigrateInfo *cdatamigrate.DataMigrateInfo
-		dataMigrate     *v1alpha1.DataMigrate
-	}
-	tests := []struct {
-		name string
-		args args
-		want *cdatamigrate.DataMigrateInfo
-	}{
-		{
-			name: "no affinity",
-			args: args{
-				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{
-					Affinity: nil,
-				},
-				dataMigrate: &v1alpha1.DataMigrate{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "dataset-migrate",
-					},
-					Spec: v1alpha1.DataMigrateSpec{},
-				},
-			},
-			want: &cdatamigrate.DataMigrateInfo{
-				Affinity: &corev1.Affinity{
-					PodAntiAffinity: &corev1.PodAntiAffinity{
-						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-							{
-								Weight: 100,
-								PodAffinityTerm: corev1.PodAffinityTerm{
-									LabelSelector: &metav1.LabelSelector{
-										MatchLabels: map[string]string{
-											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
-										},
-									},
-									TopologyKey: "kubernetes.io/hostname",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "no pod anti affinity",
-			args: args{
-				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{
-					Affinity: &corev1.Affinity{
-						PodAffinity: &corev1.PodAffinity{},
-					},
-				},
-				dataMigrate: &v1alpha1.DataMigrate{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "dataset-migrate",
-					},
-					Spec: v1alpha1.DataMigrateSpec{},
-				},
-			},
-			want: &cdatamigrate.DataMigrateInfo{
-				Affinity: &corev1.Affinity{
-					PodAffinity: &corev1.PodAffinity{},
-					PodAntiAffinity: &corev1.PodAntiAffinity{
-						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-							{
-								Weight: 100,
-								PodAffinityTerm: corev1.PodAffinityTerm{
-									LabelSelector: &metav1.LabelSelector{
-										MatchLabels: map[string]string{
-											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
-										},
-									},
-									TopologyKey: "kubernetes.io/hostname",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "no pod anti PreferredDuringSchedulingIgnoredDuringExecution ",
-			args: args{
-				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{
-					Affinity: &corev1.Affinity{
-						PodAffinity:     &corev1.PodAffinity{},
-						PodAntiAffinity: &corev1.PodAntiAffinity{},
-					},
-				},
-				dataMigrate: &v1alpha1.DataMigrate{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "dataset-migrate",
-					},
-					Spec: v1alpha1.DataMigrateSpec{},
-				},
-			},
-			want: &cdatamigrate.DataMigrateInfo{
-				Affinity: &corev1.Affinity{
-					PodAffinity: &corev1.PodAffinity{},
-					PodAntiAffinity: &corev1.PodAntiAffinity{
-						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-							{
-								Weight: 100,
-								PodAffinityTerm: corev1.PodAffinityTerm{
-									LabelSelector: &metav1.LabelSelector{
-										MatchLabels: map[string]string{
-											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
-										},
-									},
-									TopologyKey: "kubernetes.io/hostname",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "pod anti PreferredDuringSchedulingIgnoredDuringExecution ",
-			args: args{
-				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{
-					Affinity: &corev1.Affinity{
-						PodAffinity: &corev1.PodAffinity{},
-						PodAntiAffinity: &corev1.PodAntiAffinity{
-							PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-								{
-									Weight: 100,
-									PodAffinityTerm: corev1.PodAffinityTerm{
-										LabelSelector: &metav1.LabelSelector{
-											MatchLabels: map[string]string{
-												"a": "b",
-											},
-										},
-										TopologyKey: "kubernetes.io/hostname",
-									},
-								},
-							},
-						},
-					},
-				},
-				dataMigrate: &v1alpha1.DataMigrate{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "dataset-migrate",
-					},
-					Spec: v1alpha1.DataMigrateSpec{},
-				},
-			},
-			want: &cdatamigrate.DataMigrateInfo{
-				Affinity: &corev1.Affinity{
-					PodAffinity: &corev1.PodAffinity{},
-					PodAntiAffinity: &corev1.PodAntiAffinity{
-						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-							{
-								Weight: 100,
-								PodAffinityTerm: corev1.PodAffinityTerm{
-									LabelSelector: &metav1.LabelSelector{
-										MatchLabels: map[string]string{
-											"a": "b",
-										},
-									},
-									TopologyKey: "kubernetes.io/hostname",
-								},
-							},
-							{
-								Weight: 100,
-								PodAffinityTerm: corev1.PodAffinityTerm{
-									LabelSelector: &metav1.LabelSelector{
-										MatchLabels: map[string]string{
-											dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", "", utils.GetDataMigrateReleaseName("dataset-migrate")),
-										},
-									},
-									TopologyKey: "kubernetes.io/hostname",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			addWorkerPodPreferredAntiAffinity(tt.args.dataMigrateInfo, tt.args.dataMigrate)
-			if !reflect.DeepEqual(tt.args.dataMigrateInfo, tt.want) {
-				t.Errorf("addWorkerPodPreferredAntiAffinity() got = %v, want %v", tt.args.dataMigrateInfo, tt.want)
-			}
-		})
-	}
-}
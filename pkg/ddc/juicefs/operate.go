@@ -34,6 +34,9 @@ func (j *JuiceFSEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 	case dataoperation.DataLoadType:
 		valueFileName, err = j.generateDataLoadValueFile(ctx, object)
 		return valueFileName, err
+	case dataoperation.DataBackupType:
+		valueFileName, err = j.generateDataBackupValueFile(ctx, object)
+		return valueFileName, err
 	case dataoperation.DataProcessType:
 		valueFileName, err = j.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
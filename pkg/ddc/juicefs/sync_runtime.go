@@ -50,6 +50,11 @@ func (j *JuiceFSEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (chang
 		return
 	}
 
+	err = j.Helper.SyncPodDisruptionBudget(types.NamespacedName{Namespace: j.namespace, Name: j.getWorkerName()}, runtime.Spec.Worker.PodDisruptionBudget)
+	if err != nil {
+		return
+	}
+
 	var latestValue *JuiceFS
 	latestValue, err = j.transform(runtime)
 	if err != nil {
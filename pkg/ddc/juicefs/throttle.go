@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"strconv"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// genDataLoadThrottleOptions translates the throttle of the DataLoad into the options of `juicefs warmup`, which runs
+// on each worker. The concurrency is --threads, and the bandwidth is not supported.
+func (j *JuiceFSEngine) genDataLoadThrottleOptions(throttle *datav1alpha1.Throttle) map[string]string {
+	options := map[string]string{}
+	if throttle == nil {
+		return options
+	}
+	if throttle.MaxBandwidth != nil {
+		j.Log.Info("juicefs warmup doesn't support limiting the bandwidth, ignore it")
+	}
+	if throttle.MaxConcurrentFilesPerWorker > 0 {
+		options["threads"] = strconv.Itoa(int(throttle.MaxConcurrentFilesPerWorker))
+	}
+	return options
+}
+
+// genDataMigrateThrottleOptions translates the throttle of the DataMigrate into the options of `juicefs sync`, i.e.
+// --bwlimit in Mbps and --threads.
+func genDataMigrateThrottleOptions(throttle *datav1alpha1.Throttle) map[string]string {
+	options := map[string]string{}
+	if throttle == nil {
+		return options
+	}
+	if throttle.MaxBandwidth != nil {
+		// juicefs takes 1 Mbps as 1<<20 bits per second
+		bwlimit := throttle.MaxBandwidth.Value() * 8 >> 20
+		if bwlimit < 1 {
+			bwlimit = 1
+		}
+		options["bwlimit"] = strconv.FormatInt(bwlimit, 10)
+	}
+	if throttle.MaxConcurrentFilesPerWorker > 0 {
+		options["threads"] = strconv.Itoa(int(throttle.MaxConcurrentFilesPerWorker))
+	}
+	return options
+}
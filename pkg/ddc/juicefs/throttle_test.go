@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestGenThrottleOptions(t *testing.T) {
+	engine := &JuiceFSEngine{Log: fake.NullLogger()}
+	bandwidth := resource.MustParse("16Mi")
+	throttle := &datav1alpha1.Throttle{
+		MaxBandwidth:                &bandwidth,
+		MaxConcurrentFilesPerWorker: 20,
+	}
+
+	if got, want := engine.genDataLoadThrottleOptions(throttle), map[string]string{"threads": "20"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expect dataload options %v, got %v", want, got)
+	}
+	// 16MiB/s is 128 Mbps
+	if got, want := genDataMigrateThrottleOptions(throttle), map[string]string{"bwlimit": "128", "threads": "20"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expect datamigrate options %v, got %v", want, got)
+	}
+	if got := genDataMigrateThrottleOptions(nil); len(got) != 0 {
+		t.Errorf("expect no options without throttle, got %v", got)
+	}
+}
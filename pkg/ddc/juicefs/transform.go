@@ -86,12 +86,19 @@ func (j *JuiceFSEngine) transform(runtime *datav1alpha1.JuiceFSRuntime) (value *
 		return
 	}
 
+	// if the dataset indicates a restore path, need to load the metadata backup file in it
+	j.transformRestore(dataset, value)
+
 	// transform runtime pod metadata
 	err = j.transformPodMetadata(runtime, value)
 	if err != nil {
 		return
 	}
 
+	// transform the scheduling policies of the workers
+	transformer.TransformSchedulingPolicies(runtime.Spec.Worker.PriorityClassName, runtime.Spec.Worker.TopologySpreadConstraints,
+		&value.Worker.PriorityClassName, &value.Worker.TopologySpreadConstraints)
+
 	// set the placementMode
 	j.transformPlacementMode(dataset, value)
 	return
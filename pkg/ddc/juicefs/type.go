@@ -87,6 +87,9 @@ type Worker struct {
 	Command     string            `json:"command,omitempty"`
 	Labels      map[string]string `json:"labels,omitempty"`
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // Fuse struct holds the configuration of JuiceFS FUSE (File System in User Space).
@@ -491,6 +491,11 @@ func (j *JuiceFSEngine) getFuseScriptName() string {
 	return fmt.Sprintf("%s-fuse-script", j.name)
 }
 
+// GetMetadataFileName returns the file name of the metadata dumped by DataBackup
+func (j *JuiceFSEngine) GetMetadataFileName() string {
+	return "metadata-backup-" + j.name + "-" + j.namespace + ".json.gz"
+}
+
 func DeepCopy[T any](in *T) *T {
 	if in == nil {
 		return nil
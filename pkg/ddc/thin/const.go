@@ -23,3 +23,15 @@ const (
 	MetadataSyncNotDoneMsg               = "[Calculating]"
 	CheckMetadataSyncDoneTimeoutMillisec = 500
 )
+
+const (
+	defaultDataLoadMountPath = "/data"
+	dataLoadVolumeName       = "fluid-dataset"
+
+	// Environment variables passed to the loader container of DataLoad
+	EnvDataLoadMountPath    = "FLUID_DATALOAD_MOUNT_PATH"
+	EnvDataLoadDataPath     = "FLUID_DATALOAD_DATA_PATH"
+	EnvDataLoadPathReplicas = "FLUID_DATALOAD_PATH_REPLICAS"
+	EnvDataLoadMetadata     = "FLUID_DATALOAD_METADATA"
+	EnvDataLoadOptionPrefix = "FLUID_DATALOAD_OPTION_"
+)
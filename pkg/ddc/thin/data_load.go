@@ -0,0 +1,243 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package thin
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// generateDataLoadValueFile builds a DataLoadValue from the given DataLoad and the loader defined in the ThinRuntimeProfile,
+// and marshals the DataLoadValue to a temporary yaml file where stores values that'll be used by fluid dataloader helm chart
+func (t *ThinEngine) generateDataLoadValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataload, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataLoad", object)
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(t.Client, dataload.Spec.Dataset.Name, dataload.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	profile, err := t.getThinRuntimeProfile()
+	if err != nil {
+		return "", err
+	}
+	if profile == nil || profile.Spec.DataLoader == nil {
+		err = fmt.Errorf("no dataLoader is defined in the thinRuntimeProfile of runtime %s/%s", t.namespace, t.name)
+		return "", err
+	}
+
+	dataLoadValue, err := t.genDataLoadValue(profile, targetDataset, dataload)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataLoadValue)
+	if err != nil {
+		return
+	}
+	t.Log.Info("dataload value", "value", string(data))
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-loader-values.yaml", dataload.Namespace, dataload.Name))
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return
+	}
+	return valueFile.Name(), nil
+}
+
+func (t *ThinEngine) genDataLoadValue(profile *datav1alpha1.ThinRuntimeProfile, targetDataset *datav1alpha1.Dataset, dataload *datav1alpha1.DataLoad) (*DataLoadValue, error) {
+	loaderSpec := profile.Spec.DataLoader
+	if len(loaderSpec.Image) == 0 {
+		return nil, fmt.Errorf("the image of dataLoader in thinRuntimeProfile %s is not set", profile.Name)
+	}
+
+	image := loaderSpec.Image
+	if len(loaderSpec.ImageTag) > 0 {
+		image = fmt.Sprintf("%s:%s", loaderSpec.Image, loaderSpec.ImageTag)
+	}
+
+	imagePullSecrets := docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey)
+	imagePullSecrets = append(imagePullSecrets, profile.Spec.ImagePullSecrets...)
+
+	dataloadInfo := cdataload.DataLoadInfo{
+		BackoffLimit:     3,
+		TargetDataset:    dataload.Spec.Dataset.Name,
+		LoadMetadata:     dataload.Spec.LoadMetadata,
+		Image:            image,
+		Labels:           dataload.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataload.Annotations, dataload.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: imagePullSecrets,
+		Policy:           string(dataload.Spec.Policy),
+		Schedule:         dataload.Spec.Schedule,
+		Resources:        loaderSpec.Resources,
+		Affinity:         dataload.Spec.Affinity,
+		NodeSelector:     dataload.Spec.NodeSelector,
+		Tolerations:      dataload.Spec.Tolerations,
+		SchedulerName:    dataload.Spec.SchedulerName,
+		Options:          dataload.Spec.Options,
+	}
+
+	// resources of the DataLoad take precedence over the ones defined in the profile
+	if len(dataload.Spec.Resources.Limits) > 0 || len(dataload.Spec.Resources.Requests) > 0 {
+		dataloadInfo.Resources = dataload.Spec.Resources
+	}
+
+	// generate the node affinity by previous operation pod.
+	var err error
+	dataloadInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(t.Client, dataload, dataloadInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPaths := []cdataload.TargetPath{}
+	for _, target := range dataload.Spec.Target {
+		fluidNative := utils.IsTargetPathUnderFluidNativeMounts(target.Path, *targetDataset)
+		path := strings.TrimSpace(target.Path)
+		targetPaths = append(targetPaths, cdataload.TargetPath{
+			Path:        path,
+			Replicas:    target.Replicas,
+			FluidNative: fluidNative,
+		})
+	}
+	dataloadInfo.TargetPaths = targetPaths
+
+	loader, err := t.transformDataLoader(profile, dataloadInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	dataLoadValue := &DataLoadValue{
+		DataLoadValue: cdataload.DataLoadValue{
+			Name:           dataload.Name,
+			OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+			DataLoadInfo:   dataloadInfo,
+			Owner:          transformer.GenerateOwnerReferenceFromObject(dataload),
+		},
+		Loader: loader,
+	}
+
+	return dataLoadValue, nil
+}
+
+// transformDataLoader renders the loader container template in the profile. The target paths of the DataLoad
+// are passed to the loader through the environment variables, and the volumes referred by the loader are
+// picked from the volumes of the profile.
+func (t *ThinEngine) transformDataLoader(profile *datav1alpha1.ThinRuntimeProfile, dataloadInfo cdataload.DataLoadInfo) (loader DataLoader, err error) {
+	loaderSpec := profile.Spec.DataLoader
+
+	loader = DataLoader{
+		ImagePullPolicy: loaderSpec.ImagePullPolicy,
+		Command:         loaderSpec.Command,
+		Args:            loaderSpec.Args,
+		VolumeMounts:    loaderSpec.VolumeMounts,
+		DataMountPath:   loaderSpec.DataMountPath,
+	}
+	if len(loader.ImagePullPolicy) == 0 {
+		loader.ImagePullPolicy = common.DefaultImagePullPolicy
+	}
+	if len(loader.DataMountPath) == 0 {
+		loader.DataMountPath = defaultDataLoadMountPath
+	}
+
+	for _, volumeMount := range loaderSpec.VolumeMounts {
+		if volumeMount.Name == dataLoadVolumeName {
+			return loader, fmt.Errorf("the volume name %s is reserved for the dataset to load", dataLoadVolumeName)
+		}
+		volume, found := findVolumeByName(profile.Spec.Volumes, volumeMount.Name)
+		if !found {
+			return loader, fmt.Errorf("failed to find the volume %s mounted by the dataLoader in thinRuntimeProfile %s", volumeMount.Name, profile.Name)
+		}
+		loader.Volumes = append(loader.Volumes, volume)
+	}
+
+	paths := make([]string, 0, len(dataloadInfo.TargetPaths))
+	replicas := make([]string, 0, len(dataloadInfo.TargetPaths))
+	for _, target := range dataloadInfo.TargetPaths {
+		paths = append(paths, target.Path)
+		replica := target.Replicas
+		if replica <= 0 {
+			replica = 1
+		}
+		replicas = append(replicas, strconv.Itoa(int(replica)))
+	}
+
+	loader.Envs = append(loader.Envs, loaderSpec.Env...)
+	loader.Envs = append(loader.Envs,
+		corev1.EnvVar{Name: EnvDataLoadMountPath, Value: loader.DataMountPath},
+		corev1.EnvVar{Name: EnvDataLoadDataPath, Value: strings.Join(paths, " ")},
+		corev1.EnvVar{Name: EnvDataLoadPathReplicas, Value: strings.Join(replicas, ":")},
+		corev1.EnvVar{Name: EnvDataLoadMetadata, Value: strconv.FormatBool(dataloadInfo.LoadMetadata)},
+	)
+
+	// keep the env order stable to avoid unnecessary diff of the rendered job
+	keys := make([]string, 0, len(dataloadInfo.Options))
+	for key := range dataloadInfo.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		loader.Envs = append(loader.Envs, corev1.EnvVar{
+			Name:  getDataLoadOptionEnvName(key),
+			Value: dataloadInfo.Options[key],
+		})
+	}
+
+	return loader, nil
+}
+
+// getDataLoadOptionEnvName converts the option key of DataLoad to an env name, e.g. "max-files" to "FLUID_DATALOAD_OPTION_MAX_FILES"
+func getDataLoadOptionEnvName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, key)
+	return EnvDataLoadOptionPrefix + strings.ToUpper(name)
+}
+
+func findVolumeByName(volumes []corev1.Volume, name string) (corev1.Volume, bool) {
+	for _, volume := range volumes {
+		if volume.Name == name {
+			return volume, true
+		}
+	}
+	return corev1.Volume{}, false
+}
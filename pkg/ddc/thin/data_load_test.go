@@ -0,0 +1,280 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package thin
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestThinEngine_generateDataLoadValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-dataset",
+			Namespace: "default",
+		},
+	}
+
+	runtime := &datav1alpha1.ThinRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-dataset",
+			Namespace: "default",
+		},
+		Spec: datav1alpha1.ThinRuntimeSpec{
+			ThinRuntimeProfileName: "demo-profile",
+		},
+	}
+
+	profileWithoutLoader := &datav1alpha1.ThinRuntimeProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "demo-profile",
+		},
+	}
+
+	profile := &datav1alpha1.ThinRuntimeProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "demo-profile",
+		},
+		Spec: datav1alpha1.ThinRuntimeProfileSpec{
+			DataLoader: &datav1alpha1.ThinDataLoaderSpec{
+				Image:    "test-loader",
+				ImageTag: "v1",
+				Command:  []string{"/bin/sh", "-c", "/load.sh"},
+			},
+		},
+	}
+
+	dataLoad := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-dataload",
+			Namespace: "default",
+		},
+		Spec: datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{
+				Name:      "demo-dataset",
+				Namespace: "default",
+			},
+			Target: []datav1alpha1.TargetPath{
+				{Path: "/a", Replicas: 2},
+			},
+		},
+	}
+
+	type args struct {
+		engine *ThinEngine
+		ctx    cruntime.ReconcileRequestContext
+		object client.Object
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantImage string
+		wantErr   bool
+	}{
+		{
+			name: "TestNotOfTypeDataLoad",
+			args: args{
+				engine: &ThinEngine{},
+				ctx:    cruntime.ReconcileRequestContext{},
+				object: &datav1alpha1.Dataset{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestTargetDatasetNotFound",
+			args: args{
+				engine: &ThinEngine{
+					Client:  fake.NewFakeClientWithScheme(testScheme, runtime, profile),
+					runtime: runtime,
+				},
+				ctx:    cruntime.ReconcileRequestContext{},
+				object: dataLoad,
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestNoDataLoaderInProfile",
+			args: args{
+				engine: &ThinEngine{
+					Client:  fake.NewFakeClientWithScheme(testScheme, dataset, runtime, profileWithoutLoader),
+					runtime: runtime,
+					Log:     fake.NullLogger(),
+				},
+				ctx:    cruntime.ReconcileRequestContext{},
+				object: dataLoad,
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestGenerateDataLoadValueFile",
+			args: args{
+				engine: &ThinEngine{
+					Client:  fake.NewFakeClientWithScheme(testScheme, dataset, runtime, profile),
+					runtime: runtime,
+					Log:     fake.NullLogger(),
+				},
+				ctx:    cruntime.ReconcileRequestContext{},
+				object: dataLoad,
+			},
+			wantImage: "test-loader:v1",
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valueFileName, err := tt.args.engine.generateDataLoadValueFile(tt.args.ctx, tt.args.object)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ThinEngine.generateDataLoadValueFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value DataLoadValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+			if value.DataLoadInfo.Image != tt.wantImage {
+				t.Errorf("expect image %s, got %s", tt.wantImage, value.DataLoadInfo.Image)
+			}
+			if value.Loader.DataMountPath != defaultDataLoadMountPath {
+				t.Errorf("expect dataMountPath %s, got %s", defaultDataLoadMountPath, value.Loader.DataMountPath)
+			}
+		})
+	}
+}
+
+func TestThinEngine_transformDataLoader(t *testing.T) {
+	volume := corev1.Volume{
+		Name: "loader-config",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "loader-config"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		loaderSpec  *datav1alpha1.ThinDataLoaderSpec
+		volumes     []corev1.Volume
+		options     map[string]string
+		wantEnvs    []corev1.EnvVar
+		wantVolumes []corev1.Volume
+		wantErr     bool
+	}{
+		{
+			name: "default",
+			loaderSpec: &datav1alpha1.ThinDataLoaderSpec{
+				Image: "test-loader",
+				Env:   []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+			},
+			options: map[string]string{"max-files": "10", "timeout": "1h"},
+			wantEnvs: []corev1.EnvVar{
+				{Name: "FOO", Value: "bar"},
+				{Name: EnvDataLoadMountPath, Value: "/data"},
+				{Name: EnvDataLoadDataPath, Value: "/a /b"},
+				{Name: EnvDataLoadPathReplicas, Value: "2:1"},
+				{Name: EnvDataLoadMetadata, Value: "true"},
+				{Name: "FLUID_DATALOAD_OPTION_MAX_FILES", Value: "10"},
+				{Name: "FLUID_DATALOAD_OPTION_TIMEOUT", Value: "1h"},
+			},
+		},
+		{
+			name: "with volumes",
+			loaderSpec: &datav1alpha1.ThinDataLoaderSpec{
+				Image:         "test-loader",
+				DataMountPath: "/mnt/fluid",
+				VolumeMounts:  []corev1.VolumeMount{{Name: "loader-config", MountPath: "/etc/loader"}},
+			},
+			volumes: []corev1.Volume{volume},
+			wantEnvs: []corev1.EnvVar{
+				{Name: EnvDataLoadMountPath, Value: "/mnt/fluid"},
+				{Name: EnvDataLoadDataPath, Value: "/a /b"},
+				{Name: EnvDataLoadPathReplicas, Value: "2:1"},
+				{Name: EnvDataLoadMetadata, Value: "true"},
+			},
+			wantVolumes: []corev1.Volume{volume},
+		},
+		{
+			name: "volume not found",
+			loaderSpec: &datav1alpha1.ThinDataLoaderSpec{
+				Image:        "test-loader",
+				VolumeMounts: []corev1.VolumeMount{{Name: "not-exist", MountPath: "/etc/loader"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved volume name",
+			loaderSpec: &datav1alpha1.ThinDataLoaderSpec{
+				Image:        "test-loader",
+				VolumeMounts: []corev1.VolumeMount{{Name: dataLoadVolumeName, MountPath: "/etc/loader"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &ThinEngine{}
+			profile := &datav1alpha1.ThinRuntimeProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo-profile"},
+				Spec: datav1alpha1.ThinRuntimeProfileSpec{
+					DataLoader: tt.loaderSpec,
+					Volumes:    tt.volumes,
+				},
+			}
+			dataloadInfo := cdataload.DataLoadInfo{
+				LoadMetadata: true,
+				TargetPaths: []cdataload.TargetPath{
+					{Path: "/a", Replicas: 2},
+					{Path: "/b"},
+				},
+				Options: tt.options,
+			}
+
+			loader, err := engine.transformDataLoader(profile, dataloadInfo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("transformDataLoader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(loader.Envs, tt.wantEnvs) {
+				t.Errorf("expect envs %v, got %v", tt.wantEnvs, loader.Envs)
+			}
+			if !reflect.DeepEqual(loader.Volumes, tt.wantVolumes) {
+				t.Errorf("expect volumes %v, got %v", tt.wantVolumes, loader.Volumes)
+			}
+		})
+	}
+}
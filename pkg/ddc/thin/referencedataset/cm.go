@@ -18,11 +18,10 @@ package referencedataset
 
 import (
 	"context"
-	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -32,15 +31,22 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
-func copyFuseDaemonSetForRefDataset(client client.Client, refDataset *datav1alpha1.Dataset, physicalRuntimeInfo base.RuntimeInfoInterface) error {
-	var fuseName string
+// getFuseDaemonSetName returns the name of the fuse daemonset of the physical runtime
+func getFuseDaemonSetName(physicalRuntimeInfo base.RuntimeInfoInterface) string {
 	switch physicalRuntimeInfo.GetRuntimeType() {
 	case common.JindoRuntime:
-		fuseName = physicalRuntimeInfo.GetName() + "-" + common.JindoChartName + "-fuse"
+		return physicalRuntimeInfo.GetName() + "-" + common.JindoChartName + "-fuse"
 	default:
-		fuseName = physicalRuntimeInfo.GetName() + "-fuse"
+		return physicalRuntimeInfo.GetName() + "-fuse"
 	}
-	ds, err := kubeclient.GetDaemonset(client, fuseName, physicalRuntimeInfo.GetNamespace())
+}
+
+// copyFuseDaemonSetForRefDataset copies the fuse daemonset of the physical runtime to the namespace of refDataset,
+// the references to the shareable resources renamed due to name collisions are rewritten. The pod template of the
+// existing copy is updated if the physical fuse or the renamed resources change.
+func copyFuseDaemonSetForRefDataset(c client.Client, refDataset *datav1alpha1.Dataset, physicalRuntimeInfo base.RuntimeInfoInterface,
+	renamed map[base.ShareableResource]string) error {
+	ds, err := kubeclient.GetDaemonset(c, getFuseDaemonSetName(physicalRuntimeInfo), physicalRuntimeInfo.GetNamespace())
 	if err != nil {
 		return err
 	}
@@ -60,6 +66,8 @@ func copyFuseDaemonSetForRefDataset(client client.Client, refDataset *datav1alph
 			OwnerReferences: []metav1.OwnerReference{ownerReference},
 			Labels: map[string]string{
 				common.LabelAnnotationDatasetId: utils.GetDatasetId(refDataset.Namespace, refDataset.Name, string(refDataset.UID)),
+				// the daemonsets cached by the controller are selected by the app label
+				common.App: common.ThinRuntime,
 			},
 		},
 		Spec: *ds.Spec.DeepCopy(),
@@ -69,83 +77,26 @@ func copyFuseDaemonSetForRefDataset(client client.Client, refDataset *datav1alph
 		dsToCreate.Spec.Template.Spec.NodeSelector = map[string]string{}
 	}
 	dsToCreate.Spec.Template.Spec.NodeSelector["fluid.io/fuse-balloon"] = "true"
+	renameReferencedResources(&dsToCreate.Spec.Template.Spec, renamed)
 
-	err = client.Create(context.TODO(), dsToCreate)
-	if utils.IgnoreAlreadyExists(err) != nil {
+	existing, err := kubeclient.GetDaemonset(c, dsToCreate.Name, dsToCreate.Namespace)
+	if utils.IgnoreNotFound(err) != nil {
 		return err
 	}
-
-	return nil
-}
-
-func (e *ReferenceDatasetEngine) createConfigMapForRefDataset(client client.Client, refDataset *datav1alpha1.Dataset, physicalRuntimeInfo base.RuntimeInfoInterface) error {
-	physicalRuntimeType := physicalRuntimeInfo.GetRuntimeType()
-	physicalRuntimeName := physicalRuntimeInfo.GetName()
-	physicalRuntimeNamespace := physicalRuntimeInfo.GetNamespace()
-
-	refNameSpace := refDataset.GetNamespace()
-
-	// add owner reference to ensure config map deleted when delete the dataset
-	ownerReference := metav1.OwnerReference{
-		APIVersion: refDataset.APIVersion,
-		Kind:       refDataset.Kind,
-		Name:       refDataset.Name,
-		UID:        refDataset.UID,
-	}
-
-	// copy the configmap to ref namespace.
-	// TODO: any other config resource like secret need copied ?
-
-	// Note: values configmap is not needed for fuse sidecar container.
-
-	// TODO: decoupling the switch-case, too fragile
-	switch physicalRuntimeType {
-	// TODO:  currently the dst configmap name is the same as src configmap name to avoid modify the fuse init container filed,
-	//       but duplicated name error can occurs if the dst namespace has same named runtime.
-	case common.AlluxioRuntime:
-		configMapName := physicalRuntimeName + "-config"
-		err := kubeclient.CopyConfigMap(client, types.NamespacedName{Name: configMapName, Namespace: physicalRuntimeNamespace},
-			types.NamespacedName{Name: configMapName, Namespace: refNameSpace}, ownerReference)
-		if err != nil {
-			return err
-		}
-	case common.JuiceFSRuntime:
-		fuseScriptConfigMapName := physicalRuntimeName + "-fuse-script"
-		err := kubeclient.CopyConfigMap(client, types.NamespacedName{Name: fuseScriptConfigMapName, Namespace: physicalRuntimeNamespace},
-			types.NamespacedName{Name: fuseScriptConfigMapName, Namespace: refNameSpace}, ownerReference)
-		if err != nil {
-			return err
-		}
-	case common.GooseFSRuntime:
-		configMapName := physicalRuntimeName + "-config"
-		err := kubeclient.CopyConfigMap(client, types.NamespacedName{Name: configMapName, Namespace: physicalRuntimeNamespace},
-			types.NamespacedName{Name: configMapName, Namespace: refNameSpace}, ownerReference)
-		if err != nil {
-			return err
-		}
-	case common.JindoRuntime:
-		clientConfigMapName := physicalRuntimeName + "-jindofs-client-config"
-		err := kubeclient.CopyConfigMap(client, types.NamespacedName{Name: clientConfigMapName, Namespace: physicalRuntimeNamespace},
-			types.NamespacedName{Name: clientConfigMapName, Namespace: refNameSpace}, ownerReference)
-		if err != nil {
-			return err
-		}
-		configMapName := physicalRuntimeName + "-jindofs-config"
-		err = kubeclient.CopyConfigMap(client, types.NamespacedName{Name: configMapName, Namespace: physicalRuntimeNamespace},
-			types.NamespacedName{Name: configMapName, Namespace: refNameSpace}, ownerReference)
-		if err != nil {
-			return err
+	if err != nil {
+		err = c.Create(context.TODO(), dsToCreate)
+		if utils.IgnoreAlreadyExists(err) == nil && err != nil {
+			// the copy created without the app label is not cached, patch it to add the label
+			return c.Patch(context.TODO(), dsToCreate, client.Merge)
 		}
-	case common.EFCRuntime:
-		// TODO: EFCRuntime needs worker-endpoint configmap which should be synced timely for ECI mode.
-		// Currently EFCRuntime only supports CSI mode, so do nothing here.
-		e.Log.Info("Skip createConfigMapForRefDataset because the physicalRuntimeType=EFC", "name", e.name, "namespace", e.namespace)
-	case common.ThinRuntime:
-		e.Log.Info("Skip createConfigMapForRefDataset because the physicalRuntimeType=THIN", "name", e.name, "namespace", e.namespace)
-	default:
-		err := fmt.Errorf("fail to get configmap for runtime type: %s", physicalRuntimeType)
 		return err
 	}
 
-	return nil
+	// the selector of the daemonset is immutable, only the pod template is kept up to date
+	if equality.Semantic.DeepEqual(existing.Spec.Template, dsToCreate.Spec.Template) {
+		return nil
+	}
+	dsToUpdate := existing.DeepCopy()
+	dsToUpdate.Spec.Template = dsToCreate.Spec.Template
+	return c.Update(context.TODO(), dsToUpdate)
 }
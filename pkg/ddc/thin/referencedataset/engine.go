@@ -65,19 +65,44 @@ type ReferenceDatasetEngine struct {
 	runtimeInfo base.RuntimeInfoInterface
 	// physical dataset corresponding runtimeInfo, use getPhysicalRuntimeInfo instead of directly use this field.
 	physicalRuntimeInfo base.RuntimeInfoInterface
+	// physical runtime's engine which the data operations are forwarded to, use getPhysicalEngine instead of directly use this field.
+	physicalEngine base.Engine
 }
 
+// Operate forwards DataLoad and DataProcess on the reference dataset to the engine of the physical dataset,
+// other data operations are not supported.
 func (e *ReferenceDatasetEngine) Operate(ctx cruntime.ReconcileRequestContext, opStatus *v1alpha1.OperationStatus, operation dataoperation.OperationInterface) (ctrl.Result, error) {
 	object := operation.GetOperationObject()
-	// reference thin engine not support data operation
-	err := errors.NewNotSupported(
-		schema.GroupResource{
-			Group:    object.GetObjectKind().GroupVersionKind().Group,
-			Resource: object.GetObjectKind().GroupVersionKind().Kind,
-		}, "ThinRuntime")
-	ctx.Log.Error(err, "ThinRuntime for reference dataset does not support data operations")
-	ctx.Recorder.Event(object, v1.EventTypeWarning, common.DataOperationNotSupport, "thinEngine for reference dataset does not support data operations")
-	return utils.NoRequeue()
+	if !isOperationForwardable(operation.GetOperationType()) {
+		err := errors.NewNotSupported(
+			schema.GroupResource{
+				Group:    object.GetObjectKind().GroupVersionKind().Group,
+				Resource: object.GetObjectKind().GroupVersionKind().Kind,
+			}, "ThinRuntime")
+		ctx.Log.Error(err, "ThinRuntime for reference dataset does not support the data operation", "operationType", operation.GetOperationType())
+		ctx.Recorder.Eventf(object, v1.EventTypeWarning, common.DataOperationNotSupport, "thinEngine for reference dataset does not support %s", operation.GetOperationType())
+		return utils.NoRequeue()
+	}
+
+	if err := e.validateReference(ctx); err != nil {
+		ctx.Log.Error(err, "The reference dataset is not valid for data operations")
+		ctx.Recorder.Event(object, v1.EventTypeWarning, common.DataOperationNotValid, err.Error())
+		return utils.RequeueAfterInterval(20 * time.Second)
+	}
+
+	physicalEngine, physicalCtx, err := e.getPhysicalEngine(ctx)
+	if err != nil {
+		ctx.Log.Error(err, "Failed to get the engine of the physical dataset")
+		return utils.RequeueIfError(err)
+	}
+
+	physicalOperation, err := newPhysicalOperation(operation, ctx.Dataset)
+	if err != nil {
+		ctx.Log.Error(err, "Failed to forward the data operation to the physical dataset")
+		return utils.RequeueIfError(err)
+	}
+
+	return physicalEngine.Operate(physicalCtx, opStatus, physicalOperation)
 }
 
 // ID returns the id of the engine
@@ -172,18 +197,19 @@ func (e *ReferenceDatasetEngine) Setup(ctx cruntime.ReconcileRequestContext) (re
 		return false, err
 	}
 
-	// config map is for the fuse sidecar container
+	// the shareable resources and the fuse daemonset are for the fuse sidecar container
 	runtimeInfo, err := e.getPhysicalRuntimeInfo()
 	if err != nil {
 		return false, err
 	}
 
-	err = copyFuseDaemonSetForRefDataset(e.Client, dataset, runtimeInfo)
+	// shareable resources are synced before copying the fuse daemonset which may refer to the renamed copies
+	renamed, err := e.syncShareableResources(ctx, dataset)
 	if err != nil {
 		return false, err
 	}
 
-	err = e.createConfigMapForRefDataset(e.Client, dataset, runtimeInfo)
+	err = copyFuseDaemonSetForRefDataset(e.Client, dataset, runtimeInfo, renamed)
 	if err != nil {
 		return false, err
 	}
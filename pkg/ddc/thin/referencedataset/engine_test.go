@@ -173,6 +173,7 @@ func TestBuildReferenceDatasetThinEngine(t *testing.T) {
 }
 
 func TestReferenceDatasetEngine_Setup(t *testing.T) {
+	registerFakePhysicalEngine(t)
 	testScheme := runtime.NewScheme()
 	_ = v1.AddToScheme(testScheme)
 	_ = datav1alpha1.AddToScheme(testScheme)
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package referencedataset
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
+
+// RuntimeResolver gets the runtime object given its type, name and namespace, and infers its engine implementation.
+type RuntimeResolver func(client client.Client, runtimeType, name, namespace string) (runtime client.Object, engineImpl string, err error)
+
+// EngineCreator builds the engine chosen by ctx.EngineImpl.
+type EngineCreator func(id string, ctx cruntime.ReconcileRequestContext) (base.Engine, error)
+
+var (
+	resolvePhysicalRuntime RuntimeResolver
+	createPhysicalEngine   EngineCreator
+)
+
+// RegisterPhysicalEngineFactory registers the functions to build the engine of the physical runtime, which the data
+// operations on the reference dataset are forwarded to. It's called by the ddc package to avoid the import cycle.
+func RegisterPhysicalEngineFactory(resolver RuntimeResolver, creator EngineCreator) {
+	resolvePhysicalRuntime = resolver
+	createPhysicalEngine = creator
+}
+
+// isOperationForwardable checks if the data operation on the reference dataset can be forwarded to the physical engine.
+func isOperationForwardable(operationType dataoperation.OperationType) bool {
+	return operationType == dataoperation.DataLoadType || operationType == dataoperation.DataProcessType
+}
+
+// getPhysicalEngine returns the engine of the physical runtime and the reconcile context for it. The context keeps
+// the virtual dataset, so that the data operation locks the reference dataset instead of the physical one.
+func (e *ReferenceDatasetEngine) getPhysicalEngine(ctx cruntime.ReconcileRequestContext) (engine base.Engine, physicalCtx cruntime.ReconcileRequestContext, err error) {
+	if resolvePhysicalRuntime == nil || createPhysicalEngine == nil {
+		return nil, ctx, fmt.Errorf("the factory of the physical engine is not registered")
+	}
+
+	physicalRuntimeInfo, err := e.getPhysicalRuntimeInfo()
+	if err != nil {
+		return nil, ctx, err
+	}
+
+	physicalCtx = ctx
+	physicalCtx.NamespacedName = types.NamespacedName{
+		Name:      physicalRuntimeInfo.GetName(),
+		Namespace: physicalRuntimeInfo.GetNamespace(),
+	}
+	physicalCtx.RuntimeType = physicalRuntimeInfo.GetRuntimeType()
+	physicalCtx.Runtime, physicalCtx.EngineImpl, err = resolvePhysicalRuntime(e.Client, physicalRuntimeInfo.GetRuntimeType(),
+		physicalRuntimeInfo.GetName(), physicalRuntimeInfo.GetNamespace())
+	if err != nil {
+		return nil, ctx, err
+	}
+
+	if e.physicalEngine == nil {
+		// keep consistent with ddc.GenerateEngineID
+		id := fmt.Sprintf("%s-%s", physicalCtx.Namespace, physicalCtx.Name)
+		e.physicalEngine, err = createPhysicalEngine(id, physicalCtx)
+		if err != nil {
+			return nil, ctx, err
+		}
+		e.Log.Info("Build the physical engine for data operations", "physicalRuntime", physicalCtx.NamespacedName, "engineImpl", physicalCtx.EngineImpl)
+	}
+
+	return e.physicalEngine, physicalCtx, nil
+}
+
+// physicalOperation is a data operation on the reference dataset forwarded to the physical engine, the operation
+// object is rewritten to target the physical dataset.
+type physicalOperation struct {
+	dataoperation.OperationInterface
+	object client.Object
+}
+
+func (p *physicalOperation) GetOperationObject() client.Object {
+	return p.object
+}
+
+// newPhysicalOperation rewrites the data operation on the virtual dataset to the one on the physical dataset.
+func newPhysicalOperation(operation dataoperation.OperationInterface, virtualDataset *datav1alpha1.Dataset) (dataoperation.OperationInterface, error) {
+	object := operation.GetOperationObject()
+
+	// DataProcess mounts the pvc of the virtual dataset, no rewriting is needed.
+	dataLoad, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		return operation, nil
+	}
+
+	physicalDatasets := base.GetPhysicalDatasetFromMounts(virtualDataset.Spec.Mounts)
+	if len(physicalDatasets) != 1 {
+		return nil, fmt.Errorf("ThinEngine can only handle dataset only mounting one dataset")
+	}
+	var subPath string
+	if subPaths := base.GetPhysicalDatasetSubPath(virtualDataset); len(subPaths) > 0 {
+		subPath = strings.Trim(subPaths[0], "/")
+	}
+
+	physicalDataLoad := dataLoad.DeepCopy()
+	physicalDataLoad.Spec.Dataset = datav1alpha1.TargetDataset{
+		Name:      physicalDatasets[0].Name,
+		Namespace: physicalDatasets[0].Namespace,
+	}
+	if len(subPath) > 0 {
+		for i, target := range physicalDataLoad.Spec.Target {
+			// path.Join cleans the joined path, reject the target escaping the sub path with ".."
+			physicalPath := path.Join("/", subPath, target.Path)
+			if physicalPath != "/"+subPath && !strings.HasPrefix(physicalPath, "/"+subPath+"/") {
+				return nil, fmt.Errorf("target path %s is out of the dataset %s/%s", target.Path, virtualDataset.Namespace, virtualDataset.Name)
+			}
+			physicalDataLoad.Spec.Target[i].Path = physicalPath
+		}
+		// load the whole virtual dataset, i.e. the sub path of the physical dataset
+		if len(physicalDataLoad.Spec.Target) == 0 {
+			physicalDataLoad.Spec.Target = []datav1alpha1.TargetPath{{Path: "/" + subPath, Replicas: 1}}
+		}
+	}
+
+	return &physicalOperation{
+		OperationInterface: operation,
+		object:             physicalDataLoad,
+	}, nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package referencedataset
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+type fakeOperation struct {
+	dataoperation.OperationInterface
+	object        client.Object
+	operationType dataoperation.OperationType
+}
+
+func (f *fakeOperation) GetOperationObject() client.Object {
+	return f.object
+}
+
+func (f *fakeOperation) GetOperationType() dataoperation.OperationType {
+	return f.operationType
+}
+
+type fakePhysicalEngine struct {
+	base.Engine
+	ctx       cruntime.ReconcileRequestContext
+	operation dataoperation.OperationInterface
+	resources []base.ShareableResource
+	err       error
+}
+
+func (f *fakePhysicalEngine) GetShareableResources() ([]base.ShareableResource, error) {
+	return f.resources, f.err
+}
+
+// registerFakePhysicalEngine registers the factory building the fake physical engines, which declare the "-config"
+// configmap of the runtime and reject the vineyard runtime.
+func registerFakePhysicalEngine(t *testing.T) {
+	RegisterPhysicalEngineFactory(
+		func(client client.Client, runtimeType, name, namespace string) (client.Object, string, error) {
+			return &datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}, runtimeType, nil
+		},
+		func(id string, ctx cruntime.ReconcileRequestContext) (base.Engine, error) {
+			if ctx.RuntimeType == common.VineyardRuntime {
+				return &fakePhysicalEngine{err: fmt.Errorf("vineyard can't be mounted by reference datasets")}, nil
+			}
+			return &fakePhysicalEngine{resources: []base.ShareableResource{{Kind: base.ShareableConfigMap, Name: ctx.Name + "-config"}}}, nil
+		})
+	t.Cleanup(func() {
+		RegisterPhysicalEngineFactory(nil, nil)
+	})
+}
+
+func (f *fakePhysicalEngine) Operate(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus, operation dataoperation.OperationInterface) (ctrl.Result, error) {
+	f.ctx = ctx
+	f.operation = operation
+	return ctrl.Result{}, nil
+}
+
+func TestNewPhysicalOperation(t *testing.T) {
+	tests := []struct {
+		name       string
+		mountPoint string
+		target     []datav1alpha1.TargetPath
+		wantTarget []datav1alpha1.TargetPath
+		wantErr    bool
+	}{
+		{
+			name:       "sub path with targets",
+			mountPoint: "dataset://big-data/done/sub/dir",
+			target:     []datav1alpha1.TargetPath{{Path: "/a", Replicas: 2}, {Path: "b"}},
+			wantTarget: []datav1alpha1.TargetPath{{Path: "/sub/dir/a", Replicas: 2}, {Path: "/sub/dir/b"}},
+		},
+		{
+			name:       "sub path without targets",
+			mountPoint: "dataset://big-data/done/sub/",
+			wantTarget: []datav1alpha1.TargetPath{{Path: "/sub", Replicas: 1}},
+		},
+		{
+			name:       "sub path with target of parent dirs",
+			mountPoint: "dataset://big-data/done/sub/dir",
+			target:     []datav1alpha1.TargetPath{{Path: "/a/../.."}},
+			wantErr:    true,
+		},
+		{
+			name:       "sub path with target escaping",
+			mountPoint: "dataset://big-data/done/sub",
+			target:     []datav1alpha1.TargetPath{{Path: "../other"}},
+			wantErr:    true,
+		},
+		{
+			name:       "sub path with target escaping to sibling prefix",
+			mountPoint: "dataset://big-data/done/sub",
+			target:     []datav1alpha1.TargetPath{{Path: "../sub-other"}},
+			wantErr:    true,
+		},
+		{
+			name:       "sub path with target cleaned inside",
+			mountPoint: "dataset://big-data/done/sub",
+			target:     []datav1alpha1.TargetPath{{Path: "a/../b/./c"}},
+			wantTarget: []datav1alpha1.TargetPath{{Path: "/sub/b/c"}},
+		},
+		{
+			name:       "no sub path",
+			mountPoint: "dataset://big-data/done",
+			target:     []datav1alpha1.TargetPath{{Path: "/a"}},
+			wantTarget: []datav1alpha1.TargetPath{{Path: "/a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			virtualDataset := &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+				Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{MountPoint: tt.mountPoint}}},
+			}
+			dataLoad := &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec: datav1alpha1.DataLoadSpec{
+					Dataset: datav1alpha1.TargetDataset{Name: "hbase", Namespace: "fluid"},
+					Target:  tt.target,
+				},
+			}
+			operation, err := newPhysicalOperation(&fakeOperation{object: dataLoad, operationType: dataoperation.DataLoadType}, virtualDataset)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expect error, got target %v", operation.GetOperationObject().(*datav1alpha1.DataLoad).Spec.Target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			got := operation.GetOperationObject().(*datav1alpha1.DataLoad)
+			if got.Spec.Dataset != (datav1alpha1.TargetDataset{Name: "done", Namespace: "big-data"}) {
+				t.Errorf("expect the physical dataset as the target, got %v", got.Spec.Dataset)
+			}
+			if !reflect.DeepEqual(got.Spec.Target, tt.wantTarget) {
+				t.Errorf("expect target %v, got %v", tt.wantTarget, got.Spec.Target)
+			}
+			if got.Name != dataLoad.Name || dataLoad.Spec.Dataset.Name != "hbase" {
+				t.Errorf("expect the original DataLoad not to be modified, got %v", dataLoad.Spec)
+			}
+		})
+	}
+}
+
+func TestReferenceDatasetEngine_Operate(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	_ = appsv1.AddToScheme(testScheme)
+	objs := newTestDatasets(map[string]string{common.AnnotationReferenceAllowedNamespaces: "fluid"}, common.AlluxioRuntime, "oss://bucket/done")
+	c := fake.NewFakeClientWithScheme(testScheme, objs...)
+	virtualDataset := objs[1].(*datav1alpha1.Dataset)
+
+	physicalEngine := &fakePhysicalEngine{}
+	var createdEngines int
+	RegisterPhysicalEngineFactory(
+		func(client client.Client, runtimeType, name, namespace string) (client.Object, string, error) {
+			return &datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}, common.AlluxioEngineImpl, nil
+		},
+		func(id string, ctx cruntime.ReconcileRequestContext) (base.Engine, error) {
+			createdEngines++
+			return physicalEngine, nil
+		})
+	defer RegisterPhysicalEngineFactory(nil, nil)
+
+	e := &ReferenceDatasetEngine{
+		Client:    c,
+		Log:       fake.NullLogger(),
+		name:      "hbase",
+		namespace: "fluid",
+	}
+	ctx := cruntime.ReconcileRequestContext{
+		NamespacedName: types.NamespacedName{Name: "hbase", Namespace: "fluid"},
+		Dataset:        virtualDataset,
+		RuntimeType:    common.ThinRuntime,
+		EngineImpl:     common.ThinEngineImpl,
+		Client:         c,
+		Log:            fake.NullLogger(),
+		Recorder:       record.NewFakeRecorder(10),
+	}
+
+	dataLoad := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+		Spec:       datav1alpha1.DataLoadSpec{Dataset: datav1alpha1.TargetDataset{Name: "hbase"}},
+	}
+	for i := 0; i < 2; i++ {
+		_, err := e.Operate(ctx, &datav1alpha1.OperationStatus{}, &fakeOperation{object: dataLoad, operationType: dataoperation.DataLoadType})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	if createdEngines != 1 {
+		t.Errorf("expect the physical engine to be built once, got %d", createdEngines)
+	}
+	if physicalEngine.ctx.NamespacedName != (types.NamespacedName{Name: "done", Namespace: "big-data"}) ||
+		physicalEngine.ctx.EngineImpl != common.AlluxioEngineImpl || physicalEngine.ctx.RuntimeType != common.AlluxioRuntime {
+		t.Errorf("expect the context of the physical runtime, got %v", physicalEngine.ctx)
+	}
+	if physicalEngine.ctx.Dataset != virtualDataset {
+		t.Errorf("expect the virtual dataset kept in the context, got %v", physicalEngine.ctx.Dataset)
+	}
+	if got := physicalEngine.operation.GetOperationObject().(*datav1alpha1.DataLoad).Spec.Target; !reflect.DeepEqual(got, []datav1alpha1.TargetPath{{Path: "/sub", Replicas: 1}}) {
+		t.Errorf("expect the target rewritten to the sub path, got %v", got)
+	}
+
+	// data operations other than DataLoad and DataProcess are not forwarded
+	physicalEngine.operation = nil
+	dataBackup := &datav1alpha1.DataBackup{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "fluid"}}
+	_, err := e.Operate(ctx, &datav1alpha1.OperationStatus{}, &fakeOperation{object: dataBackup, operationType: dataoperation.DataBackupType})
+	if err != nil || physicalEngine.operation != nil {
+		t.Errorf("expect DataBackup not to be forwarded, got %v", err)
+	}
+}
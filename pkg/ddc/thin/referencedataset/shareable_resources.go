@@ -0,0 +1,345 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package referencedataset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// getDeclaredShareableResources returns the shareable resources declared by the engine of the physical runtime.
+func (e *ReferenceDatasetEngine) getDeclaredShareableResources(ctx cruntime.ReconcileRequestContext) ([]base.ShareableResource, error) {
+	engine, physicalCtx, err := e.getPhysicalEngine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	declarer, ok := engine.(base.ShareableResourcesDeclarer)
+	if !ok {
+		return nil, fmt.Errorf("the engine of runtime type %s doesn't declare the shareable resources", physicalCtx.RuntimeType)
+	}
+	return declarer.GetShareableResources()
+}
+
+// syncShareableResources copies the resources declared by the physical engine and the Secrets referenced by the
+// physical fuse to the namespace of the reference dataset, and keeps the copies up to date with the sources.
+// The Secrets are copied only if the physical dataset allows the namespace of the reference dataset.
+// It returns the new names of the copies renamed due to name collisions.
+func (e *ReferenceDatasetEngine) syncShareableResources(ctx cruntime.ReconcileRequestContext, refDataset *datav1alpha1.Dataset) (renamed map[base.ShareableResource]string, err error) {
+	physicalRuntimeInfo, err := e.getPhysicalRuntimeInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := e.getDeclaredShareableResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the reference dataset in the same namespace uses the resources of the physical runtime directly
+	if refDataset.Namespace == physicalRuntimeInfo.GetNamespace() {
+		return nil, nil
+	}
+
+	fuseSecrets, err := getFuseReferencedSecrets(e.Client, physicalRuntimeInfo)
+	if err != nil {
+		return nil, err
+	}
+	resources = appendSecrets(resources, fuseSecrets)
+
+	physicalDataset, err := utils.GetDataset(e.Client, physicalRuntimeInfo.GetName(), physicalRuntimeInfo.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkSecretsAllowedToShare(physicalDataset, refDataset.Namespace, resources); err != nil {
+		return nil, err
+	}
+
+	renamed = map[base.ShareableResource]string{}
+	for _, resource := range resources {
+		name, err := syncShareableResource(e.Client, resource, physicalRuntimeInfo.GetNamespace(), refDataset)
+		if err != nil {
+			return nil, err
+		}
+		if name != resource.Name {
+			e.Log.Info("The shareable resource is renamed due to name collision", "kind", resource.Kind, "name", resource.Name, "newName", name)
+			renamed[resource] = name
+		}
+	}
+	return renamed, nil
+}
+
+// syncShareableResource creates or updates the copy of the resource in the namespace of the reference dataset,
+// and returns the name of the copy. The copy has the same name as the source by default, and it's suffixed with
+// the name of the reference dataset if the name is taken by another object.
+func syncShareableResource(c client.Client, resource base.ShareableResource, srcNamespace string, refDataset *datav1alpha1.Dataset) (string, error) {
+	src, err := getShareableObject(c, resource.Kind, resource.Name, srcNamespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s %s/%s of the physical runtime: %v", resource.Kind, srcNamespace, resource.Name, err)
+	}
+
+	source := srcNamespace + "/" + resource.Name
+	// add owner reference to ensure the copy deleted when delete the dataset
+	ownerReference := metav1.OwnerReference{
+		APIVersion: refDataset.APIVersion,
+		Kind:       refDataset.Kind,
+		Name:       refDataset.Name,
+		UID:        refDataset.UID,
+	}
+
+	for _, name := range []string{resource.Name, resource.Name + "-" + refDataset.Name} {
+		dst, err := getShareableObject(c, resource.Kind, name, refDataset.Namespace)
+		if utils.IgnoreNotFound(err) != nil {
+			return "", err
+		}
+
+		if err != nil {
+			dst, err = newShareableObject(resource.Kind)
+			if err != nil {
+				return "", err
+			}
+			dst.SetName(name)
+			dst.SetNamespace(refDataset.Namespace)
+			dst.SetLabels(utils.UnionMapsWithOverride(src.GetLabels(), map[string]string{
+				common.LabelAnnotationDatasetId: utils.GetDatasetId(refDataset.Namespace, refDataset.Name, string(refDataset.UID)),
+			}))
+			dst.SetAnnotations(utils.UnionMapsWithOverride(src.GetAnnotations(), map[string]string{
+				common.AnnotationReferenceSharedFrom: source,
+			}))
+			dst.SetOwnerReferences([]metav1.OwnerReference{ownerReference})
+			copyShareableContent(dst, src)
+			return name, c.Create(context.TODO(), dst)
+		}
+
+		if !isCopyOf(dst, source, refDataset.UID) {
+			// the name is taken by an object which is not copied from the source
+			continue
+		}
+
+		changed := copyShareableContent(dst, src)
+		if !utils.ContainsOwners(dst.GetOwnerReferences(), refDataset) {
+			dst.SetOwnerReferences(append(dst.GetOwnerReferences(), ownerReference))
+			changed = true
+		}
+		if dst.GetAnnotations()[common.AnnotationReferenceSharedFrom] != source {
+			dst.SetAnnotations(utils.UnionMapsWithOverride(dst.GetAnnotations(), map[string]string{
+				common.AnnotationReferenceSharedFrom: source,
+			}))
+			changed = true
+		}
+		if changed {
+			return name, c.Update(context.TODO(), dst)
+		}
+		return name, nil
+	}
+
+	return "", fmt.Errorf("failed to copy %s %s to namespace %s because the names are taken by other objects", resource.Kind, source, refDataset.Namespace)
+}
+
+// isCopyOf checks if the object is copied from the source. The copies created before the source is recorded
+// are identified by the owner reference of the reference dataset.
+func isCopyOf(obj client.Object, source string, refDatasetUID types.UID) bool {
+	if sharedFrom, found := obj.GetAnnotations()[common.AnnotationReferenceSharedFrom]; found {
+		return sharedFrom == source
+	}
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.UID == refDatasetUID {
+			return true
+		}
+	}
+	return false
+}
+
+func newShareableObject(kind base.ShareableResourceKind) (client.Object, error) {
+	switch kind {
+	case base.ShareableConfigMap:
+		return &corev1.ConfigMap{}, nil
+	case base.ShareableSecret:
+		return &corev1.Secret{}, nil
+	default:
+		return nil, fmt.Errorf("shareable resource kind %s is not supported", kind)
+	}
+}
+
+func getShareableObject(c client.Client, kind base.ShareableResourceKind, name, namespace string) (client.Object, error) {
+	obj, err := newShareableObject(kind)
+	if err != nil {
+		return nil, err
+	}
+	err = c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
+	return obj, err
+}
+
+// copyShareableContent copies the data of the source to the copy, returns true if the copy is changed.
+func copyShareableContent(dst, src client.Object) (changed bool) {
+	switch src := src.(type) {
+	case *corev1.ConfigMap:
+		dst := dst.(*corev1.ConfigMap)
+		if reflect.DeepEqual(dst.Data, src.Data) && reflect.DeepEqual(dst.BinaryData, src.BinaryData) {
+			return false
+		}
+		dst.Data = src.Data
+		dst.BinaryData = src.BinaryData
+	case *corev1.Secret:
+		dst := dst.(*corev1.Secret)
+		if reflect.DeepEqual(dst.Data, src.Data) {
+			return false
+		}
+		// the type of secret is immutable
+		if len(dst.Type) == 0 {
+			dst.Type = src.Type
+		}
+		dst.Data = src.Data
+	}
+	return true
+}
+
+// getFuseReferencedSecrets returns the names of the Secrets referenced by the fuse of the physical runtime.
+func getFuseReferencedSecrets(c client.Client, physicalRuntimeInfo base.RuntimeInfoInterface) ([]string, error) {
+	fuseDs, err := kubeclient.GetDaemonset(c, getFuseDaemonSetName(physicalRuntimeInfo), physicalRuntimeInfo.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	return getReferencedSecrets(&fuseDs.Spec.Template.Spec), nil
+}
+
+// appendSecrets appends the Secrets to the resources if they are not in the resources yet.
+func appendSecrets(resources []base.ShareableResource, secretNames []string) []base.ShareableResource {
+	for _, secretName := range secretNames {
+		secret := base.ShareableResource{Kind: base.ShareableSecret, Name: secretName}
+		if !containsShareableResource(resources, secret) {
+			resources = append(resources, secret)
+		}
+	}
+	return resources
+}
+
+func containsShareableResource(resources []base.ShareableResource, resource base.ShareableResource) bool {
+	for _, r := range resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// getReferencedSecrets returns the names of the Secrets referenced by the pod spec.
+func getReferencedSecrets(spec *corev1.PodSpec) (secrets []string) {
+	add := func(name string) {
+		if len(name) > 0 && !utils.ContainsString(secrets, name) {
+			secrets = append(secrets, name)
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil {
+			add(volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					add(source.Secret.Name)
+				}
+			}
+		}
+	}
+	for _, container := range append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...) {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				add(env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				add(envFrom.SecretRef.Name)
+			}
+		}
+	}
+	for _, imagePullSecret := range spec.ImagePullSecrets {
+		add(imagePullSecret.Name)
+	}
+	return
+}
+
+// renameReferencedResources rewrites the references to the ConfigMaps and Secrets in the pod spec to the renamed copies.
+func renameReferencedResources(spec *corev1.PodSpec, renamed map[base.ShareableResource]string) {
+	if len(renamed) == 0 {
+		return
+	}
+	rename := func(kind base.ShareableResourceKind, name *string) {
+		if newName, found := renamed[base.ShareableResource{Kind: kind, Name: *name}]; found {
+			*name = newName
+		}
+	}
+	for i := range spec.Volumes {
+		volume := &spec.Volumes[i]
+		if volume.ConfigMap != nil {
+			rename(base.ShareableConfigMap, &volume.ConfigMap.Name)
+		}
+		if volume.Secret != nil {
+			rename(base.ShareableSecret, &volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for j := range volume.Projected.Sources {
+				source := &volume.Projected.Sources[j]
+				if source.ConfigMap != nil {
+					rename(base.ShareableConfigMap, &source.ConfigMap.Name)
+				}
+				if source.Secret != nil {
+					rename(base.ShareableSecret, &source.Secret.Name)
+				}
+			}
+		}
+	}
+	for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			container := &containers[i]
+			for j := range container.Env {
+				if valueFrom := container.Env[j].ValueFrom; valueFrom != nil {
+					if valueFrom.ConfigMapKeyRef != nil {
+						rename(base.ShareableConfigMap, &valueFrom.ConfigMapKeyRef.Name)
+					}
+					if valueFrom.SecretKeyRef != nil {
+						rename(base.ShareableSecret, &valueFrom.SecretKeyRef.Name)
+					}
+				}
+			}
+			for j := range container.EnvFrom {
+				if container.EnvFrom[j].ConfigMapRef != nil {
+					rename(base.ShareableConfigMap, &container.EnvFrom[j].ConfigMapRef.Name)
+				}
+				if container.EnvFrom[j].SecretRef != nil {
+					rename(base.ShareableSecret, &container.EnvFrom[j].SecretRef.Name)
+				}
+			}
+		}
+	}
+	for i := range spec.ImagePullSecrets {
+		rename(base.ShareableSecret, &spec.ImagePullSecrets[i].Name)
+	}
+}
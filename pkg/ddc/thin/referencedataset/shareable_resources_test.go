@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package referencedataset
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestSyncShareableResources(t *testing.T) {
+	registerFakePhysicalEngine(t)
+	testScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(testScheme)
+	_ = appsv1.AddToScheme(testScheme)
+	_ = datav1alpha1.AddToScheme(testScheme)
+
+	objs := newTestDatasets(map[string]string{common.AnnotationReferenceSecretsAllowedNamespaces: "fluid"}, common.AlluxioRuntime, "oss://bucket/done")
+	refDataset := objs[1].(*datav1alpha1.Dataset)
+	refDataset.UID = "ref-uid"
+
+	configCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "done-config", Namespace: "big-data", Labels: map[string]string{"app": "alluxio"}},
+		Data:       map[string]string{"alluxio-env": "new"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "oss-secret", Namespace: "big-data"},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"ak": []byte("ak")},
+	}
+	fuseDs := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "done-fuse", Namespace: "big-data"},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "fuse",
+						Env: []corev1.EnvVar{{
+							Name: "AK",
+							ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "oss-secret"},
+								Key:                  "ak",
+							}},
+						}},
+					}},
+				},
+			},
+		},
+	}
+	// the copy of the configmap is out of date
+	staleCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "done-config",
+			Namespace:   "fluid",
+			Annotations: map[string]string{common.AnnotationReferenceSharedFrom: "big-data/done-config"},
+		},
+		Data: map[string]string{"alluxio-env": "old"},
+	}
+	// the name of the secret is taken by the user's secret
+	userSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "oss-secret", Namespace: "fluid"},
+		Data:       map[string][]byte{"ak": []byte("user")},
+	}
+
+	objs = append(objs, configCM, secret, fuseDs, staleCM, userSecret)
+	fakeClient := fake.NewFakeClientWithScheme(testScheme, objs...)
+
+	e := &ReferenceDatasetEngine{
+		Id:        "fluid-hbase",
+		Client:    fakeClient,
+		Log:       fake.NullLogger(),
+		name:      "hbase",
+		namespace: "fluid",
+	}
+	ctx := cruntime.ReconcileRequestContext{Client: fakeClient, Log: fake.NullLogger()}
+
+	renamed, err := e.syncShareableResources(ctx, refDataset)
+	if err != nil {
+		t.Fatalf("failed to sync shareable resources: %v", err)
+	}
+	wantRenamed := map[base.ShareableResource]string{{Kind: base.ShareableSecret, Name: "oss-secret"}: "oss-secret-hbase"}
+	if !reflect.DeepEqual(renamed, wantRenamed) {
+		t.Errorf("expect renamed %v, got %v", wantRenamed, renamed)
+	}
+
+	copiedCM := &corev1.ConfigMap{}
+	if err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "done-config"}, copiedCM); err != nil {
+		t.Fatalf("failed to get copied configmap: %v", err)
+	}
+	if !reflect.DeepEqual(copiedCM.Data, configCM.Data) || len(copiedCM.OwnerReferences) != 1 {
+		t.Errorf("expect the copied configmap to be updated, got %v", copiedCM)
+	}
+
+	copiedSecret := &corev1.Secret{}
+	if err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "oss-secret-hbase"}, copiedSecret); err != nil {
+		t.Fatalf("failed to get copied secret: %v", err)
+	}
+	if !reflect.DeepEqual(copiedSecret.Data, secret.Data) || copiedSecret.Annotations[common.AnnotationReferenceSharedFrom] != "big-data/oss-secret" {
+		t.Errorf("unexpected copied secret %v", copiedSecret)
+	}
+
+	if err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "oss-secret"}, userSecret); err != nil {
+		t.Fatalf("failed to get user secret: %v", err)
+	}
+	if string(userSecret.Data["ak"]) != "user" {
+		t.Errorf("expect the user's secret untouched, got %v", userSecret)
+	}
+
+	// the secrets are not copied without the permission of the physical dataset
+	physicalDataset := objs[0].(*datav1alpha1.Dataset)
+	physicalDataset.Annotations = nil
+	if err = fakeClient.Update(context.TODO(), physicalDataset); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = e.syncShareableResources(ctx, refDataset); err == nil || !strings.Contains(err.Error(), "not allowed to be shared") {
+		t.Errorf("expect the secrets not allowed to be shared, got %v", err)
+	}
+}
+
+func TestRenameReferencedResources(t *testing.T) {
+	spec := &corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "done-config"},
+			}}},
+			{Name: "secret", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "oss-secret"}}},
+		},
+		Containers: []corev1.Container{{
+			Name:    "fuse",
+			EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "oss-secret"}}}},
+		}},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+	}
+
+	renameReferencedResources(spec, map[base.ShareableResource]string{
+		{Kind: base.ShareableConfigMap, Name: "done-config"}: "done-config-hbase",
+		{Kind: base.ShareableSecret, Name: "oss-secret"}:     "oss-secret-hbase",
+		// the configmap with the same name as the secret should not be confused
+		{Kind: base.ShareableConfigMap, Name: "registry"}: "registry-hbase",
+	})
+
+	if got := spec.Volumes[0].ConfigMap.Name; got != "done-config-hbase" {
+		t.Errorf("expect the configmap volume renamed, got %s", got)
+	}
+	if got := spec.Volumes[1].Secret.SecretName; got != "oss-secret-hbase" {
+		t.Errorf("expect the secret volume renamed, got %s", got)
+	}
+	if got := spec.Containers[0].EnvFrom[0].SecretRef.Name; got != "oss-secret-hbase" {
+		t.Errorf("expect the secret env renamed, got %s", got)
+	}
+	if got := spec.ImagePullSecrets[0].Name; got != "registry" {
+		t.Errorf("expect the image pull secret untouched, got %s", got)
+	}
+}
@@ -105,11 +105,19 @@ func (e *ReferenceDatasetEngine) Sync(ctx cruntime.ReconcileRequestContext) (err
 
 	if !reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
 		err = e.Client.Status().Update(context.TODO(), runtimeToUpdate)
+		if err != nil {
+			return
+		}
 	} else {
 		e.Log.Info("Do nothing because the runtime status is not changed.")
 	}
 
-	return
+	// 3. keep the copies of the shareable resources and the fuse daemonset up to date with the physical runtime
+	renamed, err := e.syncShareableResources(ctx, virtualDataset)
+	if err != nil {
+		return err
+	}
+	return copyFuseDaemonSetForRefDataset(e.Client, virtualDataset, physicalRuntimeInfo, renamed)
 }
 
 func getSyncRetryDuration() (d *time.Duration, err error) {
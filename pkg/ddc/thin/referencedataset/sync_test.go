@@ -18,6 +18,7 @@ package referencedataset
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 )
 
 func TestReferenceDatasetEngine_Sync(t *testing.T) {
+	registerFakePhysicalEngine(t)
 	testScheme := runtime.NewScheme()
 	_ = v1.AddToScheme(testScheme)
 	_ = datav1alpha1.AddToScheme(testScheme)
@@ -89,7 +91,52 @@ func TestReferenceDatasetEngine_Sync(t *testing.T) {
 		},
 	}
 
-	testObjs = append(testObjs, &dataset, &refDataset)
+	var configCM = v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "done-config",
+			Namespace: "big-data",
+		},
+		Data: map[string]string{
+			"check.sh": "/bin/sh check",
+		},
+	}
+	// the copy is out of date
+	var copiedConfigCM = v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "done-config",
+			Namespace:   "fluid",
+			Annotations: map[string]string{common.AnnotationReferenceSharedFrom: "big-data/done-config"},
+		},
+		Data: map[string]string{
+			"check.sh": "/bin/sh old",
+		},
+	}
+	var fuseDs = appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "done-fuse",
+			Namespace: "big-data",
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "fuse", Image: "fuse:v2"}}},
+			},
+		},
+	}
+	// the copy of the fuse daemonset is out of date
+	var copiedFuseDs = appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-fuse",
+			Namespace: "fluid",
+			Labels:    map[string]string{common.App: common.ThinRuntime},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "fuse", Image: "fuse:v1"}}},
+			},
+		},
+	}
+
+	testObjs = append(testObjs, &dataset, &refDataset, &configCM, &copiedConfigCM, &fuseDs, &copiedFuseDs)
 
 	testObjs = append(testObjs, &runtime, &refRuntime)
 	fakeClient := fake.NewFakeClientWithScheme(testScheme, testObjs...)
@@ -164,5 +211,19 @@ func TestReferenceDatasetEngine_Sync(t *testing.T) {
 			t.Errorf("Dataset bound runtime info wrong %v", boundRuntime)
 		}
 
+		// check the copied configmap is synced
+		updatedConfigCM := &v1.ConfigMap{}
+		err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "done-config"}, updatedConfigCM)
+		if err != nil || !reflect.DeepEqual(updatedConfigCM.Data, configCM.Data) {
+			t.Errorf("The copied configmap is not synced, got %v, err %v", updatedConfigCM.Data, err)
+		}
+
+		// check the copied fuse daemonset is synced
+		updatedFuseDs := &appsv1.DaemonSet{}
+		err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-fuse"}, updatedFuseDs)
+		if err != nil || updatedFuseDs.Spec.Template.Spec.Containers[0].Image != "fuse:v2" ||
+			updatedFuseDs.Spec.Template.Spec.NodeSelector["fluid.io/fuse-balloon"] != "true" {
+			t.Errorf("The copied fuse daemonset is not synced, got %v, err %v", updatedFuseDs.Spec.Template, err)
+		}
 	}
 }
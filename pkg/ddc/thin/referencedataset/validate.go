@@ -17,11 +17,17 @@ limitations under the License.
 package referencedataset
 
 import (
+	"fmt"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
 
-func (e *ReferenceDatasetEngine) Validate(runtime.ReconcileRequestContext) (err error) {
+func (e *ReferenceDatasetEngine) Validate(ctx runtime.ReconcileRequestContext) (err error) {
 	// XXXEngine.runtimeInfo must have full information about the bound dataset for further reconcilation.
 	// getRuntimeInfo() here is a refresh to make sure the information is correctly set
 	runtimeInfo, err := e.getRuntimeInfo()
@@ -34,6 +40,98 @@ func (e *ReferenceDatasetEngine) Validate(runtime.ReconcileRequestContext) (err
 		return err
 	}
 
-	// TODO: impl validation logic for AlluxioEngine
-	return nil
+	return e.validateReference(ctx)
+}
+
+// validateReference checks if the physical dataset can be referenced by the virtual dataset.
+func (e *ReferenceDatasetEngine) validateReference(ctx runtime.ReconcileRequestContext) error {
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		// not found dataset error indicates the runtime is deleting, pass the validation
+		return utils.IgnoreNotFound(err)
+	}
+
+	physicalDatasetNamespacedNames := base.GetPhysicalDatasetFromMounts(dataset.Spec.Mounts)
+	if len(physicalDatasetNamespacedNames) != 1 {
+		return fmt.Errorf("ThinRuntime can only handle dataset only mounting one dataset")
+	}
+	namespacedName := physicalDatasetNamespacedNames[0]
+
+	physicalDataset, err := utils.GetDataset(e.Client, namespacedName.Name, namespacedName.Namespace)
+	if err != nil {
+		return err
+	}
+
+	// 1. the physical dataset must not be a reference dataset
+	if len(base.GetPhysicalDatasetFromMounts(physicalDataset.Spec.Mounts)) != 0 {
+		return fmt.Errorf("dataset %s references dataset %s which is also a reference dataset, nested reference is not supported",
+			dataset.Name, namespacedName)
+	}
+
+	// 2. the physical dataset must allow the namespace of the reference dataset if it restricts the namespaces
+	if !isNamespaceAllowedToReference(physicalDataset, dataset.Namespace) {
+		return fmt.Errorf("dataset %s is not allowed to be referenced from namespace %s, add the namespace to the annotation %s of the dataset to allow it",
+			namespacedName, dataset.Namespace, common.AnnotationReferenceAllowedNamespaces)
+	}
+
+	// 3. the physical runtime must declare the resources shared with the reference dataset
+	resources, err := e.getDeclaredShareableResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 4. the physical dataset must allow copying its Secrets to the namespace of the reference dataset
+	if physicalDataset.Namespace == dataset.Namespace {
+		return nil
+	}
+	physicalRuntimeInfo, err := e.getPhysicalRuntimeInfo()
+	if err != nil {
+		return err
+	}
+	// the fuse of the physical runtime may be not created yet, its Secrets are checked again when they are copied
+	fuseSecrets, err := getFuseReferencedSecrets(e.Client, physicalRuntimeInfo)
+	if utils.IgnoreNotFound(err) != nil {
+		return err
+	}
+	return checkSecretsAllowedToShare(physicalDataset, dataset.Namespace, appendSecrets(resources, fuseSecrets))
+}
+
+// checkSecretsAllowedToShare checks if the Secrets in the resources are allowed to be copied to the namespace.
+func checkSecretsAllowedToShare(physicalDataset *datav1alpha1.Dataset, namespace string, resources []base.ShareableResource) error {
+	var secrets []string
+	for _, resource := range resources {
+		if resource.Kind == base.ShareableSecret {
+			secrets = append(secrets, resource.Name)
+		}
+	}
+	if len(secrets) == 0 || isNamespaceInList(physicalDataset.Annotations[common.AnnotationReferenceSecretsAllowedNamespaces], namespace) {
+		return nil
+	}
+	return fmt.Errorf("the secrets %v of dataset %s/%s are not allowed to be shared with namespace %s, add the namespace to the annotation %s of the dataset to allow it",
+		secrets, physicalDataset.Namespace, physicalDataset.Name, namespace, common.AnnotationReferenceSecretsAllowedNamespaces)
+}
+
+// isNamespaceAllowedToReference checks if the datasets in the namespace are allowed to reference the physical dataset.
+// The namespaces are restricted only when the physical dataset has the allowed namespaces annotation, so that the
+// existing cross-namespace references keep working.
+func isNamespaceAllowedToReference(physicalDataset *datav1alpha1.Dataset, namespace string) bool {
+	if physicalDataset.Namespace == namespace {
+		return true
+	}
+	allowedNamespaces, restricted := physicalDataset.Annotations[common.AnnotationReferenceAllowedNamespaces]
+	if !restricted {
+		return true
+	}
+	return isNamespaceInList(allowedNamespaces, namespace)
+}
+
+// isNamespaceInList checks if the namespace is in the comma separated list of namespaces, "*" matches all the namespaces.
+func isNamespaceInList(namespaces string, namespace string) bool {
+	for _, allowed := range strings.Split(namespaces, ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || allowed == namespace {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package referencedataset
+
+import (
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func newTestDatasets(physicalAnnotations map[string]string, physicalRuntimeType string, physicalMountPoint string) []runtime.Object {
+	return []runtime.Object{
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data", Annotations: physicalAnnotations},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts: []datav1alpha1.Mount{{MountPoint: physicalMountPoint}},
+			},
+			Status: datav1alpha1.DatasetStatus{
+				Runtimes: []datav1alpha1.Runtime{{Name: "done", Namespace: "big-data", Type: physicalRuntimeType}},
+			},
+		},
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts: []datav1alpha1.Mount{{MountPoint: "dataset://big-data/done/sub"}},
+			},
+		},
+		&datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"}},
+		&datav1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"}},
+		&datav1alpha1.ThinRuntime{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"}},
+		&datav1alpha1.VineyardRuntime{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"}},
+	}
+}
+
+func TestReferenceDatasetEngine_validateReference(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	_ = appsv1.AddToScheme(testScheme)
+	registerFakePhysicalEngine(t)
+
+	secretFuseDs := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "done-fuse", Namespace: "big-data"},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Volumes: []corev1.Volume{{
+						Name:         "secret",
+						VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "oss-secret"}},
+					}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		annotations         map[string]string
+		physicalRuntimeType string
+		physicalMountPoint  string
+		objs                []runtime.Object
+		wantErr             string
+	}{
+		{
+			name:                "allowed namespace",
+			annotations:         map[string]string{common.AnnotationReferenceAllowedNamespaces: "default, fluid"},
+			physicalRuntimeType: common.AlluxioRuntime,
+			physicalMountPoint:  "oss://bucket/done",
+		},
+		{
+			name:                "all namespaces allowed",
+			annotations:         map[string]string{common.AnnotationReferenceAllowedNamespaces: "*"},
+			physicalRuntimeType: common.JuiceFSRuntime,
+			physicalMountPoint:  "juicefs:///",
+		},
+		{
+			name:                "namespaces not restricted",
+			physicalRuntimeType: common.AlluxioRuntime,
+			physicalMountPoint:  "oss://bucket/done",
+		},
+		{
+			name:                "namespace not allowed",
+			annotations:         map[string]string{common.AnnotationReferenceAllowedNamespaces: "default"},
+			physicalRuntimeType: common.AlluxioRuntime,
+			physicalMountPoint:  "oss://bucket/done",
+			wantErr:             "not allowed to be referenced from namespace fluid",
+		},
+		{
+			name:                "secrets allowed to be shared",
+			annotations:         map[string]string{common.AnnotationReferenceSecretsAllowedNamespaces: "fluid"},
+			physicalRuntimeType: common.AlluxioRuntime,
+			physicalMountPoint:  "oss://bucket/done",
+			objs:                []runtime.Object{secretFuseDs},
+		},
+		{
+			name:                "secrets not allowed to be shared",
+			annotations:         map[string]string{common.AnnotationReferenceAllowedNamespaces: "fluid"},
+			physicalRuntimeType: common.AlluxioRuntime,
+			physicalMountPoint:  "oss://bucket/done",
+			objs:                []runtime.Object{secretFuseDs},
+			wantErr:             "secrets [oss-secret] of dataset big-data/done are not allowed to be shared with namespace fluid",
+		},
+		{
+			name:                "nested reference",
+			annotations:         map[string]string{common.AnnotationReferenceAllowedNamespaces: "*"},
+			physicalRuntimeType: common.ThinRuntime,
+			physicalMountPoint:  "dataset://default/other",
+			wantErr:             "nested reference is not supported",
+		},
+		{
+			name:                "unsupported runtime type",
+			annotations:         map[string]string{common.AnnotationReferenceAllowedNamespaces: "*"},
+			physicalRuntimeType: common.VineyardRuntime,
+			physicalMountPoint:  "",
+			wantErr:             "vineyard can't be mounted by reference datasets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &ReferenceDatasetEngine{
+				Client:    fake.NewFakeClientWithScheme(testScheme, append(newTestDatasets(tt.annotations, tt.physicalRuntimeType, tt.physicalMountPoint), tt.objs...)...),
+				Log:       fake.NullLogger(),
+				name:      "hbase",
+				namespace: "fluid",
+			}
+			err := e.validateReference(cruntime.ReconcileRequestContext{Log: fake.NullLogger()})
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("expect no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expect error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestIsNamespaceAllowedToReference(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"}}
+	if !isNamespaceAllowedToReference(dataset, "big-data") {
+		t.Errorf("expect the same namespace to be allowed")
+	}
+	if !isNamespaceAllowedToReference(dataset, "fluid") {
+		t.Errorf("expect other namespaces to be allowed without the annotation")
+	}
+
+	dataset.Annotations = map[string]string{common.AnnotationReferenceAllowedNamespaces: ""}
+	if isNamespaceAllowedToReference(dataset, "fluid") {
+		t.Errorf("expect other namespaces not to be allowed with the empty annotation")
+	}
+}
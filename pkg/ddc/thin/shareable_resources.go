@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+)
+
+// GetShareableResources returns the fuse config, which is stored in either a configmap or a secret
+func (t *ThinEngine) GetShareableResources() ([]base.ShareableResource, error) {
+	kind := base.ShareableConfigMap
+	if strings.ToLower(getFuseConfigStorage()) == "secret" {
+		kind = base.ShareableSecret
+	}
+	return []base.ShareableResource{
+		{Kind: kind, Name: t.getFuseConfigMapName()},
+	}, nil
+}
@@ -16,8 +16,19 @@
 
 package thin
 
-import cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+import (
+	"k8s.io/apimachinery/pkg/types"
 
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
+
+// SyncRuntime syncs the runtime spec
 func (t ThinEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (changed bool, err error) {
+	runtime, err := t.getRuntime()
+	if err != nil {
+		return
+	}
+
+	err = t.Helper.SyncPodDisruptionBudget(types.NamespacedName{Namespace: t.namespace, Name: t.getWorkerName()}, runtime.Spec.Worker.PodDisruptionBudget)
 	return
 }
@@ -17,40 +17,67 @@
 package thin
 
 import (
+	"context"
 	"testing"
 
-	"github.com/fluid-cloudnative/fluid/pkg/runtime"
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
-func TestThinEngine_SyncRuntime(t1 *testing.T) {
-	type fields struct{}
-	type args struct {
-		ctx runtime.ReconcileRequestContext
+func TestThinEngine_SyncRuntime(t *testing.T) {
+	runtimeObj := &datav1alpha1.ThinRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.ThinRuntimeSpec{
+			Worker: datav1alpha1.ThinCompTemplateSpec{
+				PodDisruptionBudget: &datav1alpha1.PodDisruptionBudgetSpec{},
+			},
+		},
 	}
-	tests := []struct {
-		name        string
-		fields      fields
-		args        args
-		wantChanged bool
-		wantErr     bool
-	}{
-		{
-			name:        "default",
-			wantChanged: false,
-			wantErr:     false,
+	workerSts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-worker",
+			Namespace: "fluid",
 		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"release": "hbase"}},
+		},
+	}
+
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	_ = policyv1.AddToScheme(s)
+	client := fake.NewFakeClientWithScheme(s, runtimeObj, workerSts)
+
+	engine := &ThinEngine{
+		name:      "hbase",
+		namespace: "fluid",
+		Client:    client,
+		Log:       fake.NullLogger(),
+		Helper:    ctrl.BuildHelper(nil, client, fake.NullLogger()),
+	}
+	changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{})
+	if err != nil || changed {
+		t.Fatalf("ThinEngine.SyncRuntime() = %v, %v, want false, nil", changed, err)
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-worker"}, pdb)
+	if err != nil {
+		t.Fatalf("expect PodDisruptionBudget of the workers created, got %v", err)
 	}
-	for _, tt := range tests {
-		t1.Run(tt.name, func(t1 *testing.T) {
-			t := ThinEngine{}
-			gotChanged, err := t.SyncRuntime(tt.args.ctx)
-			if (err != nil) != tt.wantErr {
-				t1.Errorf("SyncRuntime() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if gotChanged != tt.wantChanged {
-				t1.Errorf("SyncRuntime() gotChanged = %v, want %v", gotChanged, tt.wantChanged)
-			}
-		})
+	if pdb.Spec.MaxUnavailable == nil || pdb.Spec.MaxUnavailable.IntValue() != 1 {
+		t.Errorf("expect default maxUnavailable 1, got %v", pdb.Spec.MaxUnavailable)
 	}
 }
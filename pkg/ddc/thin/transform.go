@@ -123,6 +123,14 @@ func (t *ThinEngine) transformWorkers(runtime *datav1alpha1.ThinRuntime, profile
 	}
 	// 9. network
 	value.Worker.HostNetwork = datav1alpha1.IsHostNetwork(runtime.Spec.Worker.NetworkMode)
+
+	// 10. scheduling policies
+	if len(runtime.Spec.Worker.PriorityClassName) != 0 {
+		value.Worker.PriorityClassName = runtime.Spec.Worker.PriorityClassName
+	}
+	if len(runtime.Spec.Worker.TopologySpreadConstraints) != 0 {
+		value.Worker.TopologySpreadConstraints = runtime.Spec.Worker.TopologySpreadConstraints
+	}
 	return
 }
 
@@ -186,6 +194,9 @@ func (t *ThinEngine) parseFromProfile(profile *datav1alpha1.ThinRuntimeProfile,
 	}
 	// 8. network
 	value.Worker.HostNetwork = datav1alpha1.IsHostNetwork(profile.Spec.Worker.NetworkMode)
+	// 9. scheduling policies
+	value.Worker.PriorityClassName = profile.Spec.Worker.PriorityClassName
+	value.Worker.TopologySpreadConstraints = profile.Spec.Worker.TopologySpreadConstraints
 }
 
 func (t *ThinEngine) transformTolerations(dataset *datav1alpha1.Dataset, value *ThinValue) {
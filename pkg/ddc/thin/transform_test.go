@@ -126,7 +126,8 @@ func TestThinEngine_parseFromProfile(t1 *testing.T) {
 					SuccessThreshold:    1,
 					FailureThreshold:    1,
 				},
-				NetworkMode: datav1alpha1.HostNetworkMode,
+				NetworkMode:       datav1alpha1.HostNetworkMode,
+				PriorityClassName: "high-priority",
 			},
 		},
 	}
@@ -139,7 +140,8 @@ func TestThinEngine_parseFromProfile(t1 *testing.T) {
 				Requests: map[corev1.ResourceName]string{},
 				Limits:   map[corev1.ResourceName]string{},
 			},
-			HostNetwork: true,
+			HostNetwork:       true,
+			PriorityClassName: "high-priority",
 			Envs: []corev1.EnvVar{{
 				Name:  "a",
 				Value: "b",
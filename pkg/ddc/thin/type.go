@@ -19,6 +19,7 @@ package thin
 import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -55,6 +56,9 @@ type Worker struct {
 	ReadinessProbe   *corev1.Probe                 `json:"readinessProbe,omitempty"`
 	CacheDir         string                        `json:"cacheDir,omitempty"`
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Fuse struct {
@@ -93,3 +97,21 @@ type Config struct {
 	PersistentVolumeMountOptions map[string][]string                          `json:"persistentVolumeMountOptions,omitempty"`
 	AccessModes                  []corev1.PersistentVolumeAccessMode          `json:"accessModes,omitempty"`
 }
+
+// DataLoadValue is the value of the thin dataloader chart, it extends the common DataLoad values with
+// the loader container defined in the ThinRuntimeProfile.
+type DataLoadValue struct {
+	cdataload.DataLoadValue `json:",inline"`
+
+	Loader DataLoader `json:"loader"`
+}
+
+type DataLoader struct {
+	ImagePullPolicy string               `json:"imagePullPolicy,omitempty"`
+	Command         []string             `json:"command,omitempty"`
+	Args            []string             `json:"args,omitempty"`
+	Envs            []corev1.EnvVar      `json:"envs,omitempty"`
+	Volumes         []corev1.Volume      `json:"volumes,omitempty"`
+	VolumeMounts    []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	DataMountPath   string               `json:"dataMountPath"`
+}
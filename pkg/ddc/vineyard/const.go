@@ -54,4 +54,10 @@ const (
 	DefaultSize = "0"
 
 	DefaultEtcdPrefix = "/vineyard"
+
+	// the host path where the vineyard workers put their IPC sockets
+	workerSocketHostPathRoot = "/runtime-mnt/vineyard"
+
+	// the directory where the secrets referred by the encrypt options are mounted in the dataload pod
+	dataLoadSecretMountPath = "/etc/fluid/secrets"
 )
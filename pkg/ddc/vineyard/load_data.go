@@ -0,0 +1,240 @@
+/*
+Copyright 2025 The Fluid Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vineyard
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// generateDataLoadValueFile builds a DataLoadValue by extracted specifications from the given DataLoad, and
+// marshals the DataLoadValue to a temporary yaml file where stores values that'll be used by fluid dataloader helm chart.
+// The loader reads the target paths from the mounts of the dataset and puts them into the vineyard worker on the same node.
+func (e *VineyardEngine) generateDataLoadValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataload, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataLoad", object)
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(e.Client, dataload.Spec.Dataset.Name, dataload.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	dataLoadValue, err := e.genDataLoadValue(targetDataset, dataload)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataLoadValue)
+	if err != nil {
+		return
+	}
+	e.Log.Info("dataload value", "value", string(data))
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-loader-values.yaml", dataload.Namespace, dataload.Name))
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return
+	}
+	return valueFile.Name(), nil
+}
+
+func (e *VineyardEngine) genDataLoadValue(targetDataset *datav1alpha1.Dataset, dataload *datav1alpha1.DataLoad) (*DataLoadValue, error) {
+	dataloadInfo := cdataload.DataLoadInfo{
+		BackoffLimit:     3,
+		TargetDataset:    dataload.Spec.Dataset.Name,
+		LoadMetadata:     dataload.Spec.LoadMetadata,
+		Image:            getDataLoadImage(),
+		Labels:           dataload.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataload.Annotations, dataload.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+		Policy:           string(dataload.Spec.Policy),
+		Schedule:         dataload.Spec.Schedule,
+		Resources:        dataload.Spec.Resources,
+		Affinity:         dataload.Spec.Affinity,
+		NodeSelector:     dataload.Spec.NodeSelector,
+		Tolerations:      dataload.Spec.Tolerations,
+		SchedulerName:    dataload.Spec.SchedulerName,
+		Options:          dataload.Spec.Options,
+	}
+
+	// generate the node affinity by previous operation pod.
+	var err error
+	dataloadInfo.Affinity, err = dataflow.InjectAffinityByPrecedingOps(e.Client, dataload, dataloadInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+	// the loader connects to the vineyard worker through the IPC socket, so it must run on the node of a vineyard worker
+	dataloadInfo.Affinity = e.injectWorkerPodAffinity(dataloadInfo.Affinity)
+
+	loader := DataLoader{
+		SocketHostPath: e.getWorkerSocketHostPath(),
+	}
+	for _, target := range dataload.Spec.Target {
+		path := strings.TrimSpace(target.Path)
+		dataloadInfo.TargetPaths = append(dataloadInfo.TargetPaths, cdataload.TargetPath{
+			Path:     path,
+			Replicas: target.Replicas,
+		})
+
+		source, err := genDataLoadSource(path, targetDataset, &loader)
+		if err != nil {
+			return nil, err
+		}
+		loader.Sources = append(loader.Sources, source)
+	}
+
+	dataLoadValue := &DataLoadValue{
+		DataLoadValue: cdataload.DataLoadValue{
+			Name:           dataload.Name,
+			OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+			DataLoadInfo:   dataloadInfo,
+			Owner:          transformer.GenerateOwnerReferenceFromObject(dataload),
+		},
+		Loader: loader,
+	}
+
+	return dataLoadValue, nil
+}
+
+// genDataLoadSource finds the mount of the dataset where the target path locates, and converts the target path to
+// the location in the underlying storage. The secrets referred by the encrypt options are mounted into the loader.
+func genDataLoadSource(path string, targetDataset *datav1alpha1.Dataset, loader *DataLoader) (source DataLoadSource, err error) {
+	var mount *datav1alpha1.Mount
+	var mountPath string
+	for i, m := range targetDataset.Spec.Mounts {
+		mPath := utils.UFSPathBuilder{}.GenUFSPathInUnifiedNamespace(m)
+		// pick the innermost mount if the mount paths are nested
+		if utils.IsSubPath(mPath, path) && len(mPath) > len(mountPath) {
+			mount, mountPath = &targetDataset.Spec.Mounts[i], mPath
+		}
+	}
+	if mount == nil {
+		err = fmt.Errorf("the target path %s is not under any mount of dataset %s/%s", path, targetDataset.Namespace, targetDataset.Name)
+		return
+	}
+	if common.IsFluidNativeScheme(mount.MountPoint) {
+		err = fmt.Errorf("the target path %s is in the mount %s, which is not supported by the DataLoad of VineyardRuntime", path, mount.MountPoint)
+		return
+	}
+
+	relPath, err := filepath.Rel(mountPath, path)
+	if err != nil {
+		return
+	}
+	source = DataLoadSource{
+		Path:          path,
+		URI:           strings.TrimSuffix(mount.MountPoint, "/"),
+		Options:       map[string]string{},
+		SecretOptions: map[string]string{},
+	}
+	if relPath != "." {
+		source.URI = source.URI + "/" + relPath
+	}
+
+	// the options of the mount take precedence over the shared ones
+	for key, value := range targetDataset.Spec.SharedOptions {
+		source.Options[key] = value
+	}
+	for key, value := range mount.Options {
+		source.Options[key] = value
+	}
+	encryptOptions := append([]datav1alpha1.EncryptOption{}, targetDataset.Spec.SharedEncryptOptions...)
+	encryptOptions = append(encryptOptions, mount.EncryptOptions...)
+	for _, encryptOpt := range encryptOptions {
+		secretName := encryptOpt.ValueFrom.SecretKeyRef.Name
+		secretMountPath := filepath.Join(dataLoadSecretMountPath, secretName)
+
+		volName := fmt.Sprintf("dataload-secret-%s", secretName)
+		loader.Volumes = utils.AppendOrOverrideVolume(loader.Volumes, corev1.Volume{
+			Name: volName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretName,
+				},
+			},
+		})
+		loader.VolumeMounts = utils.AppendOrOverrideVolumeMounts(loader.VolumeMounts, corev1.VolumeMount{
+			Name:      volName,
+			ReadOnly:  true,
+			MountPath: secretMountPath,
+		})
+		delete(source.Options, encryptOpt.Name)
+		source.SecretOptions[encryptOpt.Name] = filepath.Join(secretMountPath, encryptOpt.ValueFrom.SecretKeyRef.Key)
+	}
+
+	return source, nil
+}
+
+// injectWorkerPodAffinity requires the DataLoad pod to run on the same node with a vineyard worker
+func (e *VineyardEngine) injectWorkerPodAffinity(affinity *corev1.Affinity) *corev1.Affinity {
+	if affinity == nil {
+		affinity = &corev1.Affinity{}
+	}
+	if affinity.PodAffinity == nil {
+		affinity.PodAffinity = &corev1.PodAffinity{}
+	}
+
+	affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"release":          e.name,
+					common.PodRoleType: wokrerPodRole,
+					"app":              common.VineyardRuntime,
+				},
+			},
+			TopologyKey: common.K8sNodeNameLabelKey,
+		})
+	return affinity
+}
+
+// getWorkerSocketHostPath returns the host path of the IPC socket of the vineyard workers, which is the same as the
+// one in the worker statefulset of the vineyard chart.
+func (e *VineyardEngine) getWorkerSocketHostPath() string {
+	return fmt.Sprintf("%s/%s/%s", workerSocketHostPathRoot, e.namespace, e.name)
+}
+
+// getDataLoadImage returns the image of the vineyard loader, which can be overridden by the env of the controller
+func getDataLoadImage() string {
+	imageName, imageTag := docker.ParseDockerImage(common.DefaultVineyardDataLoaderImage)
+	if image := docker.GetImageRepoFromEnv(common.VineyardDataLoaderImageEnv); len(image) > 0 {
+		imageName = image
+	}
+	if tag := docker.GetImageTagFromEnv(common.VineyardDataLoaderImageEnv); len(tag) > 0 {
+		imageTag = tag
+	}
+	return fmt.Sprintf("%s:%s", imageName, imageTag)
+}
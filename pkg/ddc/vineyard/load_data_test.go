@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The Fluid Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vineyard
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestVineyardEngine_generateDataLoadValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vineyard",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.DatasetSpec{
+			SharedOptions: map[string]string{
+				"region": "us-east-1",
+			},
+			Mounts: []datav1alpha1.Mount{
+				{
+					Name:       "data",
+					MountPoint: "s3://bucket/data/",
+					Options: map[string]string{
+						"endpoint_url": "http://minio:9000",
+					},
+					EncryptOptions: []datav1alpha1.EncryptOption{
+						{
+							Name: "secret",
+							ValueFrom: datav1alpha1.EncryptOptionSource{
+								SecretKeyRef: datav1alpha1.SecretKeySelector{
+									Name: "minio-secret",
+									Key:  "secret-key",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:       "local",
+					MountPoint: "local:///mnt/data",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		targetPaths []string
+		wantSources []DataLoadSource
+		wantErr     bool
+	}{
+		{
+			name:        "object storage",
+			targetPaths: []string{"/data", "/data/train.parquet"},
+			wantSources: []DataLoadSource{
+				{
+					Path:          "/data",
+					URI:           "s3://bucket/data",
+					Options:       map[string]string{"region": "us-east-1", "endpoint_url": "http://minio:9000"},
+					SecretOptions: map[string]string{"secret": "/etc/fluid/secrets/minio-secret/secret-key"},
+				},
+				{
+					Path:          "/data/train.parquet",
+					URI:           "s3://bucket/data/train.parquet",
+					Options:       map[string]string{"region": "us-east-1", "endpoint_url": "http://minio:9000"},
+					SecretOptions: map[string]string{"secret": "/etc/fluid/secrets/minio-secret/secret-key"},
+				},
+			},
+		},
+		{
+			name:        "fluid native mount",
+			targetPaths: []string{"/local/train.parquet"},
+			wantErr:     true,
+		},
+		{
+			name:        "not under any mount",
+			targetPaths: []string{"/others"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewFakeClientWithScheme(testScheme, []runtime.Object{dataset.DeepCopy()}...)
+			engine := &VineyardEngine{
+				name:      "vineyard",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+			}
+
+			dataLoad := &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dataload",
+					Namespace: "fluid",
+				},
+				Spec: datav1alpha1.DataLoadSpec{
+					Dataset: datav1alpha1.TargetDataset{
+						Name:      "vineyard",
+						Namespace: "fluid",
+					},
+				},
+			}
+			for _, path := range tt.targetPaths {
+				dataLoad.Spec.Target = append(dataLoad.Spec.Target, datav1alpha1.TargetPath{Path: path})
+			}
+
+			valueFileName, err := engine.generateDataLoadValueFile(cruntime.ReconcileRequestContext{Client: client}, dataLoad)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generateDataLoadValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value DataLoadValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+
+			if value.Loader.SocketHostPath != "/runtime-mnt/vineyard/fluid/vineyard" {
+				t.Errorf("expect socket host path /runtime-mnt/vineyard/fluid/vineyard, got %s", value.Loader.SocketHostPath)
+			}
+			if !reflect.DeepEqual(value.Loader.Sources, tt.wantSources) {
+				t.Errorf("expect sources %v, got %v", tt.wantSources, value.Loader.Sources)
+			}
+			if len(value.Loader.Volumes) != 1 || value.Loader.Volumes[0].Secret.SecretName != "minio-secret" {
+				t.Errorf("expect the secret volume of minio-secret, got %v", value.Loader.Volumes)
+			}
+
+			affinity := value.DataLoadInfo.Affinity
+			if affinity == nil || affinity.PodAffinity == nil || len(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution) != 1 {
+				t.Fatalf("expect the pod affinity to vineyard workers, got %v", affinity)
+			}
+			term := affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0]
+			wantLabels := map[string]string{"release": "vineyard", common.PodRoleType: wokrerPodRole, "app": common.VineyardRuntime}
+			if !reflect.DeepEqual(term.LabelSelector.MatchLabels, wantLabels) || term.TopologyKey != common.K8sNodeNameLabelKey {
+				t.Errorf("expect pod affinity term with labels %v, got %v", wantLabels, term)
+			}
+		})
+	}
+}
+
+func TestVineyardEngine_injectWorkerPodAffinity(t *testing.T) {
+	engine := &VineyardEngine{name: "vineyard", namespace: "fluid"}
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{},
+	}
+
+	got := engine.injectWorkerPodAffinity(affinity)
+	if got.NodeAffinity == nil {
+		t.Errorf("expect the node affinity to be kept")
+	}
+	if got.PodAffinity == nil || len(got.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution) != 1 {
+		t.Errorf("expect a required pod affinity term, got %v", got.PodAffinity)
+	}
+}
+
+func TestGetDataLoadImage(t *testing.T) {
+	if got := getDataLoadImage(); got != common.DefaultVineyardDataLoaderImage {
+		t.Errorf("expect default image %s, got %s", common.DefaultVineyardDataLoaderImage, got)
+	}
+
+	t.Setenv(common.VineyardDataLoaderImageEnv, "registry.example.com/vineyard-loader:v1")
+	if got := getDataLoadImage(); got != "registry.example.com/vineyard-loader:v1" {
+		t.Errorf("expect image from env, got %s", got)
+	}
+}
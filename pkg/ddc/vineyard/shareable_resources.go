@@ -0,0 +1,28 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vineyard
+
+import (
+	"fmt"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+)
+
+// GetShareableResources returns an error because vineyard doesn't support reference datasets
+func (e *VineyardEngine) GetShareableResources() ([]base.ShareableResource, error) {
+	return nil, fmt.Errorf("VineyardRuntime %s/%s can't be mounted by reference datasets", e.namespace, e.name)
+}
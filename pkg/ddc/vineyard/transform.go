@@ -61,6 +61,11 @@ func (e *VineyardEngine) transform(runtime *datav1alpha1.VineyardRuntime) (value
 
 	e.transformPodMetadata(runtime, value)
 
+	transformer.TransformSchedulingPolicies(runtime.Spec.Master.PriorityClassName, runtime.Spec.Master.TopologySpreadConstraints,
+		&value.Master.PriorityClassName, &value.Master.TopologySpreadConstraints)
+	transformer.TransformSchedulingPolicies(runtime.Spec.Worker.PriorityClassName, runtime.Spec.Worker.TopologySpreadConstraints,
+		&value.Worker.PriorityClassName, &value.Worker.TopologySpreadConstraints)
+
 	// allocate ports for hostnetwork mode
 	if datav1alpha1.IsHostNetwork(runtime.Spec.Master.NetworkMode) ||
 		datav1alpha1.IsHostNetwork(runtime.Spec.Worker.NetworkMode) {
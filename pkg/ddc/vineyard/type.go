@@ -19,6 +19,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 )
 
 // The value yaml file
@@ -63,6 +64,9 @@ type Master struct {
 	Resources        common.Resources     `json:"resources,omitempty"`
 	VolumeMounts     []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 	ExternalEndpoint Endpoint             `json:"externalEndpoint,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Worker struct {
@@ -79,6 +83,9 @@ type Worker struct {
 	Options         map[string]string    `json:"options,omitempty"`
 	Resources       common.Resources     `json:"resources,omitempty"`
 	VolumeMounts    []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Fuse struct {
@@ -121,6 +128,40 @@ type Level struct {
 	Low string `json:"low,omitempty"`
 }
 
+// DataLoadValue is the value yaml file used by the vineyard dataloader helm chart
+type DataLoadValue struct {
+	cdataload.DataLoadValue `json:",inline"`
+	Loader                  DataLoader `json:"loader"`
+}
+
+// DataLoader stages the objects from the mounts of the dataset into vineyardd
+type DataLoader struct {
+	// SocketHostPath is the host path of the IPC socket of the vineyard worker on the same node
+	SocketHostPath string `json:"socketHostPath"`
+
+	// Sources are the objects to load for each target path
+	Sources []DataLoadSource `json:"sources,omitempty"`
+
+	// Volumes and VolumeMounts of the secrets referred by the encrypt options of the mounts
+	Volumes      []corev1.Volume      `json:"volumes,omitempty"`
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+}
+
+// DataLoadSource is the location in the underlying storage of a target path of the DataLoad
+type DataLoadSource struct {
+	// Path is the target path in the dataset, which is also the name prefix of the loaded vineyard objects
+	Path string `json:"path"`
+
+	// URI is the location of the target path in the mount of the dataset
+	URI string `json:"uri"`
+
+	// Options are the storage options of the mount, e.g. the endpoint of the object storage
+	Options map[string]string `json:"options,omitempty"`
+
+	// SecretOptions are the storage options whose values are read from the mounted secret files
+	SecretOptions map[string]string `json:"secretOptions,omitempty"`
+}
+
 type cacheStates struct {
 	cacheCapacity    string
 	cached           string
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	dataOperationPhaseTransitionTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dataoperation_phase_transition_total",
+		Help: "Total num of phase transitions of a data operation",
+	}, []string{"operation_type", "operation", "phase"})
+
+	dataOperationDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "dataoperation_duration_seconds",
+		Help: "Duration in seconds of finished data operation runs",
+		// 10s ~ 5.7h
+		Buckets: prometheus.ExponentialBuckets(10, 2, 12),
+	}, []string{"operation_type", "operation", "phase"})
+
+	dataOperationWaitingRunAfter = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataoperation_waiting_run_after",
+		Help: "Whether the data operation is waiting for the operation in runAfter to complete",
+	}, []string{"operation_type", "operation"})
+
+	dataOperationCronSucceededTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dataoperation_cron_succeeded_total",
+		Help: "Total num of succeeded runs of a cron data operation",
+	}, []string{"operation_type", "operation"})
+
+	dataOperationCronFailedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dataoperation_cron_failed_total",
+		Help: "Total num of failed runs of a cron data operation",
+	}, []string{"operation_type", "operation"})
+)
+
+var dataOperationMetricsMap sync.Map // race condition protection for dataOperationMetricsMap's concurrent writes
+
+// dataOperationMetrics holds all the metrics related to a specific data operation.
+type dataOperationMetrics struct {
+	operationType string
+	operationKey  string
+
+	labels prometheus.Labels
+}
+
+func GetOrCreateDataOperationMetrics(operationType, operationNamespace, operationName string) *dataOperationMetrics {
+	key := labelKeyFunc(operationNamespace, operationName)
+	operationType = strings.ToLower(operationType)
+	m := &dataOperationMetrics{
+		operationType: operationType,
+		operationKey:  key,
+		labels:        prometheus.Labels{"operation_type": operationType, "operation": key},
+	}
+
+	// different kinds of data operations may share the same namespace and name
+	ret, _ := dataOperationMetricsMap.LoadOrStore(operationType+"/"+key, m)
+	return ret.(*dataOperationMetrics)
+}
+
+func (m *dataOperationMetrics) PhaseTransitionInc(phase string) {
+	dataOperationPhaseTransitionTotal.With(m.labelsWithPhase(phase)).Inc()
+}
+
+// ObserveDuration records the duration of a finished run, the duration is in the format of OperationStatus.Duration,
+// and unfinished or unparsable durations are ignored.
+func (m *dataOperationMetrics) ObserveDuration(phase string, duration string) {
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return
+	}
+	dataOperationDurationSeconds.With(m.labelsWithPhase(phase)).Observe(d.Seconds())
+}
+
+func (m *dataOperationMetrics) SetWaitingRunAfter(waiting bool) {
+	var value float64
+	if waiting {
+		value = 1
+	}
+	dataOperationWaitingRunAfter.With(m.labels).Set(value)
+}
+
+func (m *dataOperationMetrics) CronSucceededInc() {
+	dataOperationCronSucceededTotal.With(m.labels).Inc()
+}
+
+func (m *dataOperationMetrics) CronFailedInc() {
+	dataOperationCronFailedTotal.With(m.labels).Inc()
+}
+
+func (m *dataOperationMetrics) Forget() {
+	dataOperationPhaseTransitionTotal.DeletePartialMatch(m.labels)
+	dataOperationDurationSeconds.DeletePartialMatch(m.labels)
+	dataOperationWaitingRunAfter.Delete(m.labels)
+	dataOperationCronSucceededTotal.Delete(m.labels)
+	dataOperationCronFailedTotal.Delete(m.labels)
+
+	dataOperationMetricsMap.Delete(m.operationType + "/" + m.operationKey)
+}
+
+func (m *dataOperationMetrics) labelsWithPhase(phase string) prometheus.Labels {
+	labels := prometheus.Labels{"phase": phase}
+	for k, v := range m.labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+func init() {
+	metrics.Registry.MustRegister(dataOperationPhaseTransitionTotal, dataOperationDurationSeconds, dataOperationWaitingRunAfter,
+		dataOperationCronSucceededTotal, dataOperationCronFailedTotal)
+	dataOperationMetricsMap = sync.Map{}
+}
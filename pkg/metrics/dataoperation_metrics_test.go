@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func getMetric(t *testing.T, collector prometheus.Collector, labels prometheus.Labels) *dto.Metric {
+	ch := make(chan prometheus.Metric, 16)
+	collector.Collect(ch)
+	close(ch)
+
+	for metric := range ch {
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil {
+			t.Fatalf("failed to write metric: %v", err)
+		}
+		matched := 0
+		for _, pair := range m.GetLabel() {
+			if value, found := labels[pair.GetName()]; found && value == pair.GetValue() {
+				matched++
+			}
+		}
+		if matched == len(labels) {
+			return m
+		}
+	}
+	return nil
+}
+
+func TestDataOperationMetrics(t *testing.T) {
+	m := GetOrCreateDataOperationMetrics("DataLoad", "default", "test")
+	if m != GetOrCreateDataOperationMetrics("DataLoad", "default", "test") {
+		t.Errorf("expect the same metrics for the same data operation")
+	}
+	if m == GetOrCreateDataOperationMetrics("DataMigrate", "default", "test") {
+		t.Errorf("expect different metrics for different types of data operations")
+	}
+
+	labels := prometheus.Labels{"operation_type": "dataload", "operation": "default/test"}
+	completeLabels := prometheus.Labels{"operation_type": "dataload", "operation": "default/test", "phase": "Complete"}
+
+	m.PhaseTransitionInc("Complete")
+	m.PhaseTransitionInc("Complete")
+	if got := getMetric(t, dataOperationPhaseTransitionTotal, completeLabels).GetCounter().GetValue(); got != 2 {
+		t.Errorf("expect phase transition total 2, got %v", got)
+	}
+
+	m.ObserveDuration("Complete", "1m30s")
+	m.ObserveDuration("Complete", "Unfinished")
+	histogram := getMetric(t, dataOperationDurationSeconds, completeLabels).GetHistogram()
+	if histogram.GetSampleCount() != 1 || histogram.GetSampleSum() != 90 {
+		t.Errorf("expect 1 sample with sum 90, got %d samples with sum %v", histogram.GetSampleCount(), histogram.GetSampleSum())
+	}
+
+	m.SetWaitingRunAfter(true)
+	if got := getMetric(t, dataOperationWaitingRunAfter, labels).GetGauge().GetValue(); got != 1 {
+		t.Errorf("expect waiting gauge 1, got %v", got)
+	}
+	m.SetWaitingRunAfter(false)
+	if got := getMetric(t, dataOperationWaitingRunAfter, labels).GetGauge().GetValue(); got != 0 {
+		t.Errorf("expect waiting gauge 0, got %v", got)
+	}
+
+	m.CronSucceededInc()
+	m.CronFailedInc()
+	m.CronFailedInc()
+	if got := getMetric(t, dataOperationCronSucceededTotal, labels).GetCounter().GetValue(); got != 1 {
+		t.Errorf("expect cron succeeded total 1, got %v", got)
+	}
+	if got := getMetric(t, dataOperationCronFailedTotal, labels).GetCounter().GetValue(); got != 2 {
+		t.Errorf("expect cron failed total 2, got %v", got)
+	}
+
+	m.Forget()
+	for _, collector := range []prometheus.Collector{dataOperationPhaseTransitionTotal, dataOperationDurationSeconds,
+		dataOperationWaitingRunAfter, dataOperationCronSucceededTotal, dataOperationCronFailedTotal} {
+		if getMetric(t, collector, labels) != nil {
+			t.Errorf("expect metrics of the data operation to be deleted after forget")
+		}
+	}
+	if _, found := dataOperationMetricsMap.Load("dataload/default/test"); found {
+		t.Errorf("expect the data operation to be removed from the metrics map")
+	}
+}
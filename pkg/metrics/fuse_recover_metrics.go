@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	fuseRecoverAttemptsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fuse_recover_attempts_total",
+		Help: "Total num of attempts to recover the broken mount points of a volume",
+	}, []string{"volume"})
+
+	fuseRecoverSucceededTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fuse_recover_succeeded_total",
+		Help: "Total num of succeeded recoveries of the broken mount points of a volume",
+	}, []string{"volume"})
+
+	fuseRecoverFailedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fuse_recover_failed_total",
+		Help: "Total num of failed recoveries of the broken mount points of a volume",
+	}, []string{"volume"})
+
+	fuseRecoverSkippedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fuse_recover_skipped_total",
+		Help: "Total num of skipped recoveries of the broken mount points of a volume",
+	}, []string{"volume", "reason"})
+)
+
+var fuseRecoverMetricsMap sync.Map // race condition protection for fuseRecoverMetricsMap's concurrent writes
+
+// fuseRecoverMetrics holds all the metrics related to the FUSE recovery of a specific volume.
+type fuseRecoverMetrics struct {
+	volume string
+
+	labels prometheus.Labels
+}
+
+func GetOrCreateFuseRecoverMetrics(volume string) *fuseRecoverMetrics {
+	m := &fuseRecoverMetrics{
+		volume: volume,
+		labels: prometheus.Labels{"volume": volume},
+	}
+
+	ret, _ := fuseRecoverMetricsMap.LoadOrStore(volume, m)
+	return ret.(*fuseRecoverMetrics)
+}
+
+func (m *fuseRecoverMetrics) AttemptInc() {
+	fuseRecoverAttemptsTotal.With(m.labels).Inc()
+}
+
+func (m *fuseRecoverMetrics) SucceededInc() {
+	fuseRecoverSucceededTotal.With(m.labels).Inc()
+}
+
+func (m *fuseRecoverMetrics) FailedInc() {
+	fuseRecoverFailedTotal.With(m.labels).Inc()
+}
+
+func (m *fuseRecoverMetrics) SkippedInc(reason string) {
+	fuseRecoverSkippedTotal.WithLabelValues(m.volume, reason).Inc()
+}
+
+func (m *fuseRecoverMetrics) Forget() {
+	fuseRecoverAttemptsTotal.Delete(m.labels)
+	fuseRecoverSucceededTotal.Delete(m.labels)
+	fuseRecoverFailedTotal.Delete(m.labels)
+	fuseRecoverSkippedTotal.DeletePartialMatch(m.labels)
+
+	fuseRecoverMetricsMap.Delete(m.volume)
+}
+
+func init() {
+	metrics.Registry.MustRegister(fuseRecoverAttemptsTotal, fuseRecoverSucceededTotal, fuseRecoverFailedTotal, fuseRecoverSkippedTotal)
+	fuseRecoverMetricsMap = sync.Map{}
+}
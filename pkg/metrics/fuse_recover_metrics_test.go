@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestFuseRecoverMetrics(t *testing.T) {
+	m := GetOrCreateFuseRecoverMetrics("default-jfsdemo")
+	if m != GetOrCreateFuseRecoverMetrics("default-jfsdemo") {
+		t.Errorf("expect the same metrics for the same volume")
+	}
+
+	labels := prometheus.Labels{"volume": "default-jfsdemo"}
+
+	m.AttemptInc()
+	m.AttemptInc()
+	m.SucceededInc()
+	m.FailedInc()
+	m.SkippedInc("backoff")
+	m.SkippedInc("backoff")
+	m.SkippedInc("pod_terminating")
+
+	if got := getMetric(t, fuseRecoverAttemptsTotal, labels).GetCounter().GetValue(); got != 2 {
+		t.Errorf("expect attempts total 2, got %v", got)
+	}
+	if got := getMetric(t, fuseRecoverSucceededTotal, labels).GetCounter().GetValue(); got != 1 {
+		t.Errorf("expect succeeded total 1, got %v", got)
+	}
+	if got := getMetric(t, fuseRecoverFailedTotal, labels).GetCounter().GetValue(); got != 1 {
+		t.Errorf("expect failed total 1, got %v", got)
+	}
+	backoffLabels := prometheus.Labels{"volume": "default-jfsdemo", "reason": "backoff"}
+	if got := getMetric(t, fuseRecoverSkippedTotal, backoffLabels).GetCounter().GetValue(); got != 2 {
+		t.Errorf("expect skipped total 2 for backoff, got %v", got)
+	}
+
+	m.Forget()
+	for _, collector := range []prometheus.Collector{fuseRecoverAttemptsTotal, fuseRecoverSucceededTotal,
+		fuseRecoverFailedTotal, fuseRecoverSkippedTotal} {
+		if getMetric(t, collector, labels) != nil {
+			t.Errorf("expect metrics of the volume to be deleted after forget")
+		}
+	}
+	if _, found := fuseRecoverMetricsMap.Load("default-jfsdemo"); found {
+		t.Errorf("expect the volume to be removed from the metrics map")
+	}
+}
@@ -34,6 +34,36 @@ var (
 		Name: "runtime_sync_healthcheck_error_total",
 		Help: "Total num of errors during runtime health check",
 	}, []string{"runtime_type", "runtime"})
+
+	runtimeCachedBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "runtime_cached_bytes",
+		Help: "Total bytes of data cached by the runtime",
+	}, []string{"runtime_type", "runtime"})
+
+	runtimeCacheCapacityBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "runtime_cache_capacity_bytes",
+		Help: "Total cache capacity in bytes of the runtime",
+	}, []string{"runtime_type", "runtime"})
+
+	runtimeCachedPercentage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "runtime_cached_percentage",
+		Help: "Percentage of the dataset cached by the runtime",
+	}, []string{"runtime_type", "runtime"})
+
+	runtimeCacheHitRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "runtime_cache_hit_ratio_percentage",
+		Help: "Percentage of the data read from the cache of the runtime",
+	}, []string{"runtime_type", "runtime"})
+
+	runtimeWorkersReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "runtime_workers_ready",
+		Help: "Num of ready workers of the runtime",
+	}, []string{"runtime_type", "runtime"})
+
+	runtimeFusesReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "runtime_fuses_ready",
+		Help: "Num of ready fuse pods of the runtime",
+	}, []string{"runtime_type", "runtime"})
 )
 
 var runtimeMetricsMap sync.Map // race condition protection for runtimeMetricsMap's concurrent writes
@@ -66,14 +96,46 @@ func (m *runtimeMetrics) HealthCheckErrorInc() {
 	runtimeHealthCheckErrorTotal.With(m.labels).Inc()
 }
 
+func (m *runtimeMetrics) SetCachedBytes(bytes float64) {
+	runtimeCachedBytes.With(m.labels).Set(bytes)
+}
+
+func (m *runtimeMetrics) SetCacheCapacityBytes(bytes float64) {
+	runtimeCacheCapacityBytes.With(m.labels).Set(bytes)
+}
+
+func (m *runtimeMetrics) SetCachedPercentage(percentage float64) {
+	runtimeCachedPercentage.With(m.labels).Set(percentage)
+}
+
+func (m *runtimeMetrics) SetCacheHitRatio(percentage float64) {
+	runtimeCacheHitRatio.With(m.labels).Set(percentage)
+}
+
+func (m *runtimeMetrics) SetWorkersReady(num float64) {
+	runtimeWorkersReady.With(m.labels).Set(num)
+}
+
+func (m *runtimeMetrics) SetFusesReady(num float64) {
+	runtimeFusesReady.With(m.labels).Set(num)
+}
+
 func (m *runtimeMetrics) Forget() {
 	runtimeSetupErrorTotal.Delete(m.labels)
 	runtimeHealthCheckErrorTotal.Delete(m.labels)
+	runtimeCachedBytes.Delete(m.labels)
+	runtimeCacheCapacityBytes.Delete(m.labels)
+	runtimeCachedPercentage.Delete(m.labels)
+	runtimeCacheHitRatio.Delete(m.labels)
+	runtimeWorkersReady.Delete(m.labels)
+	runtimeFusesReady.Delete(m.labels)
 
 	runtimeMetricsMap.Delete(m.runtimeKey)
 }
 
 func init() {
 	metrics.Registry.MustRegister(runtimeSetupErrorTotal, runtimeHealthCheckErrorTotal)
+	metrics.Registry.MustRegister(runtimeCachedBytes, runtimeCacheCapacityBytes, runtimeCachedPercentage, runtimeCacheHitRatio,
+		runtimeWorkersReady, runtimeFusesReady)
 	runtimeMetricsMap = sync.Map{}
 }
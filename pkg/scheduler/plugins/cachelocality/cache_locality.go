@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachelocality
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+/*
+   This plugin scores the nodes by the bytes of the datasets mounted by the pod
+   which are cached on them. The cached bytes are reported by the cache engines
+   into the node cache ConfigMap of each runtime.
+*/
+
+const (
+	Name = "FluidCacheLocality"
+
+	// preScoreStateKey is the key in CycleState to the cached bytes on each node computed at PreScore
+	preScoreStateKey = "PreScore" + Name
+)
+
+var (
+	_ framework.PreScorePlugin  = &CacheLocality{}
+	_ framework.ScorePlugin     = &CacheLocality{}
+	_ framework.ScoreExtensions = &CacheLocality{}
+)
+
+// preScoreState is the cached bytes of the pod's datasets on each node
+type preScoreState struct {
+	nodeCache map[string]int64
+}
+
+// Clone returns the state itself because it's never modified after PreScore
+func (s *preScoreState) Clone() framework.StateData {
+	return s
+}
+
+type CacheLocality struct {
+	client client.Reader
+	log    logr.Logger
+}
+
+// New is the factory registered to the scheduler framework. It reads the datasets and the node cache ConfigMaps
+// through an informer cache started with the scheduler.
+func New(ctx context.Context, _ runtime.Object, handle framework.Handle) (framework.Plugin, error) {
+	c, err := newCachedReader(ctx, handle.KubeConfig())
+	if err != nil {
+		return nil, err
+	}
+	return NewPlugin(c, klog.FromContext(ctx)), nil
+}
+
+func NewPlugin(c client.Reader, log logr.Logger) *CacheLocality {
+	return &CacheLocality{
+		client: c,
+		log:    log.WithName(Name),
+	}
+}
+
+func (p *CacheLocality) Name() string {
+	return Name
+}
+
+// PreScore sums up the cached bytes of the pod's datasets on each node once for all the nodes,
+// and skips Score when nothing is cached. Failing to read the node cache skips Score too rather than
+// failing the scheduling of the pod, because cache locality is only a preference.
+func (p *CacheLocality) PreScore(ctx context.Context, state *framework.CycleState, pod *corev1.Pod, nodes []*corev1.Node) *framework.Status {
+	nodeCache, err := p.collectNodeCache(pod)
+	if err != nil {
+		p.log.Error(err, "Failed to collect the node cache, skip scoring", "pod", klog.KObj(pod))
+		return framework.NewStatus(framework.Skip)
+	}
+	if len(nodeCache) == 0 {
+		return framework.NewStatus(framework.Skip)
+	}
+	state.Write(preScoreStateKey, &preScoreState{nodeCache: nodeCache})
+	return nil
+}
+
+// Score returns the cached bytes on the node, which are normalized into [MinNodeScore, MaxNodeScore] by NormalizeScore.
+func (p *CacheLocality) Score(ctx context.Context, state *framework.CycleState, pod *corev1.Pod, nodeName string) (int64, *framework.Status) {
+	data, err := state.Read(preScoreStateKey)
+	if err != nil {
+		return 0, framework.AsStatus(fmt.Errorf("failed to read %q from cycle state: %w", preScoreStateKey, err))
+	}
+	s, ok := data.(*preScoreState)
+	if !ok {
+		return 0, framework.AsStatus(fmt.Errorf("%+v cannot be converted to cachelocality.preScoreState", data))
+	}
+	return s.nodeCache[nodeName], nil
+}
+
+func (p *CacheLocality) ScoreExtensions() framework.ScoreExtensions {
+	return p
+}
+
+// NormalizeScore scales the cached bytes into [MinNodeScore, MaxNodeScore] relative to the node caching the most bytes.
+func (p *CacheLocality) NormalizeScore(ctx context.Context, state *framework.CycleState, pod *corev1.Pod, scores framework.NodeScoreList) *framework.Status {
+	var maxCached int64
+	for _, score := range scores {
+		if score.Score > maxCached {
+			maxCached = score.Score
+		}
+	}
+
+	for i := range scores {
+		if maxCached == 0 || scores[i].Score <= 0 {
+			scores[i].Score = framework.MinNodeScore
+			continue
+		}
+		// avoid overflowing int64 when the cached bytes are multiplied by MaxNodeScore
+		scores[i].Score = int64(float64(scores[i].Score) / float64(maxCached) * float64(framework.MaxNodeScore))
+	}
+	return nil
+}
+
+// collectNodeCache sums up the cached bytes of the datasets mounted by the pod on each node.
+func (p *CacheLocality) collectNodeCache(pod *corev1.Pod) (nodeCache map[string]int64, err error) {
+	nodeCache = map[string]int64{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		pvc, err := kubeclient.GetPersistentVolumeClaim(p.client, volume.PersistentVolumeClaim.ClaimName, pod.Namespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get pvc %s/%s: %v", pod.Namespace, volume.PersistentVolumeClaim.ClaimName, err)
+		}
+		if !kubeclient.CheckIfPVCIsDataset(pvc) {
+			continue
+		}
+
+		datasetName := pvc.Name
+		if name, exists := common.GetManagerDatasetFromLabels(pvc.Labels); exists {
+			datasetName = name
+		}
+		dataset, err := utils.GetDataset(p.client, datasetName, pod.Namespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get dataset %s/%s: %v", pod.Namespace, datasetName, err)
+		}
+
+		_, runtime := utils.GetRuntimeByCategory(dataset.Status.Runtimes, common.AccelerateCategory)
+		if runtime == nil {
+			p.log.V(1).Info("Skip the dataset without accelerate runtime", "dataset", datasetName, "namespace", pod.Namespace)
+			continue
+		}
+
+		cached, err := kubeclient.GetNodeCache(p.client, kubeclient.GetNodeCacheConfigMapName(runtime.Name, runtime.Type), runtime.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node cache of runtime %s/%s: %v", runtime.Namespace, runtime.Name, err)
+		}
+		for node, bytes := range cached {
+			nodeCache[node] += bytes
+		}
+	}
+	return nodeCache, nil
+}
+
+// newCachedReader starts an informer cache of the objects read by the plugin, which only caches the ConfigMaps
+// created by fluid, including the node cache ConfigMaps of the runtimes.
+func newCachedReader(ctx context.Context, cfg *rest.Config) (client.Reader, error) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = datav1alpha1.AddToScheme(scheme)
+
+	datasetIdExists, err := labels.NewRequirement(common.LabelAnnotationDatasetId, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+	c, err := cache.New(cfg, cache.Options{
+		Scheme: scheme,
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.ConfigMap{}: {
+				Label: labels.NewSelector().Add(*datasetIdExists),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := c.Start(ctx); err != nil {
+			klog.FromContext(ctx).Error(err, "failed to start the cache", "plugin", Name)
+		}
+	}()
+	if !c.WaitForCacheSync(ctx) {
+		return nil, fmt.Errorf("failed to wait for the cache of plugin %s to start", Name)
+	}
+	return c, nil
+}
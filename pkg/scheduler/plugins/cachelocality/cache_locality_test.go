@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachelocality
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+func newDatasetPVC(name string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{common.LabelAnnotationStorageCapacityPrefix + "default-" + name: "true"},
+		},
+	}
+}
+
+func newDataset(name string, runtimes ...datav1alpha1.Runtime) *datav1alpha1.Dataset {
+	return &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     datav1alpha1.DatasetStatus{Runtimes: runtimes},
+	}
+}
+
+func newNodeCache(runtimeName, runtimeType string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kubeclient.GetNodeCacheConfigMapName(runtimeName, runtimeType),
+			Namespace: "default",
+		},
+		Data: data,
+	}
+}
+
+func newPod(claimNames ...string) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         "config",
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}},
+	})
+	for _, claimName := range claimNames {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: claimName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+			},
+		})
+	}
+	return pod
+}
+
+func TestScore(t *testing.T) {
+	accelerate := func(name, runtimeType string) datav1alpha1.Runtime {
+		return datav1alpha1.Runtime{Name: name, Namespace: "default", Type: runtimeType, Category: common.AccelerateCategory}
+	}
+	objects := []runtime.Object{
+		newDatasetPVC("hbase"),
+		newDataset("hbase", accelerate("hbase", common.AlluxioRuntime)),
+		newNodeCache("hbase", common.AlluxioRuntime, map[string]string{"node-1": "1000", "node-2": "500", "node-3": "invalid"}),
+		newDatasetPVC("spark"),
+		newDataset("spark", accelerate("spark", common.JindoRuntime)),
+		newNodeCache("spark", common.JindoRuntime, map[string]string{"node-2": "1500"}),
+		newDatasetPVC("no-cache"),
+		newDataset("no-cache", accelerate("no-cache", common.JuiceFSRuntime)),
+		newDatasetPVC("no-runtime"),
+		newDataset("no-runtime"),
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "non-dataset", Namespace: "default"}},
+	}
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	plugin := NewPlugin(fake.NewFakeClientWithScheme(s, objects...), fake.NullLogger())
+
+	nodes := []string{"node-1", "node-2", "node-3"}
+	tests := []struct {
+		name     string
+		pod      *corev1.Pod
+		wantSkip bool
+		want     framework.NodeScoreList
+	}{
+		{
+			name: "single dataset",
+			pod:  newPod("hbase"),
+			want: framework.NodeScoreList{{Name: "node-1", Score: 100}, {Name: "node-2", Score: 50}, {Name: "node-3", Score: 0}},
+		},
+		{
+			name: "multiple datasets",
+			pod:  newPod("hbase", "spark", "non-dataset", "not-exist"),
+			want: framework.NodeScoreList{{Name: "node-1", Score: 50}, {Name: "node-2", Score: 100}, {Name: "node-3", Score: 0}},
+		},
+		{
+			name:     "datasets without node cache",
+			pod:      newPod("no-cache", "no-runtime"),
+			wantSkip: true,
+		},
+		{
+			name:     "no dataset",
+			pod:      newPod(),
+			wantSkip: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			state := framework.NewCycleState()
+			status := plugin.PreScore(ctx, state, tt.pod, nil)
+			if tt.wantSkip {
+				if !status.IsSkip() {
+					t.Fatalf("PreScore() = %v, want skip", status)
+				}
+				return
+			}
+			if !status.IsSuccess() {
+				t.Fatalf("PreScore() = %v", status)
+			}
+
+			var got framework.NodeScoreList
+			for _, node := range nodes {
+				score, status := plugin.Score(ctx, state, tt.pod, node)
+				if !status.IsSuccess() {
+					t.Fatalf("Score() = %v", status)
+				}
+				got = append(got, framework.NodeScore{Name: node, Score: score})
+			}
+			if status := plugin.ScoreExtensions().NormalizeScore(ctx, state, tt.pod, got); !status.IsSuccess() {
+				t.Fatalf("NormalizeScore() = %v", status)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scores = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreWithoutPreScore(t *testing.T) {
+	plugin := NewPlugin(nil, fake.NullLogger())
+	if _, status := plugin.Score(context.TODO(), framework.NewCycleState(), newPod(), "node-1"); status.IsSuccess() {
+		t.Errorf("Score() without PreScore should fail")
+	}
+}
+
+func TestNormalizeScore(t *testing.T) {
+	scores := framework.NodeScoreList{
+		{Name: "node-1", Score: 3 << 40},
+		{Name: "node-2", Score: 1 << 40},
+		{Name: "node-3", Score: 0},
+		{Name: "node-4", Score: -1},
+	}
+	(&CacheLocality{}).NormalizeScore(context.TODO(), framework.NewCycleState(), newPod(), scores)
+	want := framework.NodeScoreList{
+		{Name: "node-1", Score: 100},
+		{Name: "node-2", Score: 33},
+		{Name: "node-3", Score: 0},
+		{Name: "node-4", Score: 0},
+	}
+	if !reflect.DeepEqual(scores, want) {
+		t.Errorf("NormalizeScore() = %v, want %v", scores, want)
+	}
+}
@@ -56,8 +56,9 @@ func InjectNodeSelectorRequirements(matchExpressions []corev1.NodeSelectorRequir
 		return result
 	}
 	// has element, inject term's match expressions to each element
-	for _, nodeSelectorTerm := range result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
-		nodeSelectorTerm.MatchExpressions = append(nodeSelectorTerm.MatchExpressions, matchExpressions...)
+	terms := result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for i := range terms {
+		terms[i].MatchExpressions = append(terms[i].MatchExpressions, matchExpressions...)
 	}
 
 	return result
@@ -65,6 +65,43 @@ func GetOperationStatus(obj client.Object) (*datav1alpha1.OperationStatus, error
 	return nil, fmt.Errorf("obj is not of any data operation type")
 }
 
+// GetPrecedingOperations returns the preceding operations specified by either RunAfter or RunAfterList of the
+// data operation, and how their conditions are combined.
+func GetPrecedingOperations(obj client.Object) (runAfters []datav1alpha1.OperationRef, mode datav1alpha1.RunAfterMode) {
+	var runAfter *datav1alpha1.OperationRef
+	var runAfterList *datav1alpha1.OperationRefList
+	switch op := obj.(type) {
+	case *datav1alpha1.DataLoad:
+		runAfter, runAfterList = op.Spec.RunAfter, op.Spec.RunAfterList
+	case *datav1alpha1.DataMigrate:
+		runAfter, runAfterList = op.Spec.RunAfter, op.Spec.RunAfterList
+	case *datav1alpha1.DataBackup:
+		runAfter, runAfterList = op.Spec.RunAfter, op.Spec.RunAfterList
+	case *datav1alpha1.DataProcess:
+		runAfter, runAfterList = op.Spec.RunAfter, op.Spec.RunAfterList
+	}
+
+	mode = datav1alpha1.AllOfMode
+	if runAfter != nil {
+		runAfters = append(runAfters, *runAfter)
+	}
+	if runAfterList != nil {
+		runAfters = append(runAfters, runAfterList.Operations...)
+		if len(runAfterList.Mode) != 0 {
+			mode = runAfterList.Mode
+		}
+	}
+	return
+}
+
+// GetRunAfterCondition returns the condition of the preceding operation, defaults to OnSuccess.
+func GetRunAfterCondition(runAfter *datav1alpha1.OperationRef) datav1alpha1.RunAfterCondition {
+	if len(runAfter.Condition) == 0 {
+		return datav1alpha1.OnSuccessCondition
+	}
+	return runAfter.Condition
+}
+
 func GetPrecedingOperationStatus(client client.Client, opRef *datav1alpha1.ObjectRef, opRefNamespace string) (*datav1alpha1.OperationStatus, error) {
 	if opRef == nil {
 		return nil, nil
@@ -101,6 +138,15 @@ func GetPrecedingOperationStatus(client client.Client, opRef *datav1alpha1.Objec
 	}
 }
 
+// IsDataOperationSkipped checks if the data operation is skipped because the conditions of its preceding
+// operations are not met, such operation fails without running.
+func IsDataOperationSkipped(opStatus *datav1alpha1.OperationStatus) bool {
+	if opStatus.Phase != common.PhaseFailed || len(opStatus.Conditions) == 0 {
+		return false
+	}
+	return opStatus.Conditions[len(opStatus.Conditions)-1].Reason == common.DataOperationSkipped
+}
+
 func NeedCleanUp(opStatus *datav1alpha1.OperationStatus, operation dataoperation.OperationInterface) bool {
 	if len(opStatus.Conditions) == 0 {
 		// data operation has no completion time, no need to clean up
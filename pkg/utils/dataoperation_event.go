@@ -0,0 +1,183 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package utils
+
+import (
+	"sort"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Events which trigger a data operation with OnEvent policy
+const (
+	// UFSChangedEvent means the total size or file number of the dataset's ufs changed
+	UFSChangedEvent = "UFSChanged"
+
+	// MountAddedEvent means a new mount point is mounted by the dataset
+	MountAddedEvent = "MountAdded"
+
+	// WorkersScaledOutEvent means the bound runtime scaled out new workers
+	WorkersScaledOutEvent = "WorkersScaledOut"
+
+	// MountsChangedEvent means mount points are added to or removed from the dataset
+	MountsChangedEvent = "MountsChanged"
+
+	// PrecedingOperationCompletedEvent means the preceding operation specified in RunAfter completed again
+	PrecedingOperationCompletedEvent = "PrecedingOperationCompleted"
+)
+
+// maxOperationRunHistory is the max number of run records kept in the event status
+const maxOperationRunHistory = 10
+
+// metadataSyncNotDoneMsg is set by runtimes in ufsTotal and fileNum when metadata sync is still in progress
+const metadataSyncNotDoneMsg = "[Calculating]"
+
+// GetObservedDatasetState takes a snapshot of the dataset used to detect the events on it.
+func GetObservedDatasetState(dataset *datav1alpha1.Dataset) datav1alpha1.ObservedDatasetState {
+	state := datav1alpha1.ObservedDatasetState{}
+	if dataset == nil {
+		return state
+	}
+
+	state.UfsTotal = dataset.Status.UfsTotal
+	state.FileNum = dataset.Status.FileNum
+	for _, mount := range dataset.Status.Mounts {
+		state.MountPoints = append(state.MountPoints, mount.MountPoint)
+	}
+	sort.Strings(state.MountPoints)
+
+	return state
+}
+
+// DetectDatasetEvents compares the observed state with the current state of the dataset, and returns
+// the events happened in between. Changes on an unknown ufs size or file number are not treated as events.
+func DetectDatasetEvents(observed, current datav1alpha1.ObservedDatasetState) (events []string) {
+	if ufsValueChanged(observed.UfsTotal, current.UfsTotal) || ufsValueChanged(observed.FileNum, current.FileNum) {
+		events = append(events, UFSChangedEvent)
+	}
+
+	for _, mountPoint := range current.MountPoints {
+		if !ContainsString(observed.MountPoints, mountPoint) {
+			events = append(events, MountAddedEvent)
+			break
+		}
+	}
+
+	return
+}
+
+// IsDatasetMountsChanged checks if any mount point is added to or removed from the dataset.
+func IsDatasetMountsChanged(observed, current datav1alpha1.ObservedDatasetState) bool {
+	if len(observed.MountPoints) != len(current.MountPoints) {
+		return true
+	}
+	for _, mountPoint := range current.MountPoints {
+		if !ContainsString(observed.MountPoints, mountPoint) {
+			return true
+		}
+	}
+	return false
+}
+
+// RefreshObservedDatasetState updates the observed state of the dataset with the current state. The last known
+// ufs size and file number are kept while the current ones are unknown, e.g. the metadata is being resynced,
+// so that the change made in between can still be detected once the metadata sync finishes.
+func RefreshObservedDatasetState(observed *datav1alpha1.ObservedDatasetState, current datav1alpha1.ObservedDatasetState) {
+	if isUfsValueKnown(current.UfsTotal) {
+		observed.UfsTotal = current.UfsTotal
+	}
+	if isUfsValueKnown(current.FileNum) {
+		observed.FileNum = current.FileNum
+	}
+	observed.MountPoints = current.MountPoints
+}
+
+func ufsValueChanged(observed, current string) bool {
+	if !isUfsValueKnown(observed) || !isUfsValueKnown(current) {
+		return false
+	}
+	return observed != current
+}
+
+func isUfsValueKnown(value string) bool {
+	return len(value) != 0 && value != metadataSyncNotDoneMsg
+}
+
+// GetRuntimeWorkerNumberReady returns the number of ready workers of the given runtime object,
+// it returns 0 if the object is not a runtime.
+func GetRuntimeWorkerNumberReady(runtime client.Object) int32 {
+	runtimeWithStatus, ok := runtime.(interface {
+		GetStatus() *datav1alpha1.RuntimeStatus
+	})
+	if !ok || runtimeWithStatus.GetStatus() == nil {
+		return 0
+	}
+	return runtimeWithStatus.GetStatus().WorkerNumberReady
+}
+
+// GetOperationCompletionTime returns the time when the operation completed successfully,
+// it returns nil if the operation is not complete.
+func GetOperationCompletionTime(opStatus *datav1alpha1.OperationStatus) *metav1.Time {
+	if opStatus == nil || opStatus.Phase != common.PhaseComplete {
+		return nil
+	}
+	for _, condition := range opStatus.Conditions {
+		if condition.Type == common.Complete {
+			return condition.LastTransitionTime.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// GetLatestPrecedingCompletionTime returns the latest completion time of the preceding operations of the data
+// operation. The deleted preceding operations are ignored because they never complete again.
+func GetLatestPrecedingCompletionTime(c client.Client, operation client.Object) (latest *metav1.Time, err error) {
+	runAfters, _ := GetPrecedingOperations(operation)
+	for i := range runAfters {
+		namespace := runAfters[i].Namespace
+		if len(namespace) == 0 {
+			namespace = operation.GetNamespace()
+		}
+		precedingOpStatus, err := GetPrecedingOperationStatus(c, &runAfters[i].ObjectRef, namespace)
+		if err != nil {
+			if IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, err
+		}
+		completionTime := GetOperationCompletionTime(precedingOpStatus)
+		if completionTime != nil && (latest == nil || completionTime.After(latest.Time)) {
+			latest = completionTime
+		}
+	}
+	return latest, nil
+}
+
+// AppendOperationRunRecord appends the record of a finished run to the run history,
+// the oldest records are removed when the history is full.
+func AppendOperationRunRecord(eventStatus *datav1alpha1.EventStatus, record datav1alpha1.OperationRunRecord) {
+	if eventStatus == nil {
+		return
+	}
+	eventStatus.RunHistory = append(eventStatus.RunHistory, record)
+	if overflow := len(eventStatus.RunHistory) - maxOperationRunHistory; overflow > 0 {
+		eventStatus.RunHistory = eventStatus.RunHistory[overflow:]
+	}
+}
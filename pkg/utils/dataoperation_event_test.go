@@ -0,0 +1,214 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDetectDatasetEvents(t *testing.T) {
+	observed := datav1alpha1.ObservedDatasetState{
+		UfsTotal:    "10.00GiB",
+		FileNum:     "100",
+		MountPoints: []string{"oss://bucket/a"},
+	}
+
+	testcases := []struct {
+		name     string
+		observed datav1alpha1.ObservedDatasetState
+		current  datav1alpha1.ObservedDatasetState
+		expected []string
+	}{
+		{
+			name:     "no change",
+			observed: observed,
+			current:  observed,
+			expected: nil,
+		},
+		{
+			name:     "file number changed",
+			observed: observed,
+			current: datav1alpha1.ObservedDatasetState{
+				UfsTotal:    "10.00GiB",
+				FileNum:     "101",
+				MountPoints: []string{"oss://bucket/a"},
+			},
+			expected: []string{UFSChangedEvent},
+		},
+		{
+			name:     "metadata sync in progress",
+			observed: observed,
+			current: datav1alpha1.ObservedDatasetState{
+				UfsTotal:    metadataSyncNotDoneMsg,
+				FileNum:     metadataSyncNotDoneMsg,
+				MountPoints: []string{"oss://bucket/a"},
+			},
+			expected: nil,
+		},
+		{
+			name:     "mount added and ufs changed",
+			observed: observed,
+			current: datav1alpha1.ObservedDatasetState{
+				UfsTotal:    "20.00GiB",
+				FileNum:     "200",
+				MountPoints: []string{"oss://bucket/a", "oss://bucket/b"},
+			},
+			expected: []string{UFSChangedEvent, MountAddedEvent},
+		},
+		{
+			name:     "mount removed",
+			observed: observed,
+			current: datav1alpha1.ObservedDatasetState{
+				UfsTotal: "10.00GiB",
+				FileNum:  "100",
+			},
+			expected: nil,
+		},
+	}
+
+	for _, testcase := range testcases {
+		events := DetectDatasetEvents(testcase.observed, testcase.current)
+		if !reflect.DeepEqual(events, testcase.expected) {
+			t.Errorf("testcase %s: expected events %v, get %v", testcase.name, testcase.expected, events)
+		}
+	}
+}
+
+func TestGetRuntimeWorkerNumberReady(t *testing.T) {
+	runtime := &datav1alpha1.JuiceFSRuntime{
+		Status: datav1alpha1.RuntimeStatus{WorkerNumberReady: 2},
+	}
+	if got := GetRuntimeWorkerNumberReady(runtime); got != 2 {
+		t.Errorf("expected 2 ready workers, get %d", got)
+	}
+	if got := GetRuntimeWorkerNumberReady(&datav1alpha1.Dataset{}); got != 0 {
+		t.Errorf("expected 0 ready workers for non-runtime object, get %d", got)
+	}
+}
+
+func TestRefreshObservedDatasetState(t *testing.T) {
+	observed := datav1alpha1.ObservedDatasetState{UfsTotal: "10.00GiB", FileNum: "100", MountPoints: []string{"oss://bucket/a"}}
+
+	// the metadata is being resynced, the last known values are kept as the baseline
+	for _, unknown := range []string{"", metadataSyncNotDoneMsg} {
+		current := datav1alpha1.ObservedDatasetState{UfsTotal: unknown, FileNum: unknown, MountPoints: []string{"oss://bucket/a", "oss://bucket/b"}}
+		if events := DetectDatasetEvents(observed, current); ContainsString(events, UFSChangedEvent) {
+			t.Errorf("expected no ufs changed event while metadata is unknown, get %v", events)
+		}
+		RefreshObservedDatasetState(&observed, current)
+		expected := datav1alpha1.ObservedDatasetState{UfsTotal: "10.00GiB", FileNum: "100", MountPoints: []string{"oss://bucket/a", "oss://bucket/b"}}
+		if !reflect.DeepEqual(observed, expected) {
+			t.Errorf("expected the last known ufs values kept, get %v", observed)
+		}
+	}
+
+	// the metadata sync finished with the changed values
+	current := datav1alpha1.ObservedDatasetState{UfsTotal: "20.00GiB", FileNum: "200", MountPoints: []string{"oss://bucket/a", "oss://bucket/b"}}
+	if events := DetectDatasetEvents(observed, current); len(events) != 1 || events[0] != UFSChangedEvent {
+		t.Errorf("expected ufs changed event after metadata resynced, get %v", events)
+	}
+	RefreshObservedDatasetState(&observed, current)
+	if !reflect.DeepEqual(observed, current) {
+		t.Errorf("expected the observed state refreshed to %v, get %v", current, observed)
+	}
+}
+
+func TestIsDatasetMountsChanged(t *testing.T) {
+	observed := datav1alpha1.ObservedDatasetState{MountPoints: []string{"oss://bucket/a", "oss://bucket/b"}}
+
+	testcases := []struct {
+		name     string
+		current  []string
+		expected bool
+	}{
+		{name: "no change", current: []string{"oss://bucket/a", "oss://bucket/b"}, expected: false},
+		{name: "mount added", current: []string{"oss://bucket/a", "oss://bucket/b", "oss://bucket/c"}, expected: true},
+		{name: "mount removed", current: []string{"oss://bucket/a"}, expected: true},
+		{name: "mount replaced", current: []string{"oss://bucket/a", "oss://bucket/c"}, expected: true},
+	}
+
+	for _, testcase := range testcases {
+		current := datav1alpha1.ObservedDatasetState{MountPoints: testcase.current}
+		if got := IsDatasetMountsChanged(observed, current); got != testcase.expected {
+			t.Errorf("testcase %s: expected %v, get %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestGetOperationCompletionTime(t *testing.T) {
+	completionTime := metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	testcases := []struct {
+		name     string
+		opStatus *datav1alpha1.OperationStatus
+		expected *metav1.Time
+	}{
+		{
+			name:     "nil status",
+			opStatus: nil,
+			expected: nil,
+		},
+		{
+			name: "operation executing",
+			opStatus: &datav1alpha1.OperationStatus{
+				Phase: common.PhaseExecuting,
+			},
+			expected: nil,
+		},
+		{
+			name: "operation complete",
+			opStatus: &datav1alpha1.OperationStatus{
+				Phase: common.PhaseComplete,
+				Conditions: []datav1alpha1.Condition{
+					{Type: common.Complete, LastTransitionTime: completionTime},
+				},
+			},
+			expected: &completionTime,
+		},
+	}
+
+	for _, testcase := range testcases {
+		got := GetOperationCompletionTime(testcase.opStatus)
+		if !reflect.DeepEqual(got, testcase.expected) {
+			t.Errorf("testcase %s: expected %v, get %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestAppendOperationRunRecord(t *testing.T) {
+	eventStatus := &datav1alpha1.EventStatus{}
+	for i := 0; i < maxOperationRunHistory+2; i++ {
+		AppendOperationRunRecord(eventStatus, datav1alpha1.OperationRunRecord{Duration: fmt.Sprintf("%ds", i)})
+	}
+
+	if len(eventStatus.RunHistory) != maxOperationRunHistory {
+		t.Fatalf("expected %d records, get %d", maxOperationRunHistory, len(eventStatus.RunHistory))
+	}
+	if eventStatus.RunHistory[0].Duration != "2s" {
+		t.Errorf("expected the oldest records to be removed, get first record %v", eventStatus.RunHistory[0])
+	}
+
+	// appending to nil event status should not panic
+	AppendOperationRunRecord(nil, datav1alpha1.OperationRunRecord{})
+}
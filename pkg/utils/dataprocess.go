@@ -47,3 +47,8 @@ func GetDataProcessReleaseName(name string) string {
 func GetDataProcessJobName(releaseName string) string {
 	return fmt.Sprintf("%s-job", releaseName)
 }
+
+// GetDataProcessShardListJobName returns the name of the job listing the files for the shards of the DataProcess.
+func GetDataProcessShardListJobName(releaseName string) string {
+	return fmt.Sprintf("%s-shard-list", releaseName)
+}
@@ -19,6 +19,9 @@ package kubeclient
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+
 	"k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,6 +48,42 @@ func UpdateJob(client client.Client, job *v1.Job) error {
 
 // GetSucceedPodForJob get the first finished pod for the job, if no succeed pod, return nil with no error.
 func GetSucceedPodForJob(c client.Client, job *v1.Job) (*corev1.Pod, error) {
+	pods, err := listPodsForJob(c, job)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods {
+		if IsSucceededPod(&pod) {
+			return &pod, nil
+		}
+	}
+	// no succeed job, return nil with no error.
+	return nil, nil
+}
+
+// GetLastFinishedPodForJob gets the succeeded pod of the job, or the last created failed pod if no pod succeeded.
+// It returns nil with no error if no pod is finished.
+func GetLastFinishedPodForJob(c client.Client, job *v1.Job) (*corev1.Pod, error) {
+	pods, err := listPodsForJob(c, job)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastFailedPod *corev1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if IsSucceededPod(pod) {
+			return pod, nil
+		}
+		if IsFailedPod(pod) && (lastFailedPod == nil || lastFailedPod.CreationTimestamp.Before(&pod.CreationTimestamp)) {
+			lastFailedPod = pod
+		}
+	}
+	return lastFailedPod, nil
+}
+
+func listPodsForJob(c client.Client, job *v1.Job) ([]corev1.Pod, error) {
 	var podList corev1.PodList
 	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
 	if err != nil {
@@ -57,14 +96,7 @@ func GetSucceedPodForJob(c client.Client, job *v1.Job) (*corev1.Pod, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error listing pods for Job %s in namespace %s: %v", job.Name, job.Namespace, err)
 	}
-
-	for _, pod := range podList.Items {
-		if IsSucceededPod(&pod) {
-			return &pod, nil
-		}
-	}
-	// no succeed job, return nil with no error.
-	return nil, nil
+	return podList.Items, nil
 }
 
 // GetFinishedJobCondition get the finished(succeed or failed) condition of the job
@@ -78,3 +110,26 @@ func GetFinishedJobCondition(job *v1.Job) *v1.JobCondition {
 	}
 	return nil
 }
+
+// CountJobIndexes counts the indexes in the interval format used by the status of the Indexed Job,
+// e.g. "1,3-5" has 4 indexes.
+func CountJobIndexes(intervals string) (count int32) {
+	for _, interval := range strings.Split(intervals, ",") {
+		if len(interval) == 0 {
+			continue
+		}
+		lowAndHigh := strings.SplitN(interval, "-", 2)
+		low, err := strconv.Atoi(lowAndHigh[0])
+		if err != nil {
+			continue
+		}
+		high := low
+		if len(lowAndHigh) == 2 {
+			if high, err = strconv.Atoi(lowAndHigh[1]); err != nil || high < low {
+				continue
+			}
+		}
+		count += int32(high - low + 1)
+	}
+	return count
+}
@@ -18,6 +18,7 @@ package kubeclient
 
 import (
 	"context"
+	"time"
 
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	batchv1 "k8s.io/api/batch/v1"
@@ -106,6 +107,79 @@ var _ = Describe("Job related unit tests", Label("pkg.utils.kubeclient.job_test.
 		})
 	})
 
+	Describe("Test GetLastFinishedPodForJob()", func() {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-job",
+				Namespace: "test-ns",
+			},
+			Spec: batchv1.JobSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"job-name": "test-job",
+					},
+				},
+			},
+		}
+		newJobPod := func(name string, phase corev1.PodPhase, creationTime time.Time) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         "test-ns",
+					Labels:            map[string]string{"job-name": "test-job"},
+					CreationTimestamp: metav1.NewTime(creationTime),
+				},
+				Status: corev1.PodStatus{
+					Phase: phase,
+				},
+			}
+		}
+		now := time.Now().Truncate(time.Second)
+
+		When("a pod succeeded after failures", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{job,
+					newJobPod("test-job-1", corev1.PodFailed, now.Add(-2*time.Minute)),
+					newJobPod("test-job-2", corev1.PodSucceeded, now.Add(-time.Minute)),
+				}
+			})
+
+			It("should return the succeeded pod", func() {
+				gotPod, err := GetLastFinishedPodForJob(client, job)
+				Expect(err).To(BeNil())
+				Expect(gotPod.Name).To(Equal("test-job-2"))
+			})
+		})
+
+		When("all pods failed", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{job,
+					newJobPod("test-job-1", corev1.PodFailed, now.Add(-time.Minute)),
+					newJobPod("test-job-2", corev1.PodFailed, now.Add(-2*time.Minute)),
+					newJobPod("test-job-3", corev1.PodRunning, now),
+				}
+			})
+
+			It("should return the last created failed pod", func() {
+				gotPod, err := GetLastFinishedPodForJob(client, job)
+				Expect(err).To(BeNil())
+				Expect(gotPod.Name).To(Equal("test-job-1"))
+			})
+		})
+
+		When("no pod is finished", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{job, newJobPod("test-job-1", corev1.PodRunning, now)}
+			})
+
+			It("should return nil", func() {
+				gotPod, err := GetLastFinishedPodForJob(client, job)
+				Expect(err).To(BeNil())
+				Expect(gotPod).To(BeNil())
+			})
+		})
+	})
+
 	Describe("Test UpdateJob()", func() {
 		job := &batchv1.Job{
 			ObjectMeta: metav1.ObjectMeta{
@@ -264,4 +338,13 @@ var _ = Describe("Job related unit tests", Label("pkg.utils.kubeclient.job_test.
 			})
 		})
 	})
+
+	Describe("Test CountJobIndexes()", func() {
+		It("Should count the indexes in the intervals", func() {
+			Expect(CountJobIndexes("")).To(Equal(int32(0)))
+			Expect(CountJobIndexes("3")).To(Equal(int32(1)))
+			Expect(CountJobIndexes("0,2-5,7")).To(Equal(int32(6)))
+			Expect(CountJobIndexes("1,x,5-3")).To(Equal(int32(1)))
+		})
+	})
 })
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// GetNodeCacheConfigMapName returns the name of the ConfigMap which publishes the cached bytes of the runtime on each node.
+func GetNodeCacheConfigMapName(runtimeName, runtimeType string) string {
+	return fmt.Sprintf("%s-%s-node-cache", runtimeName, runtimeType)
+}
+
+// UpdateNodeCache creates or updates the node cache ConfigMap. Its keys are the node names and its values are
+// the cached bytes on the nodes.
+func UpdateNodeCache(client client.Client, name, namespace, ownerDatasetId string, ownerReference metav1.OwnerReference, nodeCache map[string]int64) error {
+	data := make(map[string]string, len(nodeCache))
+	for node, cached := range nodeCache {
+		data[node] = strconv.FormatInt(cached, 10)
+	}
+
+	configMap := &v1.ConfigMap{}
+	err := client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, configMap)
+	if apierrs.IsNotFound(err) {
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				Labels:          map[string]string{common.LabelAnnotationDatasetId: ownerDatasetId},
+				OwnerReferences: []metav1.OwnerReference{ownerReference},
+			},
+			Data: data,
+		}
+		return client.Create(context.TODO(), configMap)
+	} else if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(configMap.Data, data) {
+		return nil
+	}
+	configMapToUpdate := configMap.DeepCopy()
+	configMapToUpdate.Data = data
+	return client.Update(context.TODO(), configMapToUpdate)
+}
+
+// GetNodeCache gets the cached bytes on each node from the node cache ConfigMap. It returns nil if the ConfigMap
+// is not found, and ignores the values which are not integers.
+func GetNodeCache(client client.Reader, name, namespace string) (nodeCache map[string]int64, err error) {
+	configMap := &v1.ConfigMap{}
+	if err = client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, configMap); err != nil {
+		if apierrs.IsNotFound(err) {
+			err = nil
+		}
+		return nil, err
+	}
+
+	nodeCache = make(map[string]int64, len(configMap.Data))
+	for node, value := range configMap.Data {
+		cached, parseErr := strconv.ParseInt(value, 10, 64)
+		if parseErr != nil {
+			log.V(1).Info("Skip the invalid cached bytes in node cache", "name", name, "namespace", namespace, "node", node, "value", value)
+			continue
+		}
+		nodeCache[node] = cached
+	}
+	return nodeCache, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Node cache related unit tests", Label("pkg.utils.kubeclient.node_cache_test.go"), func() {
+	var client client.Client
+	var resources []runtime.Object
+	owner := metav1.OwnerReference{APIVersion: "data.fluid.io/v1alpha1", Kind: "AlluxioRuntime", Name: "hbase", UID: "uid"}
+	name := GetNodeCacheConfigMapName("hbase", "alluxio")
+
+	JustBeforeEach(func() {
+		client = fake.NewFakeClientWithScheme(testScheme, resources...)
+	})
+
+	It("should name the configmap after the runtime", func() {
+		Expect(name).To(Equal("hbase-alluxio-node-cache"))
+	})
+
+	When("the node cache configmap doesn't exist", func() {
+		BeforeEach(func() {
+			resources = nil
+		})
+
+		It("should return nil node cache", func() {
+			nodeCache, err := GetNodeCache(client, name, "fluid")
+			Expect(err).To(BeNil())
+			Expect(nodeCache).To(BeNil())
+		})
+
+		It("should create the configmap", func() {
+			Expect(UpdateNodeCache(client, name, "fluid", "fluid-hbase", owner, map[string]int64{"node-1": 1024})).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: name}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"node-1": "1024"}))
+			Expect(configMap.Labels).To(HaveKeyWithValue(common.LabelAnnotationDatasetId, "fluid-hbase"))
+			Expect(configMap.OwnerReferences).To(Equal([]metav1.OwnerReference{owner}))
+		})
+	})
+
+	When("the node cache configmap exists", func() {
+		BeforeEach(func() {
+			resources = []runtime.Object{&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid"},
+				Data:       map[string]string{"node-1": "1024", "node-2": "unknown"},
+			}}
+		})
+
+		It("should skip the invalid values", func() {
+			nodeCache, err := GetNodeCache(client, name, "fluid")
+			Expect(err).To(BeNil())
+			Expect(nodeCache).To(Equal(map[string]int64{"node-1": 1024}))
+		})
+
+		It("should replace the node cache", func() {
+			Expect(UpdateNodeCache(client, name, "fluid", "fluid-hbase", owner, map[string]int64{"node-2": 2048, "node-3": 0})).To(Succeed())
+
+			nodeCache, err := GetNodeCache(client, name, "fluid")
+			Expect(err).To(BeNil())
+			Expect(nodeCache).To(Equal(map[string]int64{"node-2": 2048, "node-3": 0}))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const timeWindowLayout = "15:04"
+
+// TimeUntilWindowOpens returns how long to wait until the time window opens, zero if it's already open.
+func TimeUntilWindowOpens(window *datav1alpha1.TimeWindow, now time.Time) (time.Duration, error) {
+	if window == nil {
+		return 0, nil
+	}
+	loc := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return 0, fmt.Errorf("invalid time zone %q of the time window: %v", window.TimeZone, err)
+		}
+	}
+	start, err := time.Parse(timeWindowLayout, window.Start)
+	if err != nil {
+		return 0, fmt.Errorf("invalid start %q of the time window: %v", window.Start, err)
+	}
+	end, err := time.Parse(timeWindowLayout, window.End)
+	if err != nil {
+		return 0, fmt.Errorf("invalid end %q of the time window: %v", window.End, err)
+	}
+
+	now = now.In(loc)
+	opensAt := time.Date(now.Year(), now.Month(), now.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+	closesAt := time.Date(now.Year(), now.Month(), now.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+	if !closesAt.After(opensAt) {
+		// the window spans midnight, e.g. from 22:00 to 06:00
+		if now.Before(closesAt) {
+			return 0, nil
+		}
+		closesAt = closesAt.AddDate(0, 0, 1)
+	}
+
+	if !now.Before(opensAt) && now.Before(closesAt) {
+		return 0, nil
+	}
+	if !now.Before(opensAt) {
+		opensAt = opensAt.AddDate(0, 0, 1)
+	}
+	return opensAt.Sub(now), nil
+}
+
+// ValidateTimeWindow checks if the time window can be parsed and is supported by the policy of the operation.
+// The time window is rejected with the Cron policy, because only the first run waits for it while the later
+// runs follow the schedule.
+func ValidateTimeWindow(window *datav1alpha1.TimeWindow, policy datav1alpha1.Policy) error {
+	if window != nil && policy == datav1alpha1.Cron {
+		return fmt.Errorf("the time window is not supported with the Cron policy, set the schedule inside the time window instead")
+	}
+	_, err := TimeUntilWindowOpens(window, time.Now())
+	return err
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestTimeUntilWindowOpens(t *testing.T) {
+	at := func(value string) time.Time {
+		tm, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+
+	tests := []struct {
+		name    string
+		window  *datav1alpha1.TimeWindow
+		now     time.Time
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "no window",
+			now:  at("2025-01-01T12:00:00Z"),
+			want: 0,
+		},
+		{
+			name:   "inside the window",
+			window: &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00"},
+			now:    at("2025-01-01T03:00:00Z"),
+			want:   0,
+		},
+		{
+			name:   "before the window",
+			window: &datav1alpha1.TimeWindow{Start: "10:00", End: "12:00"},
+			now:    at("2025-01-01T09:30:00Z"),
+			want:   30 * time.Minute,
+		},
+		{
+			name:   "after the window",
+			window: &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00"},
+			now:    at("2025-01-01T06:00:00Z"),
+			want:   18 * time.Hour,
+		},
+		{
+			name:   "inside the window spanning midnight",
+			window: &datav1alpha1.TimeWindow{Start: "22:00", End: "06:00"},
+			now:    at("2025-01-01T01:00:00Z"),
+			want:   0,
+		},
+		{
+			name:   "outside the window spanning midnight",
+			window: &datav1alpha1.TimeWindow{Start: "22:00", End: "06:00"},
+			now:    at("2025-01-01T20:00:00Z"),
+			want:   2 * time.Hour,
+		},
+		{
+			name:   "window in another time zone",
+			window: &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00", TimeZone: "Asia/Shanghai"},
+			now:    at("2025-01-01T15:00:00Z"),
+			want:   time.Hour,
+		},
+		{
+			name:    "invalid time zone",
+			window:  &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00", TimeZone: "Mars/Olympus"},
+			now:     at("2025-01-01T15:00:00Z"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid start",
+			window:  &datav1alpha1.TimeWindow{Start: "24:00", End: "06:00"},
+			now:     at("2025-01-01T15:00:00Z"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TimeUntilWindowOpens(tt.window, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expect error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expect %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateTimeWindow(t *testing.T) {
+	window := &datav1alpha1.TimeWindow{Start: "00:00", End: "06:00"}
+	tests := []struct {
+		name    string
+		window  *datav1alpha1.TimeWindow
+		policy  datav1alpha1.Policy
+		wantErr bool
+	}{
+		{
+			name:   "window with once policy",
+			window: window,
+			policy: datav1alpha1.Once,
+		},
+		{
+			name:    "window with cron policy",
+			window:  window,
+			policy:  datav1alpha1.Cron,
+			wantErr: true,
+		},
+		{
+			name:   "no window with cron policy",
+			policy: datav1alpha1.Cron,
+		},
+		{
+			name:    "invalid window",
+			window:  &datav1alpha1.TimeWindow{Start: "00:00", End: "6:00am"},
+			policy:  datav1alpha1.OnEvent,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTimeWindow(tt.window, tt.policy); (err != nil) != tt.wantErr {
+				t.Errorf("expect error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
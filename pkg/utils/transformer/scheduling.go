@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformer
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TransformSchedulingPolicies copies the priority class and the topology spread constraints of a runtime component
+// into the helm values of the component.
+func TransformSchedulingPolicies(priorityClassName string, constraints []corev1.TopologySpreadConstraint,
+	valuePriorityClassName *string, valueConstraints *[]corev1.TopologySpreadConstraint) {
+	*valuePriorityClassName = priorityClassName
+	*valueConstraints = constraints
+}
+
+// TransformUnstructuredSchedulingPolicies is TransformSchedulingPolicies for the engines whose helm values are
+// marshaled by gopkg.in/yaml.v2, see ToUnstructuredTopologySpreadConstraints.
+func TransformUnstructuredSchedulingPolicies(priorityClassName string, constraints []corev1.TopologySpreadConstraint,
+	valuePriorityClassName *string, valueConstraints *[]map[string]interface{}) (err error) {
+	unstructured, err := ToUnstructuredTopologySpreadConstraints(constraints)
+	if err != nil {
+		return
+	}
+	*valuePriorityClassName = priorityClassName
+	*valueConstraints = unstructured
+	return
+}
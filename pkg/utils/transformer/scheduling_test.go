@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformer
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTransformSchedulingPolicies(t *testing.T) {
+	constraints := []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       "topology.kubernetes.io/zone",
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"role": "alluxio-worker"}},
+	}}
+
+	tests := []struct {
+		name              string
+		priorityClassName string
+		constraints       []corev1.TopologySpreadConstraint
+	}{
+		{
+			name: "no policies",
+		},
+		{
+			name:              "priority class and constraints",
+			priorityClassName: "high-priority",
+			constraints:       constraints,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var priorityClassName string
+			var gotConstraints []corev1.TopologySpreadConstraint
+			TransformSchedulingPolicies(tt.priorityClassName, tt.constraints, &priorityClassName, &gotConstraints)
+			if priorityClassName != tt.priorityClassName {
+				t.Errorf("expect priority class %q, got %q", tt.priorityClassName, priorityClassName)
+			}
+			if !reflect.DeepEqual(gotConstraints, tt.constraints) {
+				t.Errorf("expect constraints %v, got %v", tt.constraints, gotConstraints)
+			}
+		})
+	}
+}
+
+func TestTransformUnstructuredSchedulingPolicies(t *testing.T) {
+	var priorityClassName string
+	var constraints []map[string]interface{}
+	err := TransformUnstructuredSchedulingPolicies("high-priority", []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       "kubernetes.io/hostname",
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+	}}, &priorityClassName, &constraints)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if priorityClassName != "high-priority" {
+		t.Errorf("expect priority class %q, got %q", "high-priority", priorityClassName)
+	}
+	want := []map[string]interface{}{{
+		"maxSkew":           int64(1),
+		"topologyKey":       "kubernetes.io/hostname",
+		"whenUnsatisfiable": "DoNotSchedule",
+	}}
+	if !reflect.DeepEqual(constraints, want) {
+		t.Errorf("expect constraints %v, got %v", want, constraints)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformer
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// ToUnstructuredTopologySpreadConstraints converts the topology spread constraints into maps keyed by their json field names.
+// It's used by the engines whose helm values are marshaled by gopkg.in/yaml.v2, which ignores the json tags of the k8s api types.
+func ToUnstructuredTopologySpreadConstraints(constraints []corev1.TopologySpreadConstraint) (ret []map[string]interface{}, err error) {
+	for i := range constraints {
+		constraint, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(&constraints[i])
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, constraint)
+	}
+	return ret, nil
+}
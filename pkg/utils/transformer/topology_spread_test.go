@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformer
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToUnstructuredTopologySpreadConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints []corev1.TopologySpreadConstraint
+		want        []map[string]interface{}
+	}{
+		{
+			name:        "no constraints",
+			constraints: nil,
+			want:        nil,
+		},
+		{
+			name: "constraint with label selector",
+			constraints: []corev1.TopologySpreadConstraint{{
+				MaxSkew:           1,
+				TopologyKey:       "topology.kubernetes.io/zone",
+				WhenUnsatisfiable: corev1.DoNotSchedule,
+				LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"role": "goosefs-worker"}},
+			}},
+			want: []map[string]interface{}{{
+				"maxSkew":           int64(1),
+				"topologyKey":       "topology.kubernetes.io/zone",
+				"whenUnsatisfiable": "DoNotSchedule",
+				"labelSelector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{"role": "goosefs-worker"},
+				},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToUnstructuredTopologySpreadConstraints(tt.constraints)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expect %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
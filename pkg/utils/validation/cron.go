@@ -0,0 +1,49 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+)
+
+const invalidCronScheduleErrMsgFmt string = "invalid cron schedule '%s': %s"
+
+// IsValidCronSchedule checks if the schedule is accepted by the CronJob of Kubernetes, which is either
+// the standard cron format with five fields or a predefined descriptor like '@daily' and '@every 1h'.
+// The time zone prefix like 'CRON_TZ=UTC' is rejected as the CronJob of Kubernetes does.
+func IsValidCronSchedule(schedule string) error {
+	_, err := ParseCronSchedule(schedule)
+	return err
+}
+
+// ParseCronSchedule parses the schedule in the same format as IsValidCronSchedule accepts with the same
+// parser as the CronJob of Kubernetes. The schedule is in the local time zone.
+func ParseCronSchedule(schedule string) (cron.Schedule, error) {
+	spec := strings.TrimSpace(schedule)
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		return nil, fmt.Errorf(invalidCronScheduleErrMsgFmt, schedule, "the time zone prefix TZ or CRON_TZ is not supported")
+	}
+
+	s, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf(invalidCronScheduleErrMsgFmt, schedule, err.Error())
+	}
+	return s, nil
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// parseCronScheduleIn parses the schedule in the given time zone instead of the local one
+func parseCronScheduleIn(t *testing.T, schedule string, location *time.Location) cron.Schedule {
+	s, err := ParseCronSchedule(schedule)
+	if err != nil {
+		t.Fatalf("ParseCronSchedule(%q) got error %v", schedule, err)
+	}
+	if spec, ok := s.(*cron.SpecSchedule); ok {
+		spec.Location = location
+	}
+	return s
+}
+
+func TestIsValidCronSchedule(t *testing.T) {
+	testCases := []struct {
+		schedule string
+		wantErr  bool
+	}{
+		{schedule: "* * * * *"},
+		{schedule: "*/5 0-6 1,15 * MON-FRI"},
+		{schedule: "0 12 * jan,jul sun"},
+		{schedule: "5/10 * ? * *"},
+		{schedule: "@daily"},
+		{schedule: "@every 1h30m"},
+		{schedule: "", wantErr: true},
+		{schedule: "* * * *", wantErr: true},
+		{schedule: "* * * * * *", wantErr: true},
+		{schedule: "60 * * * *", wantErr: true},
+		{schedule: "* 24 * * *", wantErr: true},
+		{schedule: "* * 0 * *", wantErr: true},
+		{schedule: "* * * 13 *", wantErr: true},
+		{schedule: "* * * * 7", wantErr: true},
+		{schedule: "*/0 * * * *", wantErr: true},
+		{schedule: "10-5 * * * *", wantErr: true},
+		{schedule: "1-2-3 * * * *", wantErr: true},
+		{schedule: "a * * * *", wantErr: true},
+		{schedule: "@sometimes", wantErr: true},
+		{schedule: "@every 1x", wantErr: true},
+		{schedule: "TZ=UTC", wantErr: true},
+		{schedule: "TZ=UTC 0 2 * * *", wantErr: true},
+		{schedule: "CRON_TZ=Asia/Shanghai 0 2 * * *", wantErr: true},
+	}
+
+	for _, test := range testCases {
+		err := IsValidCronSchedule(test.schedule)
+		if (err != nil) != test.wantErr {
+			t.Errorf("IsValidCronSchedule(%q) error = %v, wantErr %v", test.schedule, err, test.wantErr)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	from := time.Date(2025, 3, 14, 10, 30, 15, 0, time.UTC)
+	testCases := []struct {
+		schedule string
+		want     time.Time
+	}{
+		{schedule: "* * * * *", want: time.Date(2025, 3, 14, 10, 31, 0, 0, time.UTC)},
+		{schedule: "*/20 * * * *", want: time.Date(2025, 3, 14, 10, 40, 0, 0, time.UTC)},
+		{schedule: "0 2 * * *", want: time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC)},
+		{schedule: "@monthly", want: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{schedule: "0 0 * * mon", want: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)},
+		// the day of month and the day of week are matched with "or" if both are restricted
+		{schedule: "0 0 20 * mon", want: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)},
+		{schedule: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{schedule: "@every 1h", want: time.Date(2025, 3, 14, 11, 30, 15, 0, time.UTC)},
+		{schedule: "0 0 30 2 *", want: time.Time{}},
+	}
+
+	for _, test := range testCases {
+		s := parseCronScheduleIn(t, test.schedule, time.UTC)
+		if got := s.Next(from); !got.Equal(test.want) {
+			t.Errorf("Next() of %q = %v, want %v", test.schedule, got, test.want)
+		}
+	}
+}
+
+func TestCronScheduleNextDayOfMonthOrDayOfWeek(t *testing.T) {
+	// Friday
+	from := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		schedule string
+		want     time.Time
+	}{
+		// only the restricted one of the day of month and the day of week is matched
+		{schedule: "0 0 15 * *", want: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{schedule: "0 0 * * fri", want: time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{schedule: "0 0 ? * 1-5", want: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)},
+		// either of them is matched if both are restricted
+		{schedule: "0 0 1 * fri", want: time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{schedule: "0 0 16 * fri", want: time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{schedule: "0 0 31 * mon", want: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)},
+		// a step greater than 1 makes the field restricted
+		{schedule: "0 0 */2 * sun", want: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		// the nonexistent day of month doesn't stop matching the day of week
+		{schedule: "0 0 30 2 fri", want: time.Date(2026, 2, 6, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range testCases {
+		s := parseCronScheduleIn(t, test.schedule, time.UTC)
+		if got := s.Next(from); !got.Equal(test.want) {
+			t.Errorf("Next() of %q = %v, want %v", test.schedule, got, test.want)
+		}
+	}
+}
+
+func TestCronScheduleNextDST(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	// the clock jumps from 02:00 EST to 03:00 EDT on 2025-03-09, and from 02:00 EDT back to 01:00 EST on 2025-11-02
+	est := time.FixedZone("EST", -5*3600)
+	edt := time.FixedZone("EDT", -4*3600)
+	testCases := []struct {
+		name     string
+		schedule string
+		from     time.Time
+		want     []time.Time
+	}{
+		{
+			name:     "skipped time never matches",
+			schedule: "30 2 * * *",
+			from:     time.Date(2025, 3, 8, 12, 0, 0, 0, est),
+			want: []time.Time{
+				time.Date(2025, 3, 10, 2, 30, 0, 0, edt),
+			},
+		},
+		{
+			name:     "hourly across the skipped hour",
+			schedule: "0 * * * *",
+			from:     time.Date(2025, 3, 9, 0, 30, 0, 0, est),
+			want: []time.Time{
+				time.Date(2025, 3, 9, 1, 0, 0, 0, est),
+				time.Date(2025, 3, 9, 3, 0, 0, 0, edt),
+			},
+		},
+		{
+			// the same as the CronJob of Kubernetes
+			name:     "repeated time matches twice",
+			schedule: "30 1 * * *",
+			from:     time.Date(2025, 11, 2, 0, 0, 0, 0, edt),
+			want: []time.Time{
+				time.Date(2025, 11, 2, 1, 30, 0, 0, edt),
+				time.Date(2025, 11, 2, 1, 30, 0, 0, est),
+				time.Date(2025, 11, 3, 1, 30, 0, 0, est),
+			},
+		},
+		{
+			name:     "hourly across the repeated hour",
+			schedule: "0 * * * *",
+			from:     time.Date(2025, 11, 2, 0, 30, 0, 0, edt),
+			want: []time.Time{
+				time.Date(2025, 11, 2, 1, 0, 0, 0, edt),
+				time.Date(2025, 11, 2, 1, 0, 0, 0, est),
+				time.Date(2025, 11, 2, 2, 0, 0, 0, est),
+			},
+		},
+		{
+			name:     "daily keeps the wall clock",
+			schedule: "0 12 * * *",
+			from:     time.Date(2025, 3, 8, 13, 0, 0, 0, est),
+			want: []time.Time{
+				time.Date(2025, 3, 9, 12, 0, 0, 0, edt),
+				time.Date(2025, 3, 10, 12, 0, 0, 0, edt),
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			s := parseCronScheduleIn(t, test.schedule, location)
+			next := test.from
+			for _, want := range test.want {
+				next = s.Next(next)
+				if !next.Equal(want) {
+					t.Fatalf("Next() of %q = %v, want %v", test.schedule, next, want)
+				}
+			}
+		})
+	}
+}
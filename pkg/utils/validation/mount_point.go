@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+const invalidMountPointErrMsgFmt string = "invalid mount point '%s': %s"
+
+const schemeSeparator string = "://"
+
+// IsValidMountPoint checks if the mount point of a dataset is in the form of '<scheme>://<path>'.
+// The mount points with fluid native schemes are further checked as Fluid resolves them by itself:
+// 'local://' must be followed by an absolute path, 'pvc://' by a valid PVC name and
+// 'dataset://' by '<namespace>/<name>'.
+func IsValidMountPoint(mountPoint string) error {
+	idx := strings.Index(mountPoint, schemeSeparator)
+	if idx <= 0 {
+		return fmt.Errorf(invalidMountPointErrMsgFmt, mountPoint, "the mount point must be in the form of '<scheme>://<path>'")
+	}
+	if strings.ContainsAny(mountPoint[:idx], " \t\n/") {
+		return fmt.Errorf(invalidMountPointErrMsgFmt, mountPoint, "the scheme must not contain whitespaces or '/'")
+	}
+
+	switch {
+	case strings.HasPrefix(mountPoint, common.PathScheme.String()):
+		path := strings.TrimPrefix(mountPoint, common.PathScheme.String())
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf(invalidMountPointErrMsgFmt, mountPoint, "the path of 'local://' must be an absolute path")
+		}
+	case strings.HasPrefix(mountPoint, common.VolumeScheme.String()):
+		pvcName := strings.SplitN(strings.TrimPrefix(mountPoint, common.VolumeScheme.String()), "/", 2)[0]
+		if errs := validation.IsDNS1123Subdomain(pvcName); len(errs) > 0 {
+			return fmt.Errorf(invalidMountPointErrMsgFmt, mountPoint, fmt.Sprintf("invalid pvc name '%s': %s", pvcName, strings.Join(errs, ", ")))
+		}
+	case common.IsFluidRefSchema(mountPoint):
+		namespaceAndName := strings.SplitN(strings.TrimPrefix(mountPoint, common.RefSchema.String()), "/", 3)
+		if len(namespaceAndName) < 2 || len(namespaceAndName[0]) == 0 || len(namespaceAndName[1]) == 0 {
+			return fmt.Errorf(invalidMountPointErrMsgFmt, mountPoint, "the mount point of 'dataset://' must be in the form of 'dataset://<namespace>/<name>'")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import "testing"
+
+func TestIsValidMountPoint(t *testing.T) {
+	testCases := []struct {
+		mountPoint string
+		wantErr    bool
+	}{
+		{mountPoint: "s3://bucket/path"},
+		{mountPoint: "https://mirrors.bit.edu.cn/apache/spark/"},
+		{mountPoint: "juicefs:///"},
+		{mountPoint: "local:///mnt/data"},
+		{mountPoint: "pvc://nfs-imagenet"},
+		{mountPoint: "pvc://nfs-imagenet/sub/path"},
+		{mountPoint: "dataset://default/phy"},
+		{mountPoint: "dataset://default/phy/sub"},
+		{mountPoint: "/mnt/data", wantErr: true},
+		{mountPoint: "://bucket", wantErr: true},
+		{mountPoint: "s 3://bucket", wantErr: true},
+		{mountPoint: "local://mnt/data", wantErr: true},
+		{mountPoint: "pvc://", wantErr: true},
+		{mountPoint: "pvc://NFS_Imagenet/path", wantErr: true},
+		{mountPoint: "dataset://default", wantErr: true},
+		{mountPoint: "dataset:///phy", wantErr: true},
+	}
+
+	for _, test := range testCases {
+		err := IsValidMountPoint(test.mountPoint)
+		if (err != nil) != test.wantErr {
+			t.Errorf("IsValidMountPoint(%q) error = %v, wantErr %v", test.mountPoint, err, test.wantErr)
+		}
+	}
+}
@@ -118,3 +118,37 @@ func (c *CertificateBuilder) PatchCABundle(webhookName string, ca []byte) error
 
 	return nil
 }
+
+// PatchValidatingCABundle patch the caBundle to ValidatingWebhookConfiguration
+func (c *CertificateBuilder) PatchValidatingCABundle(webhookName string, ca []byte) error {
+
+	var m v1.ValidatingWebhookConfiguration
+
+	c.log.Info("start patch ValidatingWebhookConfiguration caBundle", "name", webhookName)
+
+	ctx := context.Background()
+
+	if err := c.Get(ctx, client.ObjectKey{Name: webhookName}, &m); err != nil {
+		c.log.Error(err, "fail to get validatingWebHook", "name", webhookName)
+		return err
+	}
+
+	current := m.DeepCopy()
+	for i := range m.Webhooks {
+		m.Webhooks[i].ClientConfig.CABundle = ca
+	}
+
+	if reflect.DeepEqual(m.Webhooks, current.Webhooks) {
+		c.log.Info("no need to patch the ValidatingWebhookConfiguration", "name", webhookName)
+		return nil
+	}
+
+	if err := c.Patch(ctx, &m, client.MergeFrom(current)); err != nil {
+		c.log.Error(err, "fail to patch CABundle to validatingWebHook", "name", webhookName)
+		return err
+	}
+
+	c.log.Info("finished patch ValidatingWebhookConfiguration caBundle", "name", webhookName)
+
+	return nil
+}
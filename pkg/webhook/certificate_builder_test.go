@@ -280,3 +280,40 @@ func TestPatchCABundle(t *testing.T) {
 	}
 
 }
+
+func TestPatchValidatingCABundle(t *testing.T) {
+	var mockWebhookName = "mockValidatingWebhookName"
+	var testValidatingWebhookConfiguration = &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: mockWebhookName,
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name: "webhook1",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					CABundle: []byte{3, 5, 54, 34},
+				},
+			},
+		},
+	}
+
+	testScheme.AddKnownTypes(schema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1"}, testValidatingWebhookConfiguration)
+	client := fake.NewFakeClientWithScheme(testScheme, testValidatingWebhookConfiguration)
+	cb := NewCertificateBuilder(client, log)
+
+	ca := []byte{1, 2, 3}
+	if err := cb.PatchValidatingCABundle(mockWebhookName, ca); err != nil {
+		t.Fatalf("fail to patch ValidatingWebhookConfiguration: %v", err)
+	}
+	var vc admissionregistrationv1.ValidatingWebhookConfiguration
+	if err := client.Get(context.TODO(), types.NamespacedName{Name: mockWebhookName}, &vc); err != nil {
+		t.Fatalf("fail to get ValidatingWebhookConfiguration: %v", err)
+	}
+	if !reflect.DeepEqual(vc.Webhooks[0].ClientConfig.CABundle, ca) {
+		t.Errorf("expect CABundle %v, got %v", ca, vc.Webhooks[0].ClientConfig.CABundle)
+	}
+
+	if err := cb.PatchValidatingCABundle("notExist", ca); err == nil || utils.IgnoreNotFound(err) != nil {
+		t.Errorf("expect not found error, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/validation"
+)
+
+func validateDataOperation(reader client.Reader, kind string, operation client.Object) (errs field.ErrorList) {
+	policy, schedule := getOperationSpec(operation)
+
+	if policy == datav1alpha1.Cron {
+		if err := validation.IsValidCronSchedule(schedule); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec").Child("schedule"), schedule, err.Error()))
+		}
+	}
+
+	if hasRunAfter, hasRunAfterList := getRunAfterSpec(operation); hasRunAfter && hasRunAfterList {
+		errs = append(errs, field.Forbidden(field.NewPath("spec").Child("runAfterList"), "runAfter and runAfterList can't be set together"))
+	}
+
+	if runAfters, _ := utils.GetPrecedingOperations(operation); len(runAfters) > 0 {
+		if cycle, err := findRunAfterCycle(reader, kind, operation); err != nil {
+			setupLog.Error(err, "failed to check the cycle of runAfter, skip it", "kind", kind,
+				"name", operation.GetName(), "namespace", operation.GetNamespace())
+		} else if len(cycle) > 0 {
+			errs = append(errs, field.Invalid(field.NewPath("spec").Child("runAfter"), runAfters,
+				fmt.Sprintf("runAfter forms a cycle: %s", strings.Join(cycle, " -> "))))
+		}
+	}
+	return
+}
+
+// findRunAfterCycle walks the graph of preceding operations starting from the given operation in depth first order,
+// and returns the operations in the path if it comes back to the given operation.
+func findRunAfterCycle(reader client.Reader, kind string, operation client.Object) (cycle []string, err error) {
+	self := operationKey(kind, operation.GetNamespace(), operation.GetName())
+	visited := map[string]bool{self: true}
+
+	var walk func(path []string, operation client.Object) ([]string, error)
+	walk = func(path []string, operation client.Object) ([]string, error) {
+		runAfters, _ := utils.GetPrecedingOperations(operation)
+		for _, runAfter := range runAfters {
+			namespace := operation.GetNamespace()
+			if len(runAfter.Namespace) != 0 {
+				namespace = runAfter.Namespace
+			}
+			key := operationKey(runAfter.Kind, namespace, runAfter.Name)
+			if key == self {
+				return append(path, key), nil
+			}
+			// the operation is checked, or it's in a cycle which doesn't contain the given operation and isn't
+			// introduced by this request
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			preceding, err := newObjectForKind(runAfter.Kind)
+			if err != nil {
+				return nil, err
+			}
+			err = reader.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: runAfter.Name}, preceding)
+			if err != nil {
+				// the preceding operation may be created later
+				if utils.IgnoreNotFound(err) == nil {
+					continue
+				}
+				return nil, err
+			}
+			if cycle, err := walk(append(path[:len(path):len(path)], key), preceding); err != nil || len(cycle) > 0 {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return walk([]string{self}, operation)
+}
+
+func getOperationSpec(operation client.Object) (policy datav1alpha1.Policy, schedule string) {
+	switch op := operation.(type) {
+	case *datav1alpha1.DataLoad:
+		return op.Spec.Policy, op.Spec.Schedule
+	case *datav1alpha1.DataMigrate:
+		return op.Spec.Policy, op.Spec.Schedule
+	case *datav1alpha1.DataProcess:
+		return op.Spec.Policy, op.Spec.Schedule
+	}
+	return
+}
+
+func getRunAfterSpec(operation client.Object) (hasRunAfter bool, hasRunAfterList bool) {
+	switch op := operation.(type) {
+	case *datav1alpha1.DataLoad:
+		return op.Spec.RunAfter != nil, op.Spec.RunAfterList != nil
+	case *datav1alpha1.DataMigrate:
+		return op.Spec.RunAfter != nil, op.Spec.RunAfterList != nil
+	case *datav1alpha1.DataProcess:
+		return op.Spec.RunAfter != nil, op.Spec.RunAfterList != nil
+	case *datav1alpha1.DataBackup:
+		return op.Spec.RunAfter != nil, op.Spec.RunAfterList != nil
+	}
+	return
+}
+
+func operationKey(kind, namespace, name string) string {
+	return fmt.Sprintf("%s(%s/%s)", kind, namespace, name)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func runAfter(kind, name, namespace string) *datav1alpha1.OperationRef {
+	return &datav1alpha1.OperationRef{
+		ObjectRef: datav1alpha1.ObjectRef{Kind: kind, Name: name, Namespace: namespace},
+	}
+}
+
+func TestValidateDataOperation(t *testing.T) {
+	// DataLoad(fluid/a) -> DataProcess(fluid/b) -> DataMigrate(other/c) -> DataBackup(fluid/d)
+	existing := []runtime.Object{
+		&datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "fluid"},
+			Spec:       datav1alpha1.DataLoadSpec{RunAfter: runAfter("DataProcess", "b", "")},
+		},
+		&datav1alpha1.DataProcess{
+			ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "fluid"},
+			Spec:       datav1alpha1.DataProcessSpec{RunAfter: runAfter("DataMigrate", "c", "other")},
+		},
+		&datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "other"},
+			Spec:       datav1alpha1.DataMigrateSpec{RunAfter: runAfter("DataBackup", "d", "fluid")},
+		},
+		&datav1alpha1.DataBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "e", Namespace: "fluid"},
+			Spec:       datav1alpha1.DataBackupSpec{RunAfter: runAfter("DataBackup", "f", "")},
+		},
+		&datav1alpha1.DataBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "f", Namespace: "fluid"},
+			Spec:       datav1alpha1.DataBackupSpec{RunAfter: runAfter("DataBackup", "e", "")},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		kind      string
+		operation client.Object
+		wantErr   bool
+	}{
+		{
+			name: "valid cron schedule",
+			kind: "DataLoad",
+			operation: &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec:       datav1alpha1.DataLoadSpec{Policy: datav1alpha1.Cron, Schedule: "*/10 * * * *"},
+			},
+		},
+		{
+			name: "invalid cron schedule",
+			kind: "DataMigrate",
+			operation: &datav1alpha1.DataMigrate{
+				ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "fluid"},
+				Spec:       datav1alpha1.DataMigrateSpec{Policy: datav1alpha1.Cron, Schedule: "*/10 * * *"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "schedule is ignored without cron policy",
+			kind: "DataProcess",
+			operation: &datav1alpha1.DataProcess{
+				ObjectMeta: metav1.ObjectMeta{Name: "process", Namespace: "fluid"},
+				Spec:       datav1alpha1.DataProcessSpec{Policy: datav1alpha1.Once, Schedule: "invalid"},
+			},
+		},
+		{
+			name: "runAfter without cycle",
+			kind: "DataLoad",
+			operation: &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec:       datav1alpha1.DataLoadSpec{RunAfter: runAfter("DataLoad", "a", "")},
+			},
+		},
+		{
+			name: "runAfter forms a cycle",
+			kind: "DataBackup",
+			operation: &datav1alpha1.DataBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "fluid"},
+				Spec:       datav1alpha1.DataBackupSpec{RunAfter: runAfter("DataLoad", "a", "")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "runAfter itself",
+			kind: "DataLoad",
+			operation: &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec:       datav1alpha1.DataLoadSpec{RunAfter: runAfter("DataLoad", "load", "fluid")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "runAfterList without cycle",
+			kind: "DataProcess",
+			operation: &datav1alpha1.DataProcess{
+				ObjectMeta: metav1.ObjectMeta{Name: "process", Namespace: "fluid"},
+				Spec: datav1alpha1.DataProcessSpec{RunAfterList: &datav1alpha1.OperationRefList{
+					Mode:       datav1alpha1.AnyOfMode,
+					Operations: []datav1alpha1.OperationRef{*runAfter("DataLoad", "a", ""), *runAfter("DataBackup", "e", "")},
+				}},
+			},
+		},
+		{
+			name: "runAfterList forms a cycle through the second operation",
+			kind: "DataBackup",
+			operation: &datav1alpha1.DataBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "fluid"},
+				Spec: datav1alpha1.DataBackupSpec{RunAfterList: &datav1alpha1.OperationRefList{
+					Operations: []datav1alpha1.OperationRef{*runAfter("DataBackup", "e", ""), *runAfter("DataLoad", "a", "")},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "runAfter and runAfterList set together",
+			kind: "DataLoad",
+			operation: &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec: datav1alpha1.DataLoadSpec{
+					RunAfter:     runAfter("DataLoad", "a", ""),
+					RunAfterList: &datav1alpha1.OperationRefList{Operations: []datav1alpha1.OperationRef{*runAfter("DataBackup", "e", "")}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "runAfter an existing cycle",
+			kind: "DataLoad",
+			operation: &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec:       datav1alpha1.DataLoadSpec{RunAfter: runAfter("DataBackup", "e", "")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(testScheme, existing...)
+			errs := validateDataOperation(c, tt.kind, tt.operation)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateDataOperation() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/validation"
+)
+
+// ufsUpdatableRuntimes are the types of runtimes which support updating the mounts of the bound dataset,
+// i.e. the engines implement UpdateOnUFSChange.
+var ufsUpdatableRuntimes = sets.NewString(
+	common.AlluxioRuntime,
+	common.GooseFSRuntime,
+	common.ThinRuntime,
+)
+
+func validateDataset(dataset *datav1alpha1.Dataset, oldDataset *datav1alpha1.Dataset) (errs field.ErrorList) {
+	mountsPath := field.NewPath("spec").Child("mounts")
+
+	existedMountNames := map[string]bool{}
+	for idx, mount := range dataset.Spec.Mounts {
+		if err := validation.IsValidMountPoint(mount.MountPoint); err != nil {
+			errs = append(errs, field.Invalid(mountsPath.Index(idx).Child("mountPoint"), mount.MountPoint, err.Error()))
+		}
+
+		if len(mount.Name) == 0 {
+			continue
+		}
+		if existedMountNames[mount.Name] {
+			errs = append(errs, field.Duplicate(mountsPath.Index(idx).Child("name"), mount.Name))
+		}
+		existedMountNames[mount.Name] = true
+	}
+
+	if oldDataset == nil || reflect.DeepEqual(oldDataset.Spec.Mounts, dataset.Spec.Mounts) {
+		return
+	}
+	for _, runtime := range oldDataset.Status.Runtimes {
+		if !ufsUpdatableRuntimes.Has(runtime.Type) {
+			errs = append(errs, field.Forbidden(mountsPath,
+				fmt.Sprintf("the mounts can't be changed because the dataset is bound to the %s runtime %s/%s which doesn't support updating the mounts",
+					runtime.Type, runtime.Namespace, runtime.Name)))
+		}
+	}
+	return
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestValidateDataset(t *testing.T) {
+	newDataset := func(runtimeType string, mounts ...datav1alpha1.Mount) *datav1alpha1.Dataset {
+		dataset := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+			Spec:       datav1alpha1.DatasetSpec{Mounts: mounts},
+		}
+		if len(runtimeType) > 0 {
+			dataset.Status.Runtimes = []datav1alpha1.Runtime{{Name: "hbase", Namespace: "fluid", Type: runtimeType}}
+		}
+		return dataset
+	}
+
+	tests := []struct {
+		name       string
+		dataset    *datav1alpha1.Dataset
+		oldDataset *datav1alpha1.Dataset
+		wantErrs   []field.ErrorType
+	}{
+		{
+			name: "valid mounts",
+			dataset: newDataset("",
+				datav1alpha1.Mount{Name: "spark", MountPoint: "https://mirrors.bit.edu.cn/apache/spark/"},
+				datav1alpha1.Mount{Name: "local", MountPoint: "local:///mnt/data"},
+				datav1alpha1.Mount{MountPoint: "pvc://nfs-imagenet"},
+				datav1alpha1.Mount{MountPoint: "pvc://nfs-imagenet/sub"}),
+		},
+		{
+			name: "malformed mount schemes",
+			dataset: newDataset("",
+				datav1alpha1.Mount{Name: "spark", MountPoint: "mirrors.bit.edu.cn/apache/spark/"},
+				datav1alpha1.Mount{Name: "local", MountPoint: "local://mnt/data"}),
+			wantErrs: []field.ErrorType{field.ErrorTypeInvalid, field.ErrorTypeInvalid},
+		},
+		{
+			name: "duplicate mount names",
+			dataset: newDataset("",
+				datav1alpha1.Mount{Name: "data", MountPoint: "s3://bucket/a"},
+				datav1alpha1.Mount{Name: "data", MountPoint: "s3://bucket/b"}),
+			wantErrs: []field.ErrorType{field.ErrorTypeDuplicate},
+		},
+		{
+			name:       "change mounts of dataset bound to runtime supporting ufs update",
+			dataset:    newDataset(common.AlluxioRuntime, datav1alpha1.Mount{Name: "data", MountPoint: "s3://bucket/b"}),
+			oldDataset: newDataset(common.AlluxioRuntime, datav1alpha1.Mount{Name: "data", MountPoint: "s3://bucket/a"}),
+		},
+		{
+			name:       "change mounts of dataset bound to runtime not supporting ufs update",
+			dataset:    newDataset(common.JuiceFSRuntime, datav1alpha1.Mount{Name: "data", MountPoint: "juicefs:///b"}),
+			oldDataset: newDataset(common.JuiceFSRuntime, datav1alpha1.Mount{Name: "data", MountPoint: "juicefs:///a"}),
+			wantErrs:   []field.ErrorType{field.ErrorTypeForbidden},
+		},
+		{
+			name:       "change mounts of unbound dataset",
+			dataset:    newDataset("", datav1alpha1.Mount{Name: "data", MountPoint: "juicefs:///b"}),
+			oldDataset: newDataset("", datav1alpha1.Mount{Name: "data", MountPoint: "juicefs:///a"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateDataset(tt.dataset, tt.oldDataset)
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("expect %d errors, got %v", len(tt.wantErrs), errs)
+			}
+			for i, err := range errs {
+				if err.Type != tt.wantErrs[i] {
+					t.Errorf("expect error type %s, got %v", tt.wantErrs[i], err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// runtimeTypes maps the kinds of runtimes to the types recorded in the status of the bound dataset
+var runtimeTypes = map[string]string{
+	"AlluxioRuntime":  common.AlluxioRuntime,
+	"JindoRuntime":    common.JindoRuntime,
+	"GooseFSRuntime":  common.GooseFSRuntime,
+	"JuiceFSRuntime":  common.JuiceFSRuntime,
+	"ThinRuntime":     common.ThinRuntime,
+	"EFCRuntime":      common.EFCRuntime,
+	"VineyardRuntime": common.VineyardRuntime,
+}
+
+// validateRuntime rejects the runtime whose dataset has already been bound to a runtime of another type,
+// which the runtime controller would otherwise wait for forever.
+func validateRuntime(reader client.Reader, kind string, runtime client.Object) (errs field.ErrorList) {
+	runtimeType, found := runtimeTypes[kind]
+	if !found {
+		return
+	}
+
+	dataset := &datav1alpha1.Dataset{}
+	err := reader.Get(context.TODO(), types.NamespacedName{Namespace: runtime.GetNamespace(), Name: runtime.GetName()}, dataset)
+	if err != nil {
+		// the dataset may be created after the runtime
+		if utils.IgnoreNotFound(err) != nil {
+			setupLog.Error(err, "failed to get the dataset of the runtime, skip validating it", "kind", kind,
+				"name", runtime.GetName(), "namespace", runtime.GetNamespace())
+		}
+		return
+	}
+
+	for _, bound := range dataset.Status.Runtimes {
+		if bound.Type != runtimeType {
+			errs = append(errs, field.Forbidden(field.NewPath("metadata").Child("name"),
+				fmt.Sprintf("the dataset %s/%s is already bound to the %s runtime %s", dataset.Namespace, dataset.Name, bound.Type, bound.Name)))
+		}
+	}
+	return
+}
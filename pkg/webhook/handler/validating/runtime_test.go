@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestValidateRuntime(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+		Status: datav1alpha1.DatasetStatus{
+			Runtimes: []datav1alpha1.Runtime{{Name: "hbase", Namespace: "fluid", Type: common.AlluxioRuntime}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		kind    string
+		runtime client.Object
+		wantErr bool
+	}{
+		{
+			name:    "runtime of the same type",
+			kind:    "AlluxioRuntime",
+			runtime: &datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"}},
+		},
+		{
+			name:    "runtime of another type",
+			kind:    "JuiceFSRuntime",
+			runtime: &datav1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"}},
+			wantErr: true,
+		},
+		{
+			name:    "dataset not found",
+			kind:    "JuiceFSRuntime",
+			runtime: &datav1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "spark", Namespace: "fluid"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(testScheme, []runtime.Object{dataset.DeepCopy()}...)
+			errs := validateRuntime(c, tt.kind, tt.runtime)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateRuntime() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
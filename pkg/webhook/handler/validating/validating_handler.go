@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+var setupLog = ctrl.Log.WithName("validating")
+
+// FluidValidatingHandler validates the Dataset, runtimes and data operations on create and update,
+// so that the invalid objects are rejected before they're stored and reconciled by the controllers.
+type FluidValidatingHandler struct {
+	Client client.Client
+	Reader client.Reader
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+}
+
+func (a *FluidValidatingHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	a.Client = client
+	a.Reader = reader
+	a.decoder = decoder
+}
+
+// Handle is the validating logic of fluid resources
+func (a *FluidValidatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "FluidValidatingHandler.Handle",
+		"req.kind", req.Kind.Kind, "req.name", req.Name, "req.namespace", req.Namespace)
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("skip validating because the operation is neither create nor update")
+	}
+
+	obj, err := newObjectForKind(req.Kind.Kind)
+	if err != nil {
+		return admission.Allowed(err.Error())
+	}
+	if err = a.decoder.Decode(req, obj); err != nil {
+		setupLog.Error(err, "unable to decode object from req", "kind", req.Kind.Kind)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var oldObj client.Object
+	if req.Operation == admissionv1.Update {
+		// the objects are updated by the controllers frequently, e.g. adding finalizers, skip them to
+		// avoid blocking the controllers on the objects stored before the webhook is enabled.
+		changed, err := isSpecChanged(req.OldObject, req.Object)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if !changed {
+			return admission.Allowed("skip validating because the spec is not changed")
+		}
+
+		oldObj, _ = newObjectForKind(req.Kind.Kind)
+		if err = a.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
+			setupLog.Error(err, "unable to decode old object from req", "kind", req.Kind.Kind)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+	if len(obj.GetNamespace()) == 0 {
+		obj.SetNamespace(req.Namespace)
+	}
+
+	var errs field.ErrorList
+	switch object := obj.(type) {
+	case *datav1alpha1.Dataset:
+		var oldDataset *datav1alpha1.Dataset
+		if oldObj != nil {
+			oldDataset = oldObj.(*datav1alpha1.Dataset)
+		}
+		errs = validateDataset(object, oldDataset)
+	case *datav1alpha1.DataLoad, *datav1alpha1.DataBackup, *datav1alpha1.DataMigrate, *datav1alpha1.DataProcess:
+		errs = validateDataOperation(a.Reader, req.Kind.Kind, object)
+	default:
+		errs = validateRuntime(a.Reader, req.Kind.Kind, object)
+	}
+
+	if len(errs) > 0 {
+		setupLog.Info("reject invalid object", "kind", req.Kind.Kind, "name", req.Name, "namespace", req.Namespace, "errors", errs.ToAggregate().Error())
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+func newObjectForKind(kind string) (client.Object, error) {
+	switch kind {
+	case "Dataset":
+		return &datav1alpha1.Dataset{}, nil
+	case "AlluxioRuntime":
+		return &datav1alpha1.AlluxioRuntime{}, nil
+	case "JindoRuntime":
+		return &datav1alpha1.JindoRuntime{}, nil
+	case "GooseFSRuntime":
+		return &datav1alpha1.GooseFSRuntime{}, nil
+	case "JuiceFSRuntime":
+		return &datav1alpha1.JuiceFSRuntime{}, nil
+	case "ThinRuntime":
+		return &datav1alpha1.ThinRuntime{}, nil
+	case "EFCRuntime":
+		return &datav1alpha1.EFCRuntime{}, nil
+	case "VineyardRuntime":
+		return &datav1alpha1.VineyardRuntime{}, nil
+	case "DataLoad":
+		return &datav1alpha1.DataLoad{}, nil
+	case "DataBackup":
+		return &datav1alpha1.DataBackup{}, nil
+	case "DataMigrate":
+		return &datav1alpha1.DataMigrate{}, nil
+	case "DataProcess":
+		return &datav1alpha1.DataProcess{}, nil
+	}
+	return nil, fmt.Errorf("skip validating the unsupported kind %s", kind)
+}
+
+// isSpecChanged checks if the spec of the object is changed in the update request
+func isSpecChanged(oldObject, newObject runtime.RawExtension) (bool, error) {
+	var oldSpec, newSpec struct {
+		Spec interface{} `json:"spec,omitempty"`
+	}
+	if err := json.Unmarshal(oldObject.Raw, &oldSpec); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(newObject.Raw, &newSpec); err != nil {
+		return false, err
+	}
+	return !reflect.DeepEqual(oldSpec.Spec, newSpec.Spec), nil
+}
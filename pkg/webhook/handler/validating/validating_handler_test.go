@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var testScheme *runtime.Scheme
+
+func init() {
+	testScheme = runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+}
+
+func newRequest(t *testing.T, operation admissionv1.Operation, kind string, obj, oldObj client.Object) admission.Request {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			Kind:      metav1.GroupVersionKind{Group: "data.fluid.io", Version: "v1alpha1", Kind: kind},
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+	if oldObj != nil {
+		oldRaw, err := json.Marshal(oldObj)
+		if err != nil {
+			t.Fatalf("failed to marshal old object: %v", err)
+		}
+		req.OldObject = runtime.RawExtension{Raw: oldRaw}
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	validDataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{{Name: "hbase", MountPoint: "s3://bucket/hbase"}},
+		},
+	}
+	invalidDataset := validDataset.DeepCopy()
+	invalidDataset.Spec.Mounts = append(invalidDataset.Spec.Mounts, datav1alpha1.Mount{Name: "hbase", MountPoint: "local://hbase"})
+
+	boundDataset := invalidDataset.DeepCopy()
+	boundDataset.Status.Runtimes = []datav1alpha1.Runtime{{Name: "hbase", Namespace: "fluid", Type: common.JindoRuntime}}
+
+	changedDataset := validDataset.DeepCopy()
+	changedDataset.Status.Runtimes = []datav1alpha1.Runtime{{Name: "hbase", Namespace: "fluid", Type: common.JindoRuntime}}
+	changedDataset.Spec.Mounts[0].MountPoint = "s3://bucket/hbase-v2"
+
+	invalidDataLoad := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+		Spec: datav1alpha1.DataLoadSpec{
+			Policy:   datav1alpha1.Cron,
+			Schedule: "every minute",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		operation admissionv1.Operation
+		kind      string
+		obj       client.Object
+		oldObj    client.Object
+		allowed   bool
+	}{
+		{
+			name:      "create valid dataset",
+			operation: admissionv1.Create,
+			kind:      "Dataset",
+			obj:       validDataset,
+			allowed:   true,
+		},
+		{
+			name:      "create invalid dataset",
+			operation: admissionv1.Create,
+			kind:      "Dataset",
+			obj:       invalidDataset,
+			allowed:   false,
+		},
+		{
+			name:      "update invalid dataset without changing spec",
+			operation: admissionv1.Update,
+			kind:      "Dataset",
+			obj:       boundDataset,
+			oldObj:    invalidDataset,
+			allowed:   true,
+		},
+		{
+			name:      "update bound dataset without changing spec",
+			operation: admissionv1.Update,
+			kind:      "Dataset",
+			obj:       changedDataset,
+			oldObj:    changedDataset.DeepCopy(),
+			allowed:   true,
+		},
+		{
+			name:      "create dataload with invalid schedule",
+			operation: admissionv1.Create,
+			kind:      "DataLoad",
+			obj:       invalidDataLoad,
+			allowed:   false,
+		},
+		{
+			name:      "delete dataload",
+			operation: admissionv1.Delete,
+			kind:      "DataLoad",
+			obj:       invalidDataLoad,
+			allowed:   true,
+		},
+		{
+			name:      "create unsupported kind",
+			operation: admissionv1.Create,
+			kind:      "DataFlow",
+			obj:       invalidDataLoad,
+			allowed:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(testScheme)
+			handler := &FluidValidatingHandler{}
+			handler.Setup(c, c, admission.NewDecoder(testScheme))
+
+			resp := handler.Handle(context.TODO(), newRequest(t, tt.operation, tt.kind, tt.obj, tt.oldObj))
+			if resp.Allowed != tt.allowed {
+				t.Errorf("expect allowed %v, got %v, result: %v", tt.allowed, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
+
+func TestHandleChangeMountsOfBoundDataset(t *testing.T) {
+	oldDataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{{Name: "hbase", MountPoint: "s3://bucket/hbase"}},
+		},
+		Status: datav1alpha1.DatasetStatus{
+			Runtimes: []datav1alpha1.Runtime{{Name: "hbase", Namespace: "fluid", Type: common.JindoRuntime}},
+		},
+	}
+	dataset := oldDataset.DeepCopy()
+	dataset.Spec.Mounts[0].MountPoint = "s3://bucket/hbase-v2"
+
+	c := fake.NewFakeClientWithScheme(testScheme)
+	handler := &FluidValidatingHandler{}
+	handler.Setup(c, c, admission.NewDecoder(testScheme))
+
+	resp := handler.Handle(context.TODO(), newRequest(t, admissionv1.Update, "Dataset", dataset, oldDataset))
+	if resp.Allowed {
+		t.Errorf("expect changing the mounts of the dataset bound to jindo runtime to be denied")
+	}
+}